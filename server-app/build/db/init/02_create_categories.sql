@@ -0,0 +1,21 @@
+USE user_info;
+
+-- カテゴリ（親子階層・ユーザーごとに管理）
+CREATE TABLE IF NOT EXISTS CATEGORIES (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    user_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(50) NOT NULL,
+    description VARCHAR(255),
+    parent_id BIGINT UNSIGNED DEFAULT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_categories_user_name (user_id, name),
+    KEY idx_categories_parent_id (parent_id)
+);
+
+-- ブログとカテゴリの多対多
+CREATE TABLE IF NOT EXISTS post_categories (
+    category_id BIGINT UNSIGNED NOT NULL,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (category_id, blog_id),
+    KEY idx_post_categories_blog_id (blog_id)
+);
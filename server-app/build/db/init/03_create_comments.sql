@@ -0,0 +1,17 @@
+USE user_info;
+
+-- ブログ記事へのコメント（返信はparent_idで親コメントを参照）
+CREATE TABLE IF NOT EXISTS COMMENTS (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    post_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED DEFAULT NULL,
+    content TEXT NOT NULL,
+    author_name VARCHAR(100),
+    author_email VARCHAR(100),
+    created_at DATETIME(3) NOT NULL,
+    parent_id BIGINT UNSIGNED DEFAULT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    PRIMARY KEY (id),
+    KEY idx_comments_post_id_status (post_id, status),
+    KEY idx_comments_parent_id (parent_id)
+);
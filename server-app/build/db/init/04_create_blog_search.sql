@@ -0,0 +1,13 @@
+USE user_info;
+
+-- ブログ全文検索用インデックス（日本語対応のためngramパーサを使用）
+-- タイトル・本文は全角半角・大文字小文字を正規化して保持する
+CREATE TABLE IF NOT EXISTS BLOG_SEARCH (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content MEDIUMTEXT NOT NULL,
+    PRIMARY KEY (blog_id),
+    FULLTEXT KEY ft_blog_search_title (title) WITH PARSER ngram,
+    FULLTEXT KEY ft_blog_search_title_content (title, content) WITH PARSER ngram
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
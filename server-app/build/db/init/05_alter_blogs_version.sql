@@ -0,0 +1,4 @@
+USE user_info;
+
+-- ブログ編集の楽観的排他制御用バージョン
+ALTER TABLE BLOGS ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1;
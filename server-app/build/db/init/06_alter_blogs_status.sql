@@ -0,0 +1,11 @@
+USE user_info;
+
+-- ブログの公開ステータス（draft / scheduled / published / archived）と公開日時
+ALTER TABLE BLOGS
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    ADD COLUMN publish_at DATETIME(3) DEFAULT NULL,
+    ADD COLUMN published_at DATETIME(3) DEFAULT NULL,
+    ADD KEY idx_blogs_status_publish_at (status, publish_at);
+
+-- 既存のブログは作成時点で公開済みとして扱う
+UPDATE BLOGS SET status = 'published', published_at = created_at;
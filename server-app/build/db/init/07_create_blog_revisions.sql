@@ -0,0 +1,15 @@
+USE user_info;
+
+-- ブログの更新履歴（更新前のタイトル・本文をバージョンごとに保持）
+CREATE TABLE IF NOT EXISTS BLOG_REVISIONS (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    version INT UNSIGNED NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    content TEXT NOT NULL,
+    editor_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME(3) NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_blog_revisions_blog_id_version (blog_id, version),
+    KEY idx_blog_revisions_blog_id_created_at (blog_id, created_at)
+);
@@ -0,0 +1,5 @@
+USE user_info;
+
+-- ゴミ箱一覧と保持期間切れの完全削除で削除日時を検索するためのインデックス
+ALTER TABLE BLOGS
+    ADD KEY idx_blogs_user_id_deleted_at (user_id, deleted_at);
@@ -0,0 +1,18 @@
+USE user_info;
+
+-- タグ（正規化済みのタグ名を保持）
+-- 濁点・半濁点や大文字小文字を区別するためバイナリ照合順序とする
+CREATE TABLE IF NOT EXISTS TAGS (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    name VARCHAR(30) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE KEY uk_tags_name (name)
+);
+
+-- ブログとタグの多対多
+CREATE TABLE IF NOT EXISTS post_tags (
+    tag_id BIGINT UNSIGNED NOT NULL,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (tag_id, blog_id),
+    KEY idx_post_tags_blog_id (blog_id)
+);
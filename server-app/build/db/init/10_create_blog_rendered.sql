@@ -0,0 +1,13 @@
+USE user_info;
+
+-- ブログ本文の変換結果のキャッシュ（ブログごとに最新バージョンの1行を保持）
+-- rendererは変換方式の識別子で、変換方式の更新時は再変換する
+CREATE TABLE IF NOT EXISTS BLOG_RENDERED (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    version INT UNSIGNED NOT NULL,
+    renderer VARCHAR(32) NOT NULL,
+    html MEDIUMTEXT NOT NULL,
+    toc TEXT NOT NULL,
+    updated_at DATETIME NOT NULL,
+    PRIMARY KEY (blog_id)
+);
@@ -0,0 +1,22 @@
+USE user_info;
+
+-- ブログのスラッグ（著者ごとに一意なURL用の識別子）
+ALTER TABLE BLOGS
+    ADD COLUMN slug VARCHAR(80) DEFAULT NULL;
+
+-- 既存のブログはIDによるスラッグとする
+UPDATE BLOGS SET slug = CONCAT('post-', id) WHERE slug IS NULL;
+
+ALTER TABLE BLOGS
+    MODIFY COLUMN slug VARCHAR(80) NOT NULL,
+    ADD UNIQUE KEY uk_blogs_user_id_slug (user_id, slug);
+
+-- 変更前のスラッグ（新しいスラッグへの転送に使用）
+CREATE TABLE IF NOT EXISTS BLOG_SLUG_HISTORY (
+    user_id BIGINT UNSIGNED NOT NULL,
+    slug VARCHAR(80) NOT NULL,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (user_id, slug),
+    KEY idx_blog_slug_history_blog_id (blog_id)
+);
@@ -0,0 +1,15 @@
+USE user_info;
+
+-- ブログの公開範囲（public: 全体公開, unlisted: 共有URLによる限定公開, private: 非公開）
+-- 共有URLのトークン（限定公開のブログの閲覧に使用）
+ALTER TABLE BLOGS
+    ADD COLUMN visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    ADD COLUMN share_token VARCHAR(32) DEFAULT NULL;
+
+-- 既存のブログにはランダムなトークンを発行する
+UPDATE BLOGS SET share_token = REPLACE(UUID(), '-', '') WHERE share_token IS NULL;
+
+ALTER TABLE BLOGS
+    MODIFY COLUMN share_token VARCHAR(32) NOT NULL,
+    ADD UNIQUE KEY uk_blogs_share_token (share_token),
+    ADD KEY idx_blogs_status_visibility (status, visibility);
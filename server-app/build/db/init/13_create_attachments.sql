@@ -0,0 +1,17 @@
+USE user_info;
+
+-- アップロードされた画像・添付ファイル
+-- 実体はstorage_key（内容のSHA-256による保存キー）でストレージに保存し、同一内容の実体は共有する
+CREATE TABLE IF NOT EXISTS ATTACHMENTS (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    blog_id BIGINT UNSIGNED DEFAULT NULL,
+    storage_key VARCHAR(100) NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    created_at DATETIME NOT NULL,
+    KEY idx_attachments_user_id (user_id, created_at),
+    KEY idx_attachments_blog_id (blog_id),
+    KEY idx_attachments_storage_key (storage_key)
+);
@@ -0,0 +1,24 @@
+USE user_info;
+
+-- 画像のサイズと派生画像の生成状況（none: 対象外, pending: 生成待ち, ready: 生成済み, failed: 生成失敗）
+ALTER TABLE ATTACHMENTS
+    ADD COLUMN width INT NOT NULL DEFAULT 0,
+    ADD COLUMN height INT NOT NULL DEFAULT 0,
+    ADD COLUMN processing_status VARCHAR(20) NOT NULL DEFAULT 'none',
+    ADD KEY idx_attachments_processing_status (processing_status, id);
+
+-- 画像から生成した縮小版（サムネイル等）
+-- 実体は元画像と同様にstorage_keyでストレージに保存する
+CREATE TABLE IF NOT EXISTS ATTACHMENT_VARIANTS (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    attachment_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(30) NOT NULL,
+    format VARCHAR(10) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(100) NOT NULL,
+    KEY idx_attachment_variants_attachment_id (attachment_id),
+    KEY idx_attachment_variants_storage_key (storage_key)
+);
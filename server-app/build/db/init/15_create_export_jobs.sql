@@ -0,0 +1,22 @@
+USE user_info;
+
+-- ブログのエクスポートジョブ
+-- status: pending（作成待ち）, running（作成中）, done（作成済み）, failed（作成失敗）, expired（有効期限切れ）
+-- download_token: アーカイブのダウンロードURLのトークン（作成済みかつexpires_atまで有効）
+CREATE TABLE IF NOT EXISTS EXPORT_JOBS (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    blog_count INT NOT NULL DEFAULT 0,
+    attachment_count INT NOT NULL DEFAULT 0,
+    size BIGINT NOT NULL DEFAULT 0,
+    error VARCHAR(255) NOT NULL DEFAULT '',
+    download_token VARCHAR(32) NOT NULL,
+    created_at DATETIME NOT NULL,
+    started_at DATETIME DEFAULT NULL,
+    completed_at DATETIME DEFAULT NULL,
+    expires_at DATETIME DEFAULT NULL,
+    UNIQUE KEY uk_export_jobs_download_token (download_token),
+    KEY idx_export_jobs_user_id (user_id, status),
+    KEY idx_export_jobs_status (status, expires_at)
+);
@@ -0,0 +1,16 @@
+USE user_info;
+
+-- 取り込み元の記事と取り込んだブログの対応（再取り込み時の重複判定に使用）
+-- source: wordpress（WXR）, markdown（Hugo・Jekyll等）
+-- source_id: 取り込み元での識別子（WordPressはサイトURLと記事ID、Markdownはfront matterのidまたはファイルパス）
+CREATE TABLE IF NOT EXISTS IMPORT_SOURCES (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    source VARCHAR(20) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    UNIQUE KEY uk_import_sources_source (user_id, source, source_id),
+    KEY idx_import_sources_blog_id (blog_id)
+);
@@ -0,0 +1,20 @@
+USE user_info;
+
+-- ブログへのリアクション（ユーザーはブログごとに絵文字1種類につき1件まで）
+CREATE TABLE IF NOT EXISTS REACTIONS (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    emoji VARCHAR(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    created_at DATETIME NOT NULL,
+    UNIQUE KEY uk_reactions_user (blog_id, user_id, emoji),
+    KEY idx_reactions_blog_id (blog_id, emoji, created_at)
+);
+
+-- 一覧表示用の絵文字ごとのリアクション数（REACTIONSの登録・取り消しと同じトランザクションで更新）
+CREATE TABLE IF NOT EXISTS BLOG_REACTION_COUNTS (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    emoji VARCHAR(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (blog_id, emoji)
+);
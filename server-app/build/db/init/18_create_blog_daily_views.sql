@@ -0,0 +1,12 @@
+USE user_info;
+
+-- ブログの日別の閲覧数（Redisで集計した値をバックグラウンドで書き込む）
+-- unique_visitors: HyperLogLogによるユニーク訪問者数の推定値
+CREATE TABLE IF NOT EXISTS BLOG_DAILY_VIEWS (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    day DATE NOT NULL,
+    views BIGINT NOT NULL DEFAULT 0,
+    unique_visitors BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (blog_id, day),
+    KEY idx_blog_daily_views_day (day)
+);
@@ -0,0 +1,18 @@
+USE user_info;
+
+-- 関連記事用のブログごとの索引語（英単語・日本語の文字bigram）と出現回数
+-- 正規化済みの索引語を区別するためバイナリ照合順序とする
+CREATE TABLE IF NOT EXISTS BLOG_TERMS (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    term VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    tf INT NOT NULL,
+    PRIMARY KEY (blog_id, term),
+    KEY idx_blog_terms_term (term)
+);
+
+-- 索引語ごとの文書頻度（BLOG_TERMSの登録・削除と同じトランザクションで更新）
+CREATE TABLE IF NOT EXISTS TERM_DF (
+    term VARCHAR(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    df INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (term)
+);
@@ -0,0 +1,23 @@
+USE user_info;
+
+-- シリーズ（連載記事等の順序付きのブログのまとまり）
+CREATE TABLE IF NOT EXISTS SERIES (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+    user_id BIGINT UNSIGNED NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500),
+    created_at DATETIME NOT NULL,
+    updated_at DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    KEY idx_series_user_id (user_id)
+);
+
+-- シリーズ内のブログと表示順（ブログは1つのシリーズにのみ属する）
+CREATE TABLE IF NOT EXISTS SERIES_ENTRIES (
+    series_id BIGINT UNSIGNED NOT NULL,
+    blog_id BIGINT UNSIGNED NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (series_id, blog_id),
+    UNIQUE KEY uk_series_entries_position (series_id, position),
+    UNIQUE KEY uk_series_entries_blog_id (blog_id)
+);
@@ -0,0 +1,14 @@
+USE user_info;
+
+-- ブログの共同編集者（招待を承諾するまで権限は有効にならない）
+CREATE TABLE IF NOT EXISTS BLOG_COLLABORATORS (
+    blog_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    invited_by BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL,
+    responded_at DATETIME DEFAULT NULL,
+    PRIMARY KEY (blog_id, user_id),
+    KEY idx_blog_collaborators_user_id (user_id, status)
+);
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	"github.com/kazukimurahashi12/webapp/infrastructure/di"
+	"go.uber.org/zap"
+)
+
+// 記事の取り込みコマンド
+// 使い方: main import -user <ユーザーID> [-dry-run] <WXRファイル|Markdownファイル|ZIP|ディレクトリ>
+func runImport(args []string) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	userID := flags.Uint("user", 0, "取り込み先のユーザーID")
+	dryRun := flags.Bool("dry-run", false, "変更せずに取り込み結果のみを表示する")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: main import -user <id> [-dry-run] <file|dir>")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *userID == 0 || flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	source, posts, err := readImportPosts(flags.Arg(0))
+	if err != nil {
+		logger.Error("Failed to read import source", zap.String("path", flags.Arg(0)), zap.Error(err))
+		return 1
+	}
+
+	container := di.NewContainer()
+	report, err := container.ImporterUseCase.Import(*userID, source, posts, *dryRun)
+	if err != nil {
+		logger.Error("Import failed", zap.Uint("userID", *userID), zap.Error(err))
+		return 1
+	}
+	printImportReport(os.Stdout, report)
+	return 0
+}
+
+// 取り込み元のファイル・ディレクトリから記事を読み込む
+// ローカルのファイルのためサイズの上限は設けない
+func readImportPosts(p string) (string, []domainImporter.Post, error) {
+	info, err := os.Stat(p)
+	if err != nil {
+		return "", nil, err
+	}
+	if info.IsDir() {
+		posts, err := domainImporter.ParseMarkdownFS(os.DirFS(p))
+		if err != nil {
+			return "", nil, err
+		}
+		if len(posts) == 0 {
+			return "", nil, domainImporter.ErrImportEmpty
+		}
+		return domainImporter.SourceMarkdown, posts, nil
+	}
+	content, err := os.ReadFile(p)
+	if err != nil {
+		return "", nil, err
+	}
+	return domainImporter.ParseUpload(p, content, math.MaxInt64)
+}
+
+// 取り込み結果を表示
+func printImportReport(w io.Writer, report *domainImporter.Report) {
+	for _, item := range report.Items {
+		name := item.Path
+		if name == "" {
+			name = item.SourceID
+		}
+		line := fmt.Sprintf("%-9s %s", item.Action, name)
+		if item.BlogID != 0 {
+			line += fmt.Sprintf(" (blog_id=%d)", item.BlogID)
+		}
+		if len(item.Changes) > 0 {
+			line += " [" + strings.Join(item.Changes, ", ") + "]"
+		}
+		if item.Reason != "" {
+			line += ": " + item.Reason
+		}
+		fmt.Fprintln(w, line)
+		for _, warning := range item.Warnings {
+			fmt.Fprintln(w, "          warning: "+warning)
+		}
+	}
+	if len(report.NewCategories) > 0 {
+		fmt.Fprintln(w, "new categories: "+strings.Join(report.NewCategories, ", "))
+	}
+	summary := fmt.Sprintf("created=%d updated=%d unchanged=%d skipped=%d",
+		report.Created, report.Updated, report.Unchanged, report.Skipped)
+	if report.DryRun {
+		summary += " (dry run)"
+	}
+	fmt.Fprintln(w, summary)
+}
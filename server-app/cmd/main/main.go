@@ -5,9 +5,11 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/kazukimurahashi12/webapp/infrastructure/di"
 	"github.com/kazukimurahashi12/webapp/interface/controller"
 	"go.uber.org/zap"
 )
@@ -37,11 +39,50 @@ func main() {
 			_ = logger.Sync()
 		}
 	}()
+	// サブコマンド
+	if len(os.Args) > 1 {
+		var run func([]string) int
+		switch os.Args[1] {
+		case "import":
+			run = runImport
+		case "reindex-related":
+			run = runReindexRelated
+		}
+		if run != nil {
+			code := run(os.Args[2:])
+			_ = logger.Sync()
+			os.Exit(code)
+		}
+	}
+
 	// 起動中ログ出力
 	logger.Info("Starting application...")
 
+	// DIコンテナ作成
+	// NewContainer 依存性注入用のコンストラクタ
+	container := di.NewContainer()
+
 	// ルーター初期化
-	router := controller.GetRouter()
+	router := controller.GetRouter(container)
+
+	// バックグラウンドジョブ起動
+	// 終了時は各ジョブの終了処理（集計中の閲覧数の書き込み等）の完了を待つ
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	var jobs sync.WaitGroup
+	for _, run := range []func(context.Context){
+		container.PublishScheduler.Run,
+		container.TrashPurger.Run,
+		container.ImageWorkerPool.Run,
+		container.ExportWorker.Run,
+		container.ViewFlusher.Run,
+	} {
+		jobs.Add(1)
+		go func() {
+			defer jobs.Done()
+			run(jobCtx)
+		}()
+	}
 
 	// ポート設定
 	port := os.Getenv("PORT")
@@ -77,9 +118,14 @@ func main() {
 	defer cancel()
 
 	// サーバーの正常終了
+	// 処理中のリクエストが記録した閲覧数も書き込むため、ジョブはサーバーの終了後に停止する
 	if err := srv.Shutdown(ctx); err != nil {
-		logger.Fatal("Server forced to shutdown", zap.Error(err))
+		logger.Error("Server forced to shutdown", zap.Error(err))
 	}
 
+	// バックグラウンドジョブの停止と終了処理の完了待ち
+	stopJobs()
+	jobs.Wait()
+
 	logger.Info("Server exiting")
 }
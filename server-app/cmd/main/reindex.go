@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/kazukimurahashi12/webapp/infrastructure/di"
+	"go.uber.org/zap"
+)
+
+// 関連記事の索引語の一括登録コマンド
+// 索引語の導入前に作成されたブログを登録する
+// 使い方: main reindex-related [-batch <件数>]
+func runReindexRelated(args []string) int {
+	flags := flag.NewFlagSet("reindex-related", flag.ContinueOnError)
+	batchSize := flags.Int("batch", 100, "1回に取得するブログの件数")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: main reindex-related [-batch <n>]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *batchSize <= 0 || flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	container := di.NewContainer()
+	indexed, err := container.RecommendUseCase.IndexMissing(*batchSize)
+	if err != nil {
+		logger.Error("Failed to index related terms", zap.Int("indexed", indexed), zap.Error(err))
+		return 1
+	}
+	fmt.Fprintf(os.Stdout, "indexed %d blogs\n", indexed)
+	return 0
+}
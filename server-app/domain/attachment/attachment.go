@@ -0,0 +1,65 @@
+package attachment
+
+import "time"
+
+// アップロードされた画像・添付ファイル
+// 実体はBlobStorageにStorageKeyで保存し、同一内容のファイルは実体を共有する
+type Attachment struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	OwnerID     uint      `json:"ownerId" gorm:"column:user_id;not null"`
+	BlogID      *uint     `json:"blogId"` // 未指定の場合はどのブログにも紐づけない
+	StorageKey  string    `json:"storageKey" gorm:"size:100;not null"`
+	Filename    string    `json:"filename" gorm:"size:255;not null"` // アップロード時のファイル名
+	ContentType string    `json:"contentType" gorm:"size:100;not null"`
+	Size        int64     `json:"size" gorm:"not null"`
+	Width       int       `json:"width"`  // 画像の場合のみ、派生画像の生成時に設定
+	Height      int       `json:"height"` // 画像の場合のみ、派生画像の生成時に設定
+	Processing  string    `json:"processing" gorm:"column:processing_status;size:20;not null;default:none"`
+	Variants    []Variant `json:"variants" gorm:"-"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// 派生画像の生成状況
+const (
+	ProcessingNone    = "none"    // 画像以外、または派生画像を生成できない形式
+	ProcessingPending = "pending" // 生成待ち
+	ProcessingReady   = "ready"   // 生成済み
+	ProcessingFailed  = "failed"  // 生成失敗（元のファイルのみ利用可能）
+)
+
+// アップロードするファイル
+type Upload struct {
+	OwnerID  uint
+	BlogID   *uint
+	Filename string
+	Content  []byte
+}
+
+// アップロード可能なファイルの最大サイズ（バイト）
+const DefaultMaxSize = 10 << 20
+
+// アップロード可能なファイル形式と保存時の拡張子
+// SVG・HTML等、ブラウザでスクリプトを実行できる形式は許可しない
+var allowedContentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"image/webp":      ".webp",
+	"application/pdf": ".pdf",
+	"text/plain":      ".txt",
+}
+
+// アップロード可能なファイル形式かを判定し、保存時の拡張子を返却
+func ExtensionFor(contentType string) (string, bool) {
+	ext, ok := allowedContentTypes[contentType]
+	return ext, ok
+}
+
+// 画像ファイルかを判定
+func (a *Attachment) IsImage() bool {
+	switch a.ContentType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
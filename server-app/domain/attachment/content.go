@@ -0,0 +1,44 @@
+package attachment
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ファイル名の最大長（バイト）
+const maxFilenameLength = 255
+
+// ファイル内容からファイル形式を判定
+// クライアントが申告するContent-Typeは信用せず、内容の先頭から判定する
+func DetectContentType(content []byte) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
+// アップロード時のファイル名から表示用のファイル名を生成
+// ディレクトリ部分と制御文字を除き、最大長で切り詰める
+func NormalizeFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == ".." || name == "/" {
+		return "file"
+	}
+	for len(name) > maxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
@@ -0,0 +1,17 @@
+package attachment
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrAttachmentNotFound     = errors.New("attachment not found")
+	ErrAttachmentUnauthorized = errors.New("unauthorized access to this attachment")
+	ErrAttachmentEmpty        = errors.New("attachment is empty")
+	ErrAttachmentTooLarge     = errors.New("attachment exceeds maximum size")
+	ErrContentTypeNotAllowed  = errors.New("attachment content type is not allowed")
+	ErrStorageKeyInvalid      = errors.New("storage key is invalid")
+	ErrBlobNotFound           = errors.New("blob not found in storage")
+	ErrImageUnsupported       = errors.New("image format cannot be processed")
+	ErrImageTooLarge          = errors.New("image dimensions exceed maximum")
+	ErrVariantSpecInvalid     = errors.New("image variant spec is invalid")
+)
@@ -0,0 +1,28 @@
+package attachment
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+)
+
+// ファイル内容のハッシュ値による保存キーの形式
+// 先頭2文字のディレクトリで分散し、拡張子でファイル形式を表す
+var storageKeyPattern = regexp.MustCompile(`^[0-9a-f]{2}/[0-9a-f]{64}\.[a-z]+$`)
+
+// ファイル内容から保存キーを生成
+// 同一内容のファイルは同じキーとなる
+func ContentKey(content []byte, ext string) string {
+	sum := sha256.Sum256(content)
+	digest := hex.EncodeToString(sum[:])
+	return digest[:2] + "/" + digest + ext
+}
+
+// 保存キーの形式を検証
+// 保存先の外部を指すパスを受け付けないよう、生成した形式のキーのみ許可する
+func ValidateKey(key string) error {
+	if !storageKeyPattern.MatchString(key) {
+		return ErrStorageKeyInvalid
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package attachment
+
+// 生成した派生画像とその内容
+type EncodedImage struct {
+	Variant Variant // StorageKey・AttachmentIDは未設定
+	Ext     string  // 保存時の拡張子
+	Content []byte
+}
+
+// 派生画像の生成結果
+type DerivedImages struct {
+	Width  int // 向きを補正した元画像の幅
+	Height int // 向きを補正した元画像の高さ
+	Images []EncodedImage
+}
+
+// 画像処理のインターフェース
+type ImageProcessor interface {
+	// 位置情報等のメタデータを除去した内容を返す（対象外の形式はそのまま返す）
+	Sanitize(content []byte, contentType string) ([]byte, error)
+	// 派生画像を生成できる形式かを判定する
+	CanDerive(contentType string) bool
+	// 生成条件ごとに派生画像を生成する
+	Derive(content []byte, specs []VariantSpec) (*DerivedImages, error)
+}
+
+// 派生画像の生成待ちキュー
+type ProcessingQueue interface {
+	// キューが満杯の場合はfalseを返す（生成待ちのまま後で再投入される）
+	Enqueue(attachmentID uint) bool
+}
@@ -0,0 +1,16 @@
+package attachment
+
+// 添付ファイルRepositoryインターフェース
+type AttachmentRepository interface {
+	Create(attachment *Attachment) error
+	FindAttachmentByID(id uint) (*Attachment, error)
+	FindAttachmentsByKey(key string) ([]Attachment, error)
+	FindAttachmentsByOwnerID(ownerID uint) ([]Attachment, error)
+	FindAttachmentsByBlogID(blogID uint) ([]Attachment, error)
+	Delete(id uint) error
+	DeleteBlobIfUnreferenced(key string, deleteBlob func(key string) error) (bool, error)
+	FindVariantByKey(key string) (*Variant, error)
+	FindVariantsByAttachmentIDs(ids []uint) ([]Variant, error)
+	FindAttachmentIDsByProcessing(status string, limit int) ([]uint, error)
+	SaveProcessingResult(id uint, status string, width, height int, variants []Variant) error
+}
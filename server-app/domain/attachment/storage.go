@@ -0,0 +1,15 @@
+package attachment
+
+import "io"
+
+// ファイルの実体を保存するストレージのインターフェース
+// ローカルファイルシステムのほか、S3互換ストレージ等への差し替えを想定する
+type BlobStorage interface {
+	// 指定キーで保存する（既存の場合は上書き）
+	Put(key string, content io.Reader) error
+	// 保存済みの実体を開く（存在しない場合はErrBlobNotFound）
+	Open(key string) (io.ReadCloser, error)
+	// 保存済みの実体を削除する（存在しない場合も成功とする）
+	Delete(key string) error
+	Exists(key string) (bool, error)
+}
@@ -0,0 +1,97 @@
+package attachment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 画像から生成した縮小版（派生画像）
+// 同じサイズで複数の形式を生成し、クライアントが対応する形式を選択する
+type Variant struct {
+	ID           uint   `json:"id" gorm:"primaryKey"`
+	AttachmentID uint   `json:"attachmentId" gorm:"not null"`
+	Name         string `json:"name" gorm:"size:30;not null"` // VariantSpecの名前
+	Format       string `json:"format" gorm:"size:10;not null"`
+	ContentType  string `json:"contentType" gorm:"size:100;not null"`
+	Width        int    `json:"width" gorm:"not null"`
+	Height       int    `json:"height" gorm:"not null"`
+	Size         int64  `json:"size" gorm:"not null"`
+	StorageKey   string `json:"storageKey" gorm:"size:100;not null"`
+}
+
+// 派生画像の生成条件
+// 元画像が指定サイズより小さい場合は拡大しない
+type VariantSpec struct {
+	Name      string
+	MaxWidth  int
+	MaxHeight int
+	Crop      bool // 指定サイズの縦横比になるよう中央を切り抜く
+}
+
+// 派生画像の生成条件のデフォルト値
+var DefaultVariantSpecs = []VariantSpec{
+	{Name: "thumbnail", MaxWidth: 320, MaxHeight: 320, Crop: true},
+	{Name: "medium", MaxWidth: 960, MaxHeight: 960},
+	{Name: "full", MaxWidth: 1920, MaxHeight: 1920},
+}
+
+// 派生画像の一辺の最大値
+const maxVariantDimension = 8192
+
+// 「名前:幅x高さ[:crop]」をカンマ区切りで並べた文字列から生成条件を取得
+// 例: thumbnail:320x320:crop,medium:960x960
+func ParseVariantSpecs(s string) ([]VariantSpec, error) {
+	var specs []VariantSpec
+	seen := map[string]bool{}
+	for _, item := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(item), ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || seen[parts[0]] {
+			return nil, fmt.Errorf("%w: %q", ErrVariantSpecInvalid, item)
+		}
+		w, h, ok := strings.Cut(parts[1], "x")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrVariantSpecInvalid, item)
+		}
+		spec := VariantSpec{Name: parts[0]}
+		var err error
+		if spec.MaxWidth, err = strconv.Atoi(w); err != nil || spec.MaxWidth <= 0 || spec.MaxWidth > maxVariantDimension {
+			return nil, fmt.Errorf("%w: %q", ErrVariantSpecInvalid, item)
+		}
+		if spec.MaxHeight, err = strconv.Atoi(h); err != nil || spec.MaxHeight <= 0 || spec.MaxHeight > maxVariantDimension {
+			return nil, fmt.Errorf("%w: %q", ErrVariantSpecInvalid, item)
+		}
+		if len(parts) == 3 {
+			if parts[2] != "crop" {
+				return nil, fmt.Errorf("%w: %q", ErrVariantSpecInvalid, item)
+			}
+			spec.Crop = true
+		}
+		seen[spec.Name] = true
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// 形式ごとのsrcset属性の値
+// 同じ幅の派生画像は最初のもののみ含める
+func Srcsets(variants []Variant, url func(key string) string) map[string]string {
+	entries := map[string][]string{}
+	widths := map[string]map[int]bool{}
+	for _, v := range variants {
+		if widths[v.ContentType] == nil {
+			widths[v.ContentType] = map[int]bool{}
+		}
+		if widths[v.ContentType][v.Width] {
+			continue
+		}
+		widths[v.ContentType][v.Width] = true
+		entries[v.ContentType] = append(entries[v.ContentType], fmt.Sprintf("%s %dw", url(v.StorageKey), v.Width))
+	}
+
+	srcsets := make(map[string]string, len(entries))
+	for contentType, list := range entries {
+		srcsets[contentType] = strings.Join(list, ", ")
+	}
+	return srcsets
+}
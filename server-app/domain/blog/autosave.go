@@ -0,0 +1,76 @@
+package blog
+
+import (
+	"fmt"
+	"time"
+)
+
+// 自動保存の保持期間のデフォルト値
+const DefaultAutosaveTTL = 7 * 24 * time.Hour
+
+// 自動保存できるタイトル・本文の最大長
+// 保存時の上限を一時的に超えた編集中の内容も失わないよう余裕を持たせる
+const (
+	MaxAutosaveTitleLength   = 200
+	MaxAutosaveContentLength = 32000
+)
+
+// 保存前の編集中のタイトル・本文
+// BlogIDが0の場合は新規作成中の下書き
+type Autosave struct {
+	UserID      uint      `json:"userId"`
+	BlogID      uint      `json:"blogId"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	BaseVersion uint      `json:"baseVersion"` // 編集元のブログのバージョン（新規作成中は0）
+	SavedAt     time.Time `json:"savedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"` // 保持期間を過ぎると削除される
+}
+
+// 自動保存を生成
+// 編集中の内容のため空のタイトル・本文も許可する
+func NewAutosave(userID, blogID uint, title, content string, baseVersion uint) (*Autosave, error) {
+	if len(title) > MaxAutosaveTitleLength {
+		return nil, fmt.Errorf("%w: title exceeds %d bytes", ErrAutosaveInvalid, MaxAutosaveTitleLength)
+	}
+	if len(content) > MaxAutosaveContentLength {
+		return nil, fmt.Errorf("%w: content exceeds %d bytes", ErrAutosaveInvalid, MaxAutosaveContentLength)
+	}
+	if blogID == 0 && baseVersion != 0 {
+		return nil, fmt.Errorf("%w: new draft has no base version", ErrAutosaveInvalid)
+	}
+	return &Autosave{
+		UserID:      userID,
+		BlogID:      blogID,
+		Title:       title,
+		Content:     content,
+		BaseVersion: baseVersion,
+	}, nil
+}
+
+// 自動保存と保存済みのブログの比較
+type AutosaveComparison struct {
+	Autosave *Autosave
+	Blog     *Blog // 新規作成中の下書きの場合はnil
+	Changed  bool  // 保存済みの内容と異なる
+	Outdated bool  // 編集元のバージョンより後に保存済みのブログが更新された
+	Title    []DiffSegment
+	Lines    []DiffLine
+}
+
+// 保存済みのブログから自動保存への差分を計算
+// 新規作成中の下書きは空のブログとの差分とする
+func CompareAutosave(autosave *Autosave, blog *Blog) *AutosaveComparison {
+	var title, content string
+	if blog != nil {
+		title, content = blog.Title, blog.Content
+	}
+	return &AutosaveComparison{
+		Autosave: autosave,
+		Blog:     blog,
+		Changed:  autosave.Title != title || autosave.Content != content,
+		Outdated: blog != nil && blog.Version > autosave.BaseVersion,
+		Title:    DiffWords(title, autosave.Title),
+		Lines:    DiffLines(content, autosave.Content),
+	}
+}
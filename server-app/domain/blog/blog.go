@@ -7,12 +7,20 @@ import (
 )
 
 type Blog struct {
-	ID        uint            `json:"id" gorm:"primaryKey"`
-	AuthorID  uint            `json:"authorId" gorm:"column:user_id"`                  // 外部キー
-	Author    domainUser.User `json:"author" gorm:"foreignKey:AuthorID;references:ID"` // Userへ参照
-	Title     string          `json:"title" binding:"required,min=1,max=50"`
-	Content   string          `json:"content" binding:"required,min=1,max=8000"`
-	CreatedAt time.Time       `json:"createdAt"`
-	UpdatedAt time.Time       `json:"updatedAt"`
-	DeletedAt *time.Time      `json:"deletedAt" gorm:"index"`
+	ID          uint            `json:"id" gorm:"primaryKey"`
+	AuthorID    uint            `json:"authorId" gorm:"column:user_id"`                  // 外部キー
+	Author      domainUser.User `json:"author" gorm:"foreignKey:AuthorID;references:ID"` // Userへ参照
+	Title       string          `json:"title" binding:"required,min=1,max=50"`
+	Slug        string          `json:"slug" gorm:"not null"` // 著者ごとに一意なURL用の識別子
+	Content     string          `json:"content" binding:"required,min=1,max=8000"`
+	Version     uint            `json:"version" gorm:"not null;default:1"` // 楽観的排他制御用のバージョン
+	Status      string          `json:"status" gorm:"not null;default:draft"`
+	PublishAt   *time.Time      `json:"publishAt"`   // 予約公開日時
+	PublishedAt *time.Time      `json:"publishedAt"` // 公開日時
+	Visibility  string          `json:"visibility" gorm:"not null;default:public"`
+	ShareToken  string          `json:"-" gorm:"not null"` // 限定公開用の共有URLのトークン
+	CreatedAt   time.Time       `json:"createdAt"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	DeletedAt   *time.Time      `json:"deletedAt" gorm:"index"`
+	Reactions   map[string]int  `json:"reactions,omitempty" gorm:"-"` // 絵文字ごとのリアクション数（一覧取得時のみ）
 }
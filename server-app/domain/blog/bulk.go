@@ -0,0 +1,123 @@
+package blog
+
+import "fmt"
+
+// 一括操作の種類
+const (
+	BulkActionDelete         = "delete"          // ゴミ箱へ移動
+	BulkActionRestore        = "restore"         // ゴミ箱から元に戻す
+	BulkActionVisibility     = "visibility"      // 公開範囲の変更
+	BulkActionAddCategory    = "add_category"    // カテゴリの紐付け
+	BulkActionRemoveCategory = "remove_category" // カテゴリの紐付け解除
+)
+
+// 一括操作1回あたりの最大件数
+const MaxBulkItems = 100
+
+// 一括操作の各ブログの結果
+const (
+	BulkResultApplied   = "applied"   // 操作を適用した（ドライランでは適用可能）
+	BulkResultUnchanged = "unchanged" // 既に操作後の状態のため変更なし
+	BulkResultFailed    = "failed"    // 操作できない
+)
+
+// 一括操作を適用できなかった理由
+const (
+	BulkReasonNotFound     = "not_found"
+	BulkReasonUnauthorized = "unauthorized"
+	BulkReasonTrashed      = "trashed"     // ゴミ箱内のブログは削除・復元以外の操作不可
+	BulkReasonNotTrashed   = "not_trashed" // ゴミ箱に無いブログは復元不可
+)
+
+// ブログの一括操作
+// DryRunの場合は各ブログの結果のみを返し、変更は確定しない
+type BulkOperation struct {
+	Action     string
+	BlogIDs    []uint
+	Visibility string // BulkActionVisibilityの場合のみ
+	CategoryID uint   // BulkActionAddCategory・BulkActionRemoveCategoryの場合のみ
+	DryRun     bool
+}
+
+// 一括操作を生成
+// 重複するブログIDは最初の1件のみ対象とする
+func NewBulkOperation(action string, blogIDs []uint, visibility string, categoryID uint, dryRun bool) (*BulkOperation, error) {
+	ids := make([]uint, 0, len(blogIDs))
+	seen := make(map[uint]bool, len(blogIDs))
+	for _, id := range blogIDs {
+		if id == 0 {
+			return nil, fmt.Errorf("%w: blog id must not be zero", ErrBulkInvalid)
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: no blog ids", ErrBulkInvalid)
+	}
+	if len(ids) > MaxBulkItems {
+		return nil, fmt.Errorf("%w: at most %d blogs per request", ErrBulkInvalid, MaxBulkItems)
+	}
+
+	op := &BulkOperation{Action: action, BlogIDs: ids, DryRun: dryRun}
+	switch action {
+	case BulkActionDelete, BulkActionRestore:
+	case BulkActionVisibility:
+		if !IsVisibility(visibility) {
+			return nil, ErrVisibilityInvalid
+		}
+		op.Visibility = visibility
+	case BulkActionAddCategory, BulkActionRemoveCategory:
+		if categoryID == 0 {
+			return nil, fmt.Errorf("%w: category id is required", ErrBulkInvalid)
+		}
+		op.CategoryID = categoryID
+	default:
+		return nil, fmt.Errorf("%w: unknown action %q", ErrBulkInvalid, action)
+	}
+	return op, nil
+}
+
+// 一括操作の各ブログの結果
+type BulkItemResult struct {
+	BlogID uint
+	Result string
+	Reason string // BulkResultFailedの場合のみ
+}
+
+// 一括操作の結果
+// Itemsは指定されたブログIDの順に並ぶ
+type BulkReport struct {
+	Action    string
+	DryRun    bool
+	Items     []BulkItemResult
+	Applied   int
+	Unchanged int
+	Failed    int
+}
+
+// 各ブログの結果を追加
+func (r *BulkReport) Add(item BulkItemResult) {
+	r.Items = append(r.Items, item)
+	switch item.Result {
+	case BulkResultApplied:
+		r.Applied++
+	case BulkResultUnchanged:
+		r.Unchanged++
+	case BulkResultFailed:
+		r.Failed++
+	}
+}
+
+// 操作を適用したブログのIDを取得
+func (r *BulkReport) AppliedIDs() []uint {
+	ids := make([]uint, 0, r.Applied)
+	for _, item := range r.Items {
+		if item.Result == BulkResultApplied {
+			ids = append(ids, item.BlogID)
+		}
+	}
+	return ids
+}
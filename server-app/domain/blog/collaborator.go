@@ -0,0 +1,51 @@
+package blog
+
+import "time"
+
+// ブログに対するユーザーの権限
+const (
+	RoleOwner  = "owner"  // 著者本人（公開・削除・共同編集者の管理・所有権の移譲が可能）
+	RoleEditor = "editor" // 共同編集者（本文の編集・更新履歴からの復元・カテゴリ/タグの設定・コメントの承認が可能）
+	RoleViewer = "viewer" // 閲覧者（非公開のブログ・更新履歴の閲覧のみ可能）
+)
+
+// 共同編集者の招待状態
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+)
+
+// ブログの共同編集者
+// 招待を承諾するまで権限は有効にならない
+type Collaborator struct {
+	BlogID      uint       `json:"blogId" gorm:"primaryKey"`
+	UserID      uint       `json:"userId" gorm:"primaryKey"`
+	Username    string     `json:"username" gorm:"->"`  // 一覧取得時のみ
+	BlogTitle   string     `json:"blogTitle" gorm:"->"` // 招待一覧取得時のみ
+	Role        string     `json:"role" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;default:pending"`
+	InvitedBy   uint       `json:"invitedBy" gorm:"not null"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	RespondedAt *time.Time `json:"respondedAt"`
+}
+
+// 共同編集者として招待可能な権限かを判定
+func IsCollaboratorRole(role string) bool {
+	return role == RoleEditor || role == RoleViewer
+}
+
+// 承諾済みで権限が有効かを判定
+func (c *Collaborator) IsActive() bool {
+	return c.Status == InvitationAccepted
+}
+
+// 権限が指定した権限のいずれかに該当するかを判定
+func HasRole(role string, allowed ...string) bool {
+	for _, a := range allowed {
+		if role == a {
+			return true
+		}
+	}
+	return false
+}
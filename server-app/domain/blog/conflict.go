@@ -0,0 +1,17 @@
+package blog
+
+// 更新競合エラー
+// 更新時点のサーバー上のブログと、競合により反映されなかったブログを保持する
+type VersionConflictError struct {
+	Current  *Blog
+	Rejected *Blog
+}
+
+func (e *VersionConflictError) Error() string {
+	return ErrBlogVersionConflict.Error()
+}
+
+// errors.Is(err, ErrBlogVersionConflict)で判定できるようにする
+func (e *VersionConflictError) Unwrap() error {
+	return ErrBlogVersionConflict
+}
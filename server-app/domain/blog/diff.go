@@ -0,0 +1,211 @@
+package blog
+
+import (
+	"strings"
+	"unicode"
+)
+
+// 差分の種類
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// 差分計算で扱う最大のDP表サイズ
+// 超える場合は変更範囲全体を削除・追加として扱う
+const maxDiffCells = 4_000_000
+
+// 単語単位の差分
+type DiffSegment struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// 行単位の差分
+// 変更された行には行内の単語単位の差分を含める
+type DiffLine struct {
+	Op       string        `json:"op"`
+	Text     string        `json:"text"`
+	Segments []DiffSegment `json:"segments,omitempty"`
+}
+
+// 行単位の差分を計算
+func DiffLines(from, to string) []DiffLine {
+	ops := diffTokens(splitLines(from), splitLines(to))
+
+	lines := make([]DiffLine, 0, len(ops))
+	for i := 0; i < len(ops); {
+		if ops[i].Op == DiffEqual {
+			lines = append(lines, DiffLine{Op: DiffEqual, Text: ops[i].Text})
+			i++
+			continue
+		}
+
+		// 連続する削除行・追加行を取り出し、先頭から順に対応付けて行内差分を付与
+		var deleted, inserted []string
+		for ; i < len(ops) && ops[i].Op == DiffDelete; i++ {
+			deleted = append(deleted, ops[i].Text)
+		}
+		for ; i < len(ops) && ops[i].Op == DiffInsert; i++ {
+			inserted = append(inserted, ops[i].Text)
+		}
+		deletedLines := make([]DiffLine, len(deleted))
+		insertedLines := make([]DiffLine, len(inserted))
+		for j, text := range deleted {
+			deletedLines[j] = DiffLine{Op: DiffDelete, Text: text}
+		}
+		for j, text := range inserted {
+			insertedLines[j] = DiffLine{Op: DiffInsert, Text: text}
+		}
+		for j := 0; j < len(deleted) && j < len(inserted); j++ {
+			segments := DiffWords(deleted[j], inserted[j])
+			deletedLines[j].Segments = filterSegments(segments, DiffInsert)
+			insertedLines[j].Segments = filterSegments(segments, DiffDelete)
+		}
+		lines = append(lines, deletedLines...)
+		lines = append(lines, insertedLines...)
+	}
+	return lines
+}
+
+// 単語単位の差分を計算
+// 日本語など空白で区切られない文字は1文字を1単語として扱う
+func DiffWords(from, to string) []DiffSegment {
+	ops := diffTokens(splitWords(from), splitWords(to))
+
+	// 同じ種類の連続する単語を結合
+	segments := make([]DiffSegment, 0, len(ops))
+	for _, op := range ops {
+		if n := len(segments); n > 0 && segments[n-1].Op == op.Op {
+			segments[n-1].Text += op.Text
+			continue
+		}
+		segments = append(segments, op)
+	}
+	return segments
+}
+
+// 指定した種類の差分を除外
+func filterSegments(segments []DiffSegment, exclude string) []DiffSegment {
+	filtered := make([]DiffSegment, 0, len(segments))
+	for _, s := range segments {
+		if s.Op != exclude {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// トークン列の差分を最長共通部分列により計算
+func diffTokens(a, b []string) []DiffSegment {
+	// 共通の先頭・末尾を除いて計算量を抑える
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]DiffSegment, 0, len(a)+len(b))
+	for _, t := range a[:prefix] {
+		ops = append(ops, DiffSegment{Op: DiffEqual, Text: t})
+	}
+	ops = append(ops, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, t := range a[len(a)-suffix:] {
+		ops = append(ops, DiffSegment{Op: DiffEqual, Text: t})
+	}
+	return ops
+}
+
+func diffMiddle(a, b []string) []DiffSegment {
+	ops := make([]DiffSegment, 0, len(a)+len(b))
+	if len(a)*len(b) > maxDiffCells {
+		for _, t := range a {
+			ops = append(ops, DiffSegment{Op: DiffDelete, Text: t})
+		}
+		for _, t := range b {
+			ops = append(ops, DiffSegment{Op: DiffInsert, Text: t})
+		}
+		return ops
+	}
+
+	// lcs[i][j]はa[i:]とb[j:]の最長共通部分列の長さ
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, DiffSegment{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, DiffSegment{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			ops = append(ops, DiffSegment{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, DiffSegment{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, DiffSegment{Op: DiffInsert, Text: b[j]})
+	}
+	return ops
+}
+
+// 改行で行に分割
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// 英数字の連続、空白の連続、その他の1文字を単語として分割
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isWordRune(runes[i]):
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		case unicode.IsSpace(runes[i]):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		}
+		words = append(words, string(runes[i:j]))
+		i = j
+	}
+	return words
+}
+
+// 空白で区切られる言語の単語を構成する文字かを判定
+func isWordRune(r rune) bool {
+	return r < unicode.MaxLatin1 && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
@@ -0,0 +1,131 @@
+package blog
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffWords(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		expected []DiffSegment
+	}{
+		{
+			name:     "identical",
+			from:     "hello world",
+			to:       "hello world",
+			expected: []DiffSegment{{Op: DiffEqual, Text: "hello world"}},
+		},
+		{
+			name: "inserted word",
+			from: "hello world",
+			to:   "hello go world",
+			expected: []DiffSegment{
+				{Op: DiffEqual, Text: "hello "},
+				{Op: DiffInsert, Text: "go "},
+				{Op: DiffEqual, Text: "world"},
+			},
+		},
+		{
+			name: "replaced word is not split into characters",
+			from: "use gin framework",
+			to:   "use echo framework",
+			expected: []DiffSegment{
+				{Op: DiffEqual, Text: "use "},
+				{Op: DiffDelete, Text: "gin"},
+				{Op: DiffInsert, Text: "echo"},
+				{Op: DiffEqual, Text: " framework"},
+			},
+		},
+		{
+			name: "japanese is compared by character",
+			from: "今日は晴れ",
+			to:   "今日は雨",
+			expected: []DiffSegment{
+				{Op: DiffEqual, Text: "今日は"},
+				{Op: DiffDelete, Text: "晴れ"},
+				{Op: DiffInsert, Text: "雨"},
+			},
+		},
+		{
+			name:     "from empty",
+			from:     "",
+			to:       "new",
+			expected: []DiffSegment{{Op: DiffInsert, Text: "new"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DiffWords(tt.from, tt.to))
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	t.Run("changed line has word segments", func(t *testing.T) {
+		lines := DiffLines("# Title\nuse gin\nend", "# Title\nuse echo\nend")
+		assert.Equal(t, []DiffLine{
+			{Op: DiffEqual, Text: "# Title"},
+			{Op: DiffDelete, Text: "use gin", Segments: []DiffSegment{
+				{Op: DiffEqual, Text: "use "},
+				{Op: DiffDelete, Text: "gin"},
+			}},
+			{Op: DiffInsert, Text: "use echo", Segments: []DiffSegment{
+				{Op: DiffEqual, Text: "use "},
+				{Op: DiffInsert, Text: "echo"},
+			}},
+			{Op: DiffEqual, Text: "end"},
+		}, lines)
+	})
+
+	t.Run("unpaired lines have no segments", func(t *testing.T) {
+		lines := DiffLines("a\nb", "a\nB\nc")
+		assert.Equal(t, []DiffLine{
+			{Op: DiffEqual, Text: "a"},
+			{Op: DiffDelete, Text: "b", Segments: []DiffSegment{{Op: DiffDelete, Text: "b"}}},
+			{Op: DiffInsert, Text: "B", Segments: []DiffSegment{{Op: DiffInsert, Text: "B"}}},
+			{Op: DiffInsert, Text: "c"},
+		}, lines)
+	})
+
+	t.Run("line endings are ignored", func(t *testing.T) {
+		lines := DiffLines("a\r\nb", "a\nb")
+		assert.Equal(t, []DiffLine{
+			{Op: DiffEqual, Text: "a"},
+			{Op: DiffEqual, Text: "b"},
+		}, lines)
+	})
+
+	t.Run("empty contents", func(t *testing.T) {
+		assert.Empty(t, DiffLines("", ""))
+		assert.Equal(t, []DiffLine{{Op: DiffInsert, Text: "x"}}, DiffLines("", "x"))
+		assert.Equal(t, []DiffLine{{Op: DiffDelete, Text: "x"}}, DiffLines("x", ""))
+	})
+
+	t.Run("too large change is treated as whole replacement", func(t *testing.T) {
+		var from, to []string
+		for i := 0; i <= 2000; i++ {
+			from = append(from, fmt.Sprintf("old %d", i))
+			to = append(to, fmt.Sprintf("new %d", i))
+		}
+		// LCSを計算すれば共通行となる行を中央に置く
+		from[1000], to[1000] = "common", "common"
+
+		lines := DiffLines(strings.Join(from, "\n"), strings.Join(to, "\n"))
+		if assert.Len(t, lines, len(from)+len(to)) {
+			for i, line := range lines {
+				if i < len(from) {
+					assert.Equal(t, DiffDelete, line.Op)
+				} else {
+					assert.Equal(t, DiffInsert, line.Op)
+				}
+			}
+		}
+	})
+}
@@ -4,14 +4,27 @@ import "errors"
 
 // ドメインエラーの定義
 var (
-	ErrBlogNotFound        = errors.New("blog not found")
-	ErrBlogAlreadyExists   = errors.New("blog with the same title already exists")
-	ErrBlogInvalidData     = errors.New("blog data is invalid")
-	ErrBlogUnauthorized    = errors.New("unauthorized access to this blog")
-	ErrBlogContentEmpty    = errors.New("blog content cannot be empty")
-	ErrBlogTitleTooLong    = errors.New("blog title exceeds maximum length")
-	ErrBlogTitleEmpty      = errors.New("blog title cannot be empty")
-	ErrBlogVersionConflict = errors.New("blog has been modified by another user")
-	ErrBlogDeleted         = errors.New("blog has been deleted")
-	ErrBlogPublishFailed   = errors.New("failed to publish blog")
+	ErrBlogNotFound         = errors.New("blog not found")
+	ErrBlogAlreadyExists    = errors.New("blog with the same title already exists")
+	ErrBlogInvalidData      = errors.New("blog data is invalid")
+	ErrBlogUnauthorized     = errors.New("unauthorized access to this blog")
+	ErrBlogContentEmpty     = errors.New("blog content cannot be empty")
+	ErrBlogTitleTooLong     = errors.New("blog title exceeds maximum length")
+	ErrBlogTitleEmpty       = errors.New("blog title cannot be empty")
+	ErrBlogVersionConflict  = errors.New("blog has been modified by another user")
+	ErrBlogDeleted          = errors.New("blog has been deleted")
+	ErrBlogPublishFailed    = errors.New("failed to publish blog")
+	ErrBlogInvalidQuery     = errors.New("blog list query is invalid")
+	ErrRevisionNotFound     = errors.New("blog revision not found")
+	ErrRenderCacheMiss      = errors.New("rendered content is not cached")
+	ErrSlugInvalid          = errors.New("blog slug is invalid")
+	ErrSlugAlreadyExists    = errors.New("blog slug is already used by another blog")
+	ErrVisibilityInvalid    = errors.New("blog visibility is invalid")
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+	ErrCollaboratorExists   = errors.New("user is already a collaborator of this blog")
+	ErrCollaboratorInvalid  = errors.New("collaborator invitation is invalid")
+	ErrBulkInvalid          = errors.New("bulk operation is invalid")
+	ErrAutosaveNotFound     = errors.New("autosave not found")
+	ErrAutosaveInvalid      = errors.New("autosave data is invalid")
+	ErrAutosaveUnavailable  = errors.New("autosave store is unavailable")
 )
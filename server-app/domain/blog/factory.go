@@ -18,6 +18,8 @@ func NewBlog(authorID uint, title, content string) (*Blog, error) {
 		AuthorID: authorID,
 		Title:    title,
 		Content:  content,
+		Version:  1,
+		Status:   StatusDraft,
 		// CreatedAt, UpdatedAt GORM自動で設定
 	}, nil
 }
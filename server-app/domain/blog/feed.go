@@ -0,0 +1,71 @@
+package blog
+
+import (
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// フィードの取得件数
+const (
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 50
+)
+
+// フィードの取得条件
+// 著者・カテゴリのいずれも未指定の場合はサイト全体を対象とする
+type FeedQuery struct {
+	AuthorName  string // 指定時は著者名で絞り込む
+	CategoryID  uint   // 指定時は子孫カテゴリを含めて絞り込む
+	CategoryIDs []uint // UseCaseで子孫カテゴリまで展開したID
+	Limit       int
+}
+
+// 未指定項目へデフォルト値を設定し、取得条件を検証
+func (q *FeedQuery) Normalize() error {
+	if q.Limit == 0 {
+		q.Limit = DefaultFeedLimit
+	}
+	if q.Limit < 0 || q.Limit > MaxFeedLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrBlogInvalidQuery, MaxFeedLimit)
+	}
+	return nil
+}
+
+// フィード
+type Feed struct {
+	Title string // 絞り込み対象の著者名・カテゴリ名（サイト全体の場合は空）
+	Blogs []Blog // 公開日時の新しい順
+}
+
+// 最終更新日時
+// 掲載するブログの公開日時・更新日時の最大値とする
+func (f *Feed) UpdatedAt() time.Time {
+	var updated time.Time
+	for i := range f.Blogs {
+		if modified := f.Blogs[i].ModifiedAt(); modified.After(updated) {
+			updated = modified
+		}
+	}
+	return updated
+}
+
+// 掲載内容の識別値
+// 掲載するブログとそのバージョンが変わらない限り同じ値となる
+func (f *Feed) Fingerprint() string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s\n", f.Title)
+	for i := range f.Blogs {
+		fmt.Fprintf(h, "%d:%d\n", f.Blogs[i].ID, f.Blogs[i].Version)
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// ブログの最終更新日時
+// 予約公開されたブログは更新日時より公開日時が新しい場合がある
+func (b *Blog) ModifiedAt() time.Time {
+	if b.PublishedAt != nil && b.PublishedAt.After(b.UpdatedAt) {
+		return *b.PublishedAt
+	}
+	return b.UpdatedAt
+}
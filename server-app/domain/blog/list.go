@@ -0,0 +1,148 @@
+package blog
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ブログ一覧の並び替えキー
+const (
+	SortByCreated = "created"
+	SortByUpdated = "updated"
+	SortByTitle   = "title"
+)
+
+// ブログ一覧の並び順
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// ブログ一覧の取得件数
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// ブログ一覧の取得条件
+type ListQuery struct {
+	AuthorID      uint   // 0の場合は全ての著者のブログを対象とする
+	ViewerID      uint   // 著者未指定時、公開済み以外のブログは閲覧者自身のもののみ対象とする
+	CategoryID    uint   // 指定時は子孫カテゴリを含めて絞り込む
+	CategoryIDs   []uint // UseCaseで子孫カテゴリまで展開したID
+	TagID         uint   // 指定時はタグで絞り込む
+	Status        string // 指定時は公開ステータスで絞り込む
+	Limit         int
+	SortBy        string
+	Order         string
+	Cursor        *Cursor
+	TitleContains string
+	CreatedFrom   *time.Time // 以上
+	CreatedTo     *time.Time // 未満
+}
+
+// 未指定項目へデフォルト値を設定し、取得条件を検証
+func (q *ListQuery) Normalize() error {
+	if q.Limit == 0 {
+		q.Limit = DefaultPageLimit
+	}
+	if q.SortBy == "" {
+		q.SortBy = SortByCreated
+	}
+	if q.Order == "" {
+		q.Order = OrderDesc
+	}
+
+	if q.Limit < 0 || q.Limit > MaxPageLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrBlogInvalidQuery, MaxPageLimit)
+	}
+	switch q.SortBy {
+	case SortByCreated, SortByUpdated, SortByTitle:
+	default:
+		return fmt.Errorf("%w: unknown sort key %q", ErrBlogInvalidQuery, q.SortBy)
+	}
+	if q.Order != OrderAsc && q.Order != OrderDesc {
+		return fmt.Errorf("%w: unknown order %q", ErrBlogInvalidQuery, q.Order)
+	}
+	if q.Status != "" && !IsStatus(q.Status) {
+		return fmt.Errorf("%w: unknown status %q", ErrBlogInvalidQuery, q.Status)
+	}
+	if q.CreatedFrom != nil && q.CreatedTo != nil && !q.CreatedFrom.Before(*q.CreatedTo) {
+		return fmt.Errorf("%w: from must be before to", ErrBlogInvalidQuery)
+	}
+	// カーソルは発行時と同じ並び替え条件でのみ有効
+	if q.Cursor != nil && (q.Cursor.SortBy != q.SortBy || q.Cursor.Order != q.Order) {
+		return fmt.Errorf("%w: cursor does not match sort condition", ErrBlogInvalidQuery)
+	}
+	return nil
+}
+
+// ページングされたブログ一覧
+type Page struct {
+	Blogs      []Blog
+	NextCursor string
+	PrevCursor string
+	HasNext    bool
+	HasPrev    bool
+}
+
+// 一覧上の位置を表すカーソル
+// クライアントには不透明な文字列として受け渡す
+type Cursor struct {
+	SortBy   string `json:"s"`
+	Order    string `json:"o"`
+	Value    string `json:"v"`
+	ID       uint   `json:"i"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+// ブログの並び替えキーの値からカーソルを生成
+// backwardがtrueの場合はこの位置より前のページを指す
+func NewCursor(b *Blog, sortBy, order string, backward bool) *Cursor {
+	var value string
+	switch sortBy {
+	case SortByUpdated:
+		value = b.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case SortByTitle:
+		value = b.Title
+	default:
+		value = b.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+	return &Cursor{
+		SortBy:   sortBy,
+		Order:    order,
+		Value:    value,
+		ID:       b.ID,
+		Backward: backward,
+	}
+}
+
+// カーソルを不透明な文字列に変換
+func (c *Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// 日時の並び替えキーの値を取得
+func (c *Cursor) TimeValue() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid cursor value", ErrBlogInvalidQuery)
+	}
+	return t, nil
+}
+
+// 不透明な文字列からカーソルを復元
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrBlogInvalidQuery)
+	}
+	cursor := Cursor{}
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == 0 {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrBlogInvalidQuery)
+	}
+	return &cursor, nil
+}
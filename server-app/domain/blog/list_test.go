@@ -0,0 +1,128 @@
+package blog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListQuery_Normalize(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("defaults are applied", func(t *testing.T) {
+		q := ListQuery{}
+		if assert.NoError(t, q.Normalize()) {
+			assert.Equal(t, DefaultPageLimit, q.Limit)
+			assert.Equal(t, SortByCreated, q.SortBy)
+			assert.Equal(t, OrderDesc, q.Order)
+		}
+	})
+
+	tests := []struct {
+		name    string
+		query   ListQuery
+		wantErr bool
+	}{
+		{name: "max limit", query: ListQuery{Limit: MaxPageLimit}},
+		{name: "limit over max", query: ListQuery{Limit: MaxPageLimit + 1}, wantErr: true},
+		{name: "negative limit", query: ListQuery{Limit: -1}, wantErr: true},
+		{name: "sort by title asc", query: ListQuery{SortBy: SortByTitle, Order: OrderAsc}},
+		{name: "unknown sort key", query: ListQuery{SortBy: "views"}, wantErr: true},
+		{name: "unknown order", query: ListQuery{Order: "random"}, wantErr: true},
+		{name: "known status", query: ListQuery{Status: StatusPublished}},
+		{name: "unknown status", query: ListQuery{Status: "deleted"}, wantErr: true},
+		{name: "valid period", query: ListQuery{CreatedFrom: &from, CreatedTo: &to}},
+		{name: "empty period", query: ListQuery{CreatedFrom: &from, CreatedTo: &from}, wantErr: true},
+		{name: "reversed period", query: ListQuery{CreatedFrom: &to, CreatedTo: &from}, wantErr: true},
+		{
+			name:  "cursor matching sort condition",
+			query: ListQuery{SortBy: SortByUpdated, Order: OrderAsc, Cursor: &Cursor{SortBy: SortByUpdated, Order: OrderAsc, ID: 1}},
+		},
+		{
+			name:    "cursor issued for another sort key",
+			query:   ListQuery{SortBy: SortByTitle, Cursor: &Cursor{SortBy: SortByCreated, Order: OrderDesc, ID: 1}},
+			wantErr: true,
+		},
+		{
+			name:    "cursor issued for another order",
+			query:   ListQuery{Order: OrderAsc, Cursor: &Cursor{SortBy: SortByCreated, Order: OrderDesc, ID: 1}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.query.Normalize()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrBlogInvalidQuery)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.FixedZone("JST", 9*60*60))
+	blog := &Blog{ID: 42, Title: "Go入門", CreatedAt: createdAt, UpdatedAt: createdAt.Add(time.Hour)}
+
+	t.Run("round trip keeps all fields", func(t *testing.T) {
+		cursor := NewCursor(blog, SortByTitle, OrderAsc, true)
+		decoded, err := DecodeCursor(cursor.Encode())
+		if assert.NoError(t, err) {
+			assert.Equal(t, cursor, decoded)
+			assert.Equal(t, "Go入門", decoded.Value)
+			assert.True(t, decoded.Backward)
+		}
+	})
+
+	t.Run("time value is kept in UTC with nanoseconds", func(t *testing.T) {
+		decoded, err := DecodeCursor(NewCursor(blog, SortByCreated, OrderDesc, false).Encode())
+		if !assert.NoError(t, err) {
+			return
+		}
+		value, err := decoded.TimeValue()
+		if assert.NoError(t, err) {
+			assert.True(t, value.Equal(createdAt))
+			assert.Equal(t, time.UTC, value.Location())
+		}
+	})
+
+	t.Run("updated sort key uses updated at", func(t *testing.T) {
+		value, err := NewCursor(blog, SortByUpdated, OrderDesc, false).TimeValue()
+		if assert.NoError(t, err) {
+			assert.True(t, value.Equal(blog.UpdatedAt))
+		}
+	})
+
+	t.Run("encoded cursor is URL safe", func(t *testing.T) {
+		assert.NotContains(t, NewCursor(blog, SortByTitle, OrderAsc, false).Encode(), "=")
+	})
+
+	t.Run("title cursor has no time value", func(t *testing.T) {
+		_, err := NewCursor(blog, SortByTitle, OrderAsc, false).TimeValue()
+		assert.ErrorIs(t, err, ErrBlogInvalidQuery)
+	})
+}
+
+func TestDecodeCursor_Malformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "not base64", input: "!!!"},
+		{name: "not json", input: "bm90LWpzb24"},
+		{name: "missing id", input: (&Cursor{SortBy: SortByCreated, Order: OrderDesc}).Encode()},
+		{name: "empty", input: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := DecodeCursor(tt.input)
+			assert.ErrorIs(t, err, ErrBlogInvalidQuery)
+			assert.Nil(t, cursor)
+		})
+	}
+}
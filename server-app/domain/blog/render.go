@@ -0,0 +1,27 @@
+package blog
+
+// 本文をHTMLに変換した結果
+type RenderedContent struct {
+	HTML string     // サニタイズ済みのHTML
+	TOC  []TOCEntry // 見出しの出現順
+}
+
+// 目次の項目
+type TOCEntry struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"` // 見出しに付与したid属性の値
+}
+
+// 本文をHTMLに変換するインターフェース
+// Nameは変換方式を識別し、変換方式が変わった場合はキャッシュを無効とする
+type ContentRenderer interface {
+	Name() string
+	Render(content string) (*RenderedContent, error)
+}
+
+// 本文の変換結果をブログのバージョンごとに保持するキャッシュ
+type RenderCache interface {
+	Find(blogID, version uint, renderer string) (*RenderedContent, error)
+	Save(blogID, version uint, renderer string, rendered *RenderedContent) error
+}
@@ -1,11 +1,47 @@
 package blog
 
+import "time"
+
 // ブログRepositoryインターフェース
 type BlogRepository interface {
 	Create(blog *Blog) error
 	FindBlogByID(id uint) (*Blog, error)
 	FindBlogsByAuthorID(authorID uint) ([]Blog, error)
+	FindBlogPage(query ListQuery) (*Page, error)
 	FindBlogByAuthorID(authorID uint) (*Blog, error)
-	Update(blog *Blog) error
+	Update(blog *Blog, editorID uint) error
 	Delete(id uint) error
+	FindTrashedBlogsByAuthorID(authorID uint) ([]Blog, error)
+	FindTrashedBlogByID(id uint) (*Blog, error)
+	FindTrashedBlogIDsBefore(before time.Time, limit int) ([]uint, error)
+	Restore(id uint) error
+	Purge(id uint) error
+	ChangeStatus(id uint, from []string, change StatusChange) error
+	PublishDueBlogs(now time.Time, limit int) ([]Blog, error)
+	FindBlogBySlug(username, slug string) (*Blog, error)
+	FindBlogBySlugHistory(username, slug string) (*Blog, error)
+	IsSlugAvailable(authorID uint, slug string, exceptBlogID uint) (bool, error)
+	FindFeedBlogs(query FeedQuery) ([]Blog, error)
+	FindBlogByShareToken(token string) (*Blog, error)
+	UpdateShareToken(id uint, token string) error
+	ApplyBulk(op *BulkOperation, authorize func(blog *Blog) error) (*BulkReport, error)
+}
+
+// 共同編集者Repositoryインターフェース
+type CollaboratorRepository interface {
+	Invite(collaborator *Collaborator) error
+	FindCollaborator(blogID, userID uint) (*Collaborator, error)
+	FindCollaboratorsByBlogID(blogID uint) ([]Collaborator, error)
+	FindInvitationsByUserID(userID uint) ([]Collaborator, error)
+	Respond(blogID, userID uint, status string) error
+	Remove(blogID, userID uint) error
+	TransferOwnership(blogID, fromUserID, toUserID uint) error
+}
+
+// 編集中の内容の自動保存ストアインターフェース
+// ユーザーとブログ（新規作成中は0）ごとに最新の1件のみ保持する
+type AutosaveStore interface {
+	Save(autosave *Autosave, ttl time.Duration) error
+	Find(userID, blogID uint) (*Autosave, error)
+	Delete(userID, blogID uint) error
 }
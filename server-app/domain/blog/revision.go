@@ -0,0 +1,55 @@
+package blog
+
+import "time"
+
+// ブログの更新履歴
+// 更新により置き換えられる直前のタイトル・本文をバージョンごとに保持する
+type Revision struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	BlogID    uint      `json:"blogId"`
+	Version   uint      `json:"version"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	EditorID  uint      `json:"editorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// 更新前のブログから履歴を生成
+func NewRevision(b *Blog, editorID uint) *Revision {
+	return &Revision{
+		BlogID:   b.ID,
+		Version:  b.Version,
+		Title:    b.Title,
+		Content:  b.Content,
+		EditorID: editorID,
+	}
+}
+
+// 更新履歴の保持期間
+// 直近Keep件、またはMaxAge以内に作成された履歴のいずれかに該当すれば保持する
+// いずれも0の場合は全ての履歴を保持する
+type RevisionRetention struct {
+	Keep   int
+	MaxAge time.Duration
+}
+
+// 全ての履歴を保持するかを判定
+func (r RevisionRetention) IsUnlimited() bool {
+	return r.Keep <= 0 && r.MaxAge <= 0
+}
+
+// 更新履歴Repositoryインターフェース
+// 履歴の作成はブログ更新と同一トランザクションでBlogRepository.Updateが行う
+type RevisionRepository interface {
+	FindRevisionsByBlogID(blogID uint) ([]Revision, error)
+	FindRevision(blogID, version uint) (*Revision, error)
+	Prune(blogID uint, retention RevisionRetention, now time.Time) error
+}
+
+// 2つのバージョン間の差分
+type RevisionDiff struct {
+	From  *Revision
+	To    *Revision
+	Title []DiffSegment
+	Lines []DiffLine
+}
@@ -0,0 +1,131 @@
+package blog
+
+import "strings"
+
+// ひらがなのヘボン式ローマ字表記
+var kanaRomaji = map[rune]string{
+	'あ': "a", 'い': "i", 'う': "u", 'え': "e", 'お': "o",
+	'か': "ka", 'き': "ki", 'く': "ku", 'け': "ke", 'こ': "ko",
+	'が': "ga", 'ぎ': "gi", 'ぐ': "gu", 'げ': "ge", 'ご': "go",
+	'さ': "sa", 'し': "shi", 'す': "su", 'せ': "se", 'そ': "so",
+	'ざ': "za", 'じ': "ji", 'ず': "zu", 'ぜ': "ze", 'ぞ': "zo",
+	'た': "ta", 'ち': "chi", 'つ': "tsu", 'て': "te", 'と': "to",
+	'だ': "da", 'ぢ': "ji", 'づ': "zu", 'で': "de", 'ど': "do",
+	'な': "na", 'に': "ni", 'ぬ': "nu", 'ね': "ne", 'の': "no",
+	'は': "ha", 'ひ': "hi", 'ふ': "fu", 'へ': "he", 'ほ': "ho",
+	'ば': "ba", 'び': "bi", 'ぶ': "bu", 'べ': "be", 'ぼ': "bo",
+	'ぱ': "pa", 'ぴ': "pi", 'ぷ': "pu", 'ぺ': "pe", 'ぽ': "po",
+	'ま': "ma", 'み': "mi", 'む': "mu", 'め': "me", 'も': "mo",
+	'や': "ya", 'ゆ': "yu", 'よ': "yo",
+	'ら': "ra", 'り': "ri", 'る': "ru", 'れ': "re", 'ろ': "ro",
+	'わ': "wa", 'ゐ': "i", 'ゑ': "e", 'を': "o", 'ん': "n",
+	'ゔ': "vu", 'ゎ': "wa", 'ゕ': "ka", 'ゖ': "ke",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+	'ゃ': "ya", 'ゅ': "yu", 'ょ': "yo",
+}
+
+// 直前の文字と拗音・外来音を作る小書きの文字
+var smallKana = map[rune]string{
+	'ゃ': "a", 'ゅ': "u", 'ょ': "o",
+	'ぁ': "a", 'ぃ': "i", 'ぅ': "u", 'ぇ': "e", 'ぉ': "o",
+}
+
+func isKana(r rune) bool {
+	return (r >= 'ぁ' && r <= 'ゖ') || (r >= 'ァ' && r <= 'ヺ') || r == 'ー'
+}
+
+// カタカナをひらがなに変換
+func toHiragana(r rune) rune {
+	if r >= 'ァ' && r <= 'ヶ' {
+		return r - 0x60
+	}
+	return r
+}
+
+// かなをローマ字に変換し、それ以外の文字はそのまま返す
+// かなとそれ以外の文字の境界には空白を挟む
+func romanize(runes []rune) string {
+	var sb strings.Builder
+	var syllables []string
+	inKana := false
+	geminate := false
+
+	flush := func() {
+		sb.WriteString(strings.Join(syllables, ""))
+		syllables = syllables[:0]
+	}
+
+	for _, r := range runes {
+		if !isKana(r) {
+			if inKana {
+				flush()
+				sb.WriteByte(' ')
+				inKana = false
+			}
+			sb.WriteRune(r)
+			continue
+		}
+		if !inKana && sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		inKana = true
+
+		h := toHiragana(r)
+		switch {
+		case h == 'ー':
+			// 長音は表記しない
+			continue
+		case h == 'っ':
+			geminate = true
+			continue
+		case h >= 'ヷ' && h <= 'ヺ':
+			syllables = append(syllables, []string{"va", "vi", "ve", "vo"}[h-'ヷ'])
+			continue
+		}
+
+		romaji, ok := kanaRomaji[h]
+		if !ok {
+			continue
+		}
+		if vowel, small := smallKana[h]; small && len(syllables) > 0 {
+			syllables[len(syllables)-1] = combineSmallKana(syllables[len(syllables)-1], h, vowel)
+			continue
+		}
+		// 促音は次の子音を重ねる（chはtchとする）
+		if geminate {
+			switch {
+			case strings.HasPrefix(romaji, "ch"):
+				romaji = "t" + romaji
+			case !strings.ContainsRune("aiueon", rune(romaji[0])):
+				romaji = romaji[:1] + romaji
+			}
+			geminate = false
+		}
+		syllables = append(syllables, romaji)
+	}
+	flush()
+	return sb.String()
+}
+
+// 拗音（きゃ→kya、しゃ→sha）と外来音（ふぁ→fa、てぃ→ti）を合成
+func combineSmallKana(prev string, small rune, vowel string) string {
+	switch small {
+	case 'ゃ', 'ゅ', 'ょ':
+		if !strings.HasSuffix(prev, "i") || len(prev) < 2 {
+			return prev + kanaRomaji[small]
+		}
+		stem := prev[:len(prev)-1]
+		if strings.HasSuffix(stem, "sh") || strings.HasSuffix(stem, "ch") || strings.HasSuffix(stem, "j") {
+			return stem + vowel
+		}
+		return stem + "y" + vowel
+	default:
+		switch prev {
+		case "u":
+			return "w" + vowel
+		case "a", "i", "e", "o", "n":
+			return prev + vowel
+		}
+		return prev[:len(prev)-1] + vowel
+	}
+}
@@ -0,0 +1,181 @@
+package blog
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// 全文検索の取得件数
+const (
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+	MaxSearchKeywords  = 10
+)
+
+// 検索結果スニペットの前後に含める文字数
+const snippetRadius = 40
+
+// 全文検索インデックスインターフェース
+// ブログの作成・更新・削除時にUseCaseから同期する
+type SearchIndex interface {
+	Index(blog *Blog) error
+	Remove(id uint) error
+	Search(query SearchQuery) (*SearchResult, error)
+}
+
+// 全文検索の検索条件
+type SearchQuery struct {
+	Keyword     string
+	Terms       []string // Normalizeで正規化・分割した検索語
+	AuthorID    uint
+	ViewerID    uint       // 公開済み以外のブログは閲覧者自身のもののみ対象とする
+	CreatedFrom *time.Time // 以上
+	CreatedTo   *time.Time // 未満
+	Limit       int
+	Offset      int
+}
+
+// 未指定項目へデフォルト値を設定し、検索条件を検証
+func (q *SearchQuery) Normalize() error {
+	if q.Limit == 0 {
+		q.Limit = DefaultSearchLimit
+	}
+	if q.Limit < 0 || q.Limit > MaxSearchLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrBlogInvalidQuery, MaxSearchLimit)
+	}
+	if q.Offset < 0 {
+		return fmt.Errorf("%w: offset must not be negative", ErrBlogInvalidQuery)
+	}
+	if q.CreatedFrom != nil && q.CreatedTo != nil && !q.CreatedFrom.Before(*q.CreatedTo) {
+		return fmt.Errorf("%w: from must be before to", ErrBlogInvalidQuery)
+	}
+
+	// 引用符は検索式の区切りと衝突するため区切り文字として扱う
+	q.Terms = strings.Fields(strings.ReplaceAll(NormalizeSearchText(q.Keyword), `"`, " "))
+	if len(q.Terms) == 0 {
+		return fmt.Errorf("%w: keyword is required", ErrBlogInvalidQuery)
+	}
+	if len(q.Terms) > MaxSearchKeywords {
+		return fmt.Errorf("%w: too many keywords", ErrBlogInvalidQuery)
+	}
+	return nil
+}
+
+// 全文検索のヒット結果
+type SearchHit struct {
+	Blog           Blog
+	Score          float64
+	TitleHighlight string // 検索語を<mark>で囲んだHTMLエスケープ済みのタイトル
+	Snippet        string // 検索語を<mark>で囲んだHTMLエスケープ済みの本文抜粋
+}
+
+// 全文検索結果
+type SearchResult struct {
+	Hits  []SearchHit
+	Total int64
+}
+
+// 検索用にテキストを正規化
+// 全角英数記号を半角に、全角スペースを半角に変換し英字を小文字化する
+// 1文字ずつ変換するため変換前後で文字位置が一致する
+func NormalizeSearchText(s string) string {
+	return string(normalizeRunes([]rune(s)))
+}
+
+func normalizeRunes(runes []rune) []rune {
+	normalized := make([]rune, len(runes))
+	for i, r := range runes {
+		normalized[i] = normalizeRune(r)
+	}
+	return normalized
+}
+
+func normalizeRune(r rune) rune {
+	switch {
+	case r == '　':
+		return ' '
+	case r >= '！' && r <= '～':
+		r -= 0xfee0
+	}
+	return unicode.ToLower(r)
+}
+
+// 本文から最初に検索語が現れる箇所の抜粋を生成
+// 検索語は<mark>で囲み、それ以外はHTMLエスケープする
+func BuildSnippet(content string, terms []string) string {
+	runes := []rune(content)
+	normalized := normalizeRunes(runes)
+
+	// 検索語に一致する区間を記録
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(term)
+		if len(termRunes) == 0 {
+			continue
+		}
+		for i := 0; i+len(termRunes) <= len(normalized); i++ {
+			if !hasRunePrefix(normalized[i:], termRunes) {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				marked[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+
+	// 一致箇所が無い場合は先頭から抜粋
+	if first == -1 {
+		first = 0
+	}
+	start := first - snippetRadius
+	if start < 0 {
+		start = 0
+	}
+	end := first + snippetRadius*2
+	if end > len(runes) {
+		end = len(runes)
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	inMark := false
+	for i := start; i < end; i++ {
+		if marked[i] != inMark {
+			if marked[i] {
+				sb.WriteString("<mark>")
+			} else {
+				sb.WriteString("</mark>")
+			}
+			inMark = marked[i]
+		}
+		sb.WriteString(html.EscapeString(string(runes[i])))
+	}
+	if inMark {
+		sb.WriteString("</mark>")
+	}
+	if end < len(runes) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+func hasRunePrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
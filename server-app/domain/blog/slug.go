@@ -0,0 +1,98 @@
+package blog
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// スラッグの最大長
+const MaxSlugLength = 80
+
+// 一意なスラッグを探す際の連番の上限
+const MaxSlugSuffix = 100
+
+var slugRe = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// スラッグの形式を検証
+// 半角英小文字・数字をハイフンで区切った形式のみ許可する
+func ValidateSlug(slug string) error {
+	if len(slug) > MaxSlugLength || !slugRe.MatchString(slug) {
+		return fmt.Errorf("%w: %q", ErrSlugInvalid, slug)
+	}
+	return nil
+}
+
+// タイトルからスラッグを生成
+// かなはヘボン式のローマ字に変換し、漢字など変換できない文字を含む場合は英数字のみを使用する
+// 使用できる文字が残らない場合はタイトルのハッシュ値によるスラッグとする
+func GenerateSlug(title string) string {
+	runes := normalizeRunes([]rune(title))
+
+	transliterate := true
+	for _, r := range runes {
+		if unicode.IsLetter(r) && r > unicode.MaxASCII && !isKana(r) {
+			transliterate = false
+			break
+		}
+	}
+
+	var sb strings.Builder
+	if transliterate {
+		sb.WriteString(romanize(runes))
+	} else {
+		sb.WriteString(string(runes))
+	}
+
+	slug := slugify(sb.String())
+	if slug == "" {
+		h := fnv.New32a()
+		h.Write([]byte(title))
+		return fmt.Sprintf("post-%08x", h.Sum32())
+	}
+	return slug
+}
+
+// 重複時に連番を付与したスラッグ
+// 連番を付与しても最大長を超えないよう元のスラッグを切り詰める
+func SlugCandidate(base string, n int) string {
+	if n <= 1 {
+		return base
+	}
+	suffix := fmt.Sprintf("-%d", n)
+	if len(base)+len(suffix) > MaxSlugLength {
+		base = strings.TrimRight(base[:MaxSlugLength-len(suffix)], "-")
+	}
+	return base + suffix
+}
+
+// 英数字以外をハイフンに置き換え、最大長に収まるよう単語の区切りで切り詰める
+func slugify(s string) string {
+	var sb strings.Builder
+	pendingHyphen := false
+	for _, r := range s {
+		switch {
+		case r == '\'' || r == '’':
+			// 短縮形のアポストロフィは単語を区切らない
+		case r < unicode.MaxASCII && (unicode.IsLower(r) || unicode.IsDigit(r)):
+			if pendingHyphen && sb.Len() > 0 {
+				sb.WriteByte('-')
+			}
+			pendingHyphen = false
+			sb.WriteRune(r)
+		default:
+			pendingHyphen = true
+		}
+	}
+
+	slug := sb.String()
+	if len(slug) > MaxSlugLength {
+		slug = slug[:MaxSlugLength]
+		if i := strings.LastIndexByte(slug, '-'); i > 0 {
+			slug = slug[:i]
+		}
+	}
+	return slug
+}
@@ -0,0 +1,101 @@
+package blog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{name: "english title", title: "Hello, World!", expected: "hello-world"},
+		{name: "apostrophe does not split words", title: "Don't Panic", expected: "dont-panic"},
+		{name: "fullwidth alphanumerics", title: "Ｇｏ　１２３", expected: "go-123"},
+		{name: "hiragana", title: "こんにちは", expected: "konnichiha"},
+		{name: "youon", title: "しゃしん", expected: "shashin"},
+		{name: "youon with y", title: "きょうのニュース", expected: "kyounonyusu"},
+		{name: "sokuon", title: "ちょっと", expected: "chotto"},
+		{name: "sokuon before ch", title: "まっちゃ", expected: "matcha"},
+		{name: "foreign sound", title: "ファイル", expected: "fairu"},
+		{name: "v sound", title: "ヴァイオリン", expected: "vaiorin"},
+		{name: "long vowel mark is omitted", title: "サーバー", expected: "saba"},
+		{name: "kana mixed with ascii", title: "DockerとGoのテスト", expected: "docker-to-go-notesuto"},
+		{name: "kanji falls back to ascii only", title: "Go言語入門", expected: "go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slug := GenerateSlug(tt.title)
+			assert.Equal(t, tt.expected, slug)
+			assert.NoError(t, ValidateSlug(slug))
+		})
+	}
+
+	t.Run("title without usable characters uses hash", func(t *testing.T) {
+		slug := GenerateSlug("日本語の記事")
+		assert.True(t, strings.HasPrefix(slug, "post-"), slug)
+		assert.Len(t, slug, len("post-")+8)
+		assert.NoError(t, ValidateSlug(slug))
+		assert.Equal(t, slug, GenerateSlug("日本語の記事"))
+		assert.NotEqual(t, slug, GenerateSlug("日本語の日記"))
+	})
+
+	t.Run("long title is cut at word boundary", func(t *testing.T) {
+		slug := GenerateSlug(strings.Repeat("word ", 30))
+		assert.LessOrEqual(t, len(slug), MaxSlugLength)
+		assert.False(t, strings.HasSuffix(slug, "-"))
+		assert.True(t, strings.HasSuffix(slug, "word"), slug)
+		assert.NoError(t, ValidateSlug(slug))
+	})
+}
+
+func TestValidateSlug(t *testing.T) {
+	tests := []struct {
+		name    string
+		slug    string
+		wantErr bool
+	}{
+		{name: "valid", slug: "hello-world-2"},
+		{name: "max length", slug: strings.Repeat("a", MaxSlugLength)},
+		{name: "too long", slug: strings.Repeat("a", MaxSlugLength+1), wantErr: true},
+		{name: "empty", slug: "", wantErr: true},
+		{name: "uppercase", slug: "Hello", wantErr: true},
+		{name: "leading hyphen", slug: "-hello", wantErr: true},
+		{name: "trailing hyphen", slug: "hello-", wantErr: true},
+		{name: "double hyphen", slug: "hello--world", wantErr: true},
+		{name: "non ascii", slug: "こんにちは", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSlug(tt.slug)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrSlugInvalid)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSlugCandidate(t *testing.T) {
+	assert.Equal(t, "hello", SlugCandidate("hello", 1))
+	assert.Equal(t, "hello-2", SlugCandidate("hello", 2))
+
+	t.Run("long base is truncated to fit suffix", func(t *testing.T) {
+		candidate := SlugCandidate(strings.Repeat("a", MaxSlugLength), 10)
+		assert.Equal(t, strings.Repeat("a", MaxSlugLength-3)+"-10", candidate)
+		assert.NoError(t, ValidateSlug(candidate))
+	})
+
+	t.Run("trailing hyphen left by truncation is removed", func(t *testing.T) {
+		candidate := SlugCandidate(strings.Repeat("a", MaxSlugLength-4)+"-bcd", 10)
+		assert.Equal(t, strings.Repeat("a", MaxSlugLength-4)+"-10", candidate)
+		assert.NoError(t, ValidateSlug(candidate))
+	})
+}
@@ -0,0 +1,52 @@
+package blog
+
+import "time"
+
+// ブログの公開ステータス
+const (
+	StatusDraft     = "draft"
+	StatusScheduled = "scheduled"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+// 公開ステータスとして有効かを判定
+func IsStatus(status string) bool {
+	switch status {
+	case StatusDraft, StatusScheduled, StatusPublished, StatusArchived:
+		return true
+	}
+	return false
+}
+
+// 各ステータスへ遷移可能な遷移元ステータス
+var statusTransitions = map[string][]string{
+	StatusDraft:     {StatusScheduled, StatusPublished},
+	StatusScheduled: {StatusDraft, StatusScheduled},
+	StatusPublished: {StatusDraft, StatusScheduled, StatusArchived},
+	StatusArchived:  {StatusPublished},
+}
+
+// 指定ステータスへ遷移可能な遷移元ステータスを取得
+func TransitionSources(to string) []string {
+	return statusTransitions[to]
+}
+
+// 著者以外にも公開されているかを判定
+func (b *Blog) IsPublished() bool {
+	return b.Status == StatusPublished
+}
+
+// 閲覧者が参照可能かを判定
+// 公開範囲が全体公開の公開済みブログ以外は著者本人のみ参照できる
+// 限定公開のブログは共有URLのトークンによってのみ著者以外に公開される
+func (b *Blog) IsVisibleTo(viewerID uint) bool {
+	return b.IsListed() || (viewerID != 0 && b.AuthorID == viewerID)
+}
+
+// 公開ステータスの変更内容
+type StatusChange struct {
+	Status      string
+	PublishAt   *time.Time
+	PublishedAt *time.Time
+}
@@ -0,0 +1,49 @@
+package blog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsStatus(t *testing.T) {
+	for _, status := range []string{StatusDraft, StatusScheduled, StatusPublished, StatusArchived} {
+		assert.True(t, IsStatus(status), status)
+	}
+	assert.False(t, IsStatus(""))
+	assert.False(t, IsStatus("deleted"))
+	assert.False(t, IsStatus("Published"))
+}
+
+func TestTransitionSources(t *testing.T) {
+	statuses := []string{StatusDraft, StatusScheduled, StatusPublished, StatusArchived}
+
+	// allowed[from][to]
+	allowed := map[string]map[string]bool{
+		StatusDraft:     {StatusScheduled: true, StatusPublished: true},
+		StatusScheduled: {StatusDraft: true, StatusScheduled: true, StatusPublished: true},
+		StatusPublished: {StatusDraft: true, StatusArchived: true},
+		StatusArchived:  {StatusPublished: true},
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(from+" to "+to, func(t *testing.T) {
+				assert.Equal(t, allowed[from][to], containsStatus(TransitionSources(to), from))
+			})
+		}
+	}
+
+	t.Run("unknown status has no sources", func(t *testing.T) {
+		assert.Empty(t, TransitionSources("deleted"))
+	})
+}
+
+func containsStatus(statuses []string, status string) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package blog
+
+import "time"
+
+// ゴミ箱の保持期間のデフォルト値
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
+// ゴミ箱内のブログ
+// 保持期間を過ぎたものはPurgeAt以降に完全に削除される
+type TrashedBlog struct {
+	Blog    Blog
+	PurgeAt time.Time
+}
+
+// ゴミ箱に移動済みかを判定
+func (b *Blog) IsTrashed() bool {
+	return b.DeletedAt != nil
+}
+
+// 保持期間から完全削除される日時を算出
+func (b *Blog) PurgeAt(retention time.Duration) time.Time {
+	if b.DeletedAt == nil {
+		return time.Time{}
+	}
+	return b.DeletedAt.Add(retention)
+}
@@ -0,0 +1,42 @@
+package blog
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+)
+
+// ブログの公開範囲
+// 公開ステータスが公開済みの場合のみ著者以外に公開される
+const (
+	VisibilityPublic   = "public"   // 一覧・検索・フィードに掲載し、誰でも閲覧できる
+	VisibilityUnlisted = "unlisted" // 一覧等には掲載せず、共有URLを知る人のみ閲覧できる
+	VisibilityPrivate  = "private"  // 著者本人のみ閲覧できる
+)
+
+// 公開範囲として有効かを判定
+func IsVisibility(visibility string) bool {
+	switch visibility {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+// 限定公開用の共有URLのトークンを生成
+func NewShareToken() string {
+	return rand.Text()
+}
+
+// 一覧・検索・フィードに掲載するかを判定
+func (b *Blog) IsListed() bool {
+	return b.IsPublished() && b.Visibility == VisibilityPublic
+}
+
+// 共有URLのトークンで閲覧可能かを判定
+// 非公開のブログはトークンが一致しても閲覧できない
+func (b *Blog) IsSharedWith(token string) bool {
+	if !b.IsPublished() || b.Visibility == VisibilityPrivate || b.ShareToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(b.ShareToken), []byte(token)) == 1
+}
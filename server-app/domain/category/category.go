@@ -4,9 +4,11 @@ import (
 	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
 )
 
+// カテゴリはユーザーごとに管理し、作成したユーザーのみ変更・削除できる
 type Category struct {
 	ID          uint              `gorm:"primaryKey"`
-	Name        string            `gorm:"size:50;not null;unique"`
+	UserID      uint              `gorm:"not null;uniqueIndex:uk_categories_user_name"`
+	Name        string            `gorm:"size:50;not null;uniqueIndex:uk_categories_user_name"`
 	Description string            `gorm:"size:255"`
 	ParentID    *uint             `gorm:"default:null"`
 	Parent      *Category         `gorm:"foreignKey:ParentID"`
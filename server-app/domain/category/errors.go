@@ -0,0 +1,13 @@
+package category
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrCategoryNotFound      = errors.New("category not found")
+	ErrCategoryAlreadyExists = errors.New("category with the same name already exists")
+	ErrCategoryInvalidData   = errors.New("category data is invalid")
+	ErrCategoryCycle         = errors.New("category cannot be moved under itself or its descendants")
+	ErrCategoryHasChildren   = errors.New("category has child categories")
+	ErrCategoryUnauthorized  = errors.New("user is not authorized to modify this category")
+)
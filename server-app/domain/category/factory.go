@@ -0,0 +1,31 @@
+package category
+
+import (
+	"errors"
+	"unicode/utf8"
+)
+
+// DB保存用のCategoryを生成するファクトリ関数
+func NewCategory(userID uint, name, description string, parentID *uint) (*Category, error) {
+	if err := ValidateName(name); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(description) > 255 {
+		return nil, errors.New("カテゴリ説明の長さが不正です")
+	}
+
+	return &Category{
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		ParentID:    parentID,
+	}, nil
+}
+
+// カテゴリ名の長さを検証
+func ValidateName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > 50 {
+		return errors.New("カテゴリ名の長さが不正です")
+	}
+	return nil
+}
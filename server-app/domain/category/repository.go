@@ -0,0 +1,15 @@
+package category
+
+// カテゴリRepositoryインターフェース
+type CategoryRepository interface {
+	Create(category *Category) error
+	FindCategoryByID(id uint) (*Category, error)
+	FindCategoryByName(userID uint, name string) (*Category, error)
+	FindCategoriesByUserID(userID uint) ([]Category, error)
+	FindCategoriesByBlogID(blogID uint) ([]Category, error)
+	FindCategoriesByBlogIDs(blogIDs []uint) (map[uint][]Category, error)
+	Update(category *Category) error
+	Delete(id uint) error
+	AssignBlog(categoryID, blogID uint) error
+	UnassignBlog(categoryID, blogID uint) error
+}
@@ -0,0 +1,91 @@
+package category
+
+// カテゴリ一覧を親子関係のツリーに組み立てる
+// 親が一覧に存在しないカテゴリはルートとして扱う
+func BuildTree(categories []Category) []Category {
+	exists := make(map[uint]bool, len(categories))
+	for _, c := range categories {
+		exists[c.ID] = true
+	}
+
+	children := make(map[uint][]Category)
+	var roots []Category
+	for _, c := range categories {
+		if c.ParentID == nil || !exists[*c.ParentID] {
+			roots = append(roots, c)
+			continue
+		}
+		children[*c.ParentID] = append(children[*c.ParentID], c)
+	}
+
+	visited := make(map[uint]bool, len(categories))
+	var attach func(c Category) Category
+	attach = func(c Category) Category {
+		visited[c.ID] = true
+		c.Children = nil
+		for _, child := range children[c.ID] {
+			// 不正データによる循環参照を防止
+			if visited[child.ID] {
+				continue
+			}
+			c.Children = append(c.Children, attach(child))
+		}
+		return c
+	}
+
+	tree := make([]Category, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, attach(root))
+	}
+	return tree
+}
+
+// 指定カテゴリ自身と全ての子孫カテゴリのIDを返却
+func DescendantIDs(categories []Category, rootID uint) []uint {
+	children := make(map[uint][]uint)
+	for _, c := range categories {
+		if c.ParentID != nil {
+			children[*c.ParentID] = append(children[*c.ParentID], c.ID)
+		}
+	}
+
+	ids := []uint{rootID}
+	visited := map[uint]bool{rootID: true}
+	for i := 0; i < len(ids); i++ {
+		for _, childID := range children[ids[i]] {
+			if visited[childID] {
+				continue
+			}
+			visited[childID] = true
+			ids = append(ids, childID)
+		}
+	}
+	return ids
+}
+
+// カテゴリを新しい親の配下へ移動した場合に循環が発生するか判定
+func WouldCreateCycle(categories []Category, id uint, newParentID *uint) bool {
+	if newParentID == nil {
+		return false
+	}
+
+	parents := make(map[uint]*uint, len(categories))
+	for _, c := range categories {
+		parents[c.ID] = c.ParentID
+	}
+
+	// 新しい親から祖先方向へ辿り、自身に到達すれば循環
+	visited := make(map[uint]bool)
+	current := newParentID
+	for current != nil {
+		if *current == id {
+			return true
+		}
+		if visited[*current] {
+			return true
+		}
+		visited[*current] = true
+		current = parents[*current]
+	}
+	return false
+}
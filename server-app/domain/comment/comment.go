@@ -20,3 +20,20 @@ type Comment struct {
 	Parent      *Comment        `gorm:"foreignKey:ParentID"`
 	Replies     []Comment       `gorm:"foreignKey:ParentID"`
 }
+
+// コメントの公開ステータス
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+	StatusSpam     = "spam"
+)
+
+// モデレーションで設定可能なステータスか判定
+func IsModerationStatus(status string) bool {
+	switch status {
+	case StatusApproved, StatusRejected, StatusSpam:
+		return true
+	}
+	return false
+}
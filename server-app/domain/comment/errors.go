@@ -0,0 +1,12 @@
+package comment
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrCommentNotFound      = errors.New("comment not found")
+	ErrCommentInvalidData   = errors.New("comment data is invalid")
+	ErrCommentInvalidParent = errors.New("comment cannot be replied to")
+	ErrCommentInvalidStatus = errors.New("comment status is invalid")
+	ErrCommentUnauthorized  = errors.New("unauthorized access to this comment")
+)
@@ -0,0 +1,31 @@
+package comment
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// DB保存用のCommentを生成するファクトリ関数
+// Statusは承認待ち、CreatedAtはGORM自動設定
+func NewComment(postID uint, userID, parentID *uint, content, authorName, authorEmail string) (*Comment, error) {
+	if strings.TrimSpace(content) == "" || utf8.RuneCountInString(content) > 2000 {
+		return nil, errors.New("コメント本文の長さが不正です")
+	}
+	if utf8.RuneCountInString(authorName) > 100 {
+		return nil, errors.New("投稿者名の長さが不正です")
+	}
+	if utf8.RuneCountInString(authorEmail) > 100 {
+		return nil, errors.New("メールアドレスの長さが不正です")
+	}
+
+	return &Comment{
+		PostID:      postID,
+		UserID:      userID,
+		ParentID:    parentID,
+		Content:     content,
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Status:      StatusPending,
+	}, nil
+}
@@ -0,0 +1,10 @@
+package comment
+
+// コメントRepositoryインターフェース
+type CommentRepository interface {
+	Create(comment *Comment) error
+	FindCommentByID(id uint) (*Comment, error)
+	FindCommentsByPostIDAndStatus(postID uint, status string) ([]Comment, error)
+	FindCommentsByModeratorIDAndStatus(userID uint, collaboratorRoles []string, status string) ([]Comment, error)
+	UpdateStatus(id uint, status string) error
+}
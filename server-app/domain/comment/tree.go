@@ -0,0 +1,34 @@
+package comment
+
+// コメント一覧を返信関係のツリーに組み立てる
+// 親コメントが一覧に含まれない返信は表示対象外として除外する
+func BuildTree(comments []Comment) []Comment {
+	replies := make(map[uint][]Comment)
+	var roots []Comment
+	for _, c := range comments {
+		if c.ParentID == nil {
+			roots = append(roots, c)
+			continue
+		}
+		replies[*c.ParentID] = append(replies[*c.ParentID], c)
+	}
+
+	var attach func(c Comment, depth int) Comment
+	attach = func(c Comment, depth int) Comment {
+		c.Replies = nil
+		// 不正データによる循環参照を防止
+		if depth > len(comments) {
+			return c
+		}
+		for _, reply := range replies[c.ID] {
+			c.Replies = append(c.Replies, attach(reply, depth+1))
+		}
+		return c
+	}
+
+	tree := make([]Comment, 0, len(roots))
+	for _, root := range roots {
+		tree = append(tree, attach(root, 0))
+	}
+	return tree
+}
@@ -0,0 +1,117 @@
+package export
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// マニフェストの形式のバージョン
+const ManifestVersion = 1
+
+// エクスポートするブログとカテゴリ・タグ
+type Entry struct {
+	Blog       domainBlog.Blog
+	Categories []string
+	Tags       []string
+}
+
+// アーカイブの内容
+type Archive struct {
+	Username    string
+	ExportedAt  time.Time
+	Entries     []Entry
+	Attachments []domainAttachment.Attachment // 本文から参照されている、またはブログに紐づく添付ファイル
+}
+
+// アーカイブの内容一覧
+type Manifest struct {
+	Version     int                  `json:"version"`
+	Username    string               `json:"username"`
+	ExportedAt  time.Time            `json:"exportedAt"`
+	Blogs       []ManifestBlog       `json:"blogs"`
+	Attachments []ManifestAttachment `json:"attachments"`
+}
+
+type ManifestBlog struct {
+	ID          uint       `json:"id"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Path        string     `json:"path"`
+	Status      string     `json:"status"`
+	Visibility  string     `json:"visibility"`
+	Categories  []string   `json:"categories"`
+	Tags        []string   `json:"tags"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	PublishedAt *time.Time `json:"publishedAt"`
+	Attachments []string   `json:"attachments"` // 参照している添付ファイルのパス
+}
+
+type ManifestAttachment struct {
+	ID          uint   `json:"id"`
+	BlogID      *uint  `json:"blogId"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Path        string `json:"path"`
+	Missing     bool   `json:"missing,omitempty"` // ストレージに実体が無く、アーカイブに含まれていない
+}
+
+// アーカイブの書き込みのインターフェース
+// 添付ファイルの実体はopenで読み込み、ストレージに無いもの（ErrBlobNotFound）はマニフェストに記録して読み飛ばす
+type ArchiveWriter interface {
+	Write(w io.Writer, archive *Archive, open func(key string) (io.ReadCloser, error)) (*Manifest, error)
+}
+
+// 本文中の添付ファイルへのリンク（/files/<保存キー>）
+var attachmentLinkPattern = regexp.MustCompile(`/files/([0-9a-f]{2}/[0-9a-f]{64}\.[a-z]+)`)
+
+// 本文から参照されている添付ファイルの保存キーを取得
+func ReferencedKeys(content string) []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, m := range attachmentLinkPattern.FindAllStringSubmatch(content, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			keys = append(keys, m[1])
+		}
+	}
+	return keys
+}
+
+// 本文中の添付ファイルへのリンクを書き換える
+// replaceが空文字を返した場合は元のリンクのままとする
+func ReplaceAttachmentLinks(content string, replace func(key string) string) string {
+	return attachmentLinkPattern.ReplaceAllStringFunc(content, func(link string) string {
+		if replaced := replace(strings.TrimPrefix(link, "/files/")); replaced != "" {
+			return replaced
+		}
+		return link
+	})
+}
+
+// エクスポート対象の添付ファイルを抽出
+// 本文から参照されている、またはエクスポートするブログに紐づくものを対象とする
+func SelectAttachments(entries []Entry, attachments []domainAttachment.Attachment) []domainAttachment.Attachment {
+	blogIDs := map[uint]bool{}
+	keys := map[string]bool{}
+	for _, e := range entries {
+		blogIDs[e.Blog.ID] = true
+		for _, key := range ReferencedKeys(e.Blog.Content) {
+			keys[key] = true
+		}
+	}
+
+	var selected []domainAttachment.Attachment
+	for _, a := range attachments {
+		if keys[a.StorageKey] || (a.BlogID != nil && blogIDs[*a.BlogID]) {
+			selected = append(selected, a)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,13 @@
+package export
+
+import "errors"
+
+var (
+	ErrExportJobNotFound  = errors.New("export job not found")
+	ErrExportUnauthorized = errors.New("unauthorized to access export job")
+	ErrExportTooLarge     = errors.New("too many blogs to export synchronously")
+	ErrExportNotReady     = errors.New("export archive is not ready")
+	ErrExportExpired      = errors.New("export download link has expired")
+	ErrArchiveNotFound    = errors.New("export archive not found in storage")
+	ErrArchiveNameInvalid = errors.New("export archive name is invalid")
+)
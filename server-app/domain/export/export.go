@@ -0,0 +1,59 @@
+package export
+
+import (
+	"crypto/rand"
+	"time"
+)
+
+// エクスポートジョブ
+// 件数の多いエクスポートはバックグラウンドでアーカイブを作成し、期限付きのURLでダウンロードさせる
+type Job struct {
+	ID              uint       `json:"id" gorm:"primaryKey"`
+	UserID          uint       `json:"userId" gorm:"not null"`
+	Status          string     `json:"status" gorm:"size:20;not null;default:pending"`
+	BlogCount       int        `json:"blogCount"`
+	AttachmentCount int        `json:"attachmentCount"`
+	Size            int64      `json:"size"` // アーカイブのサイズ（バイト）
+	Error           string     `json:"error" gorm:"size:255"`
+	DownloadToken   string     `json:"-" gorm:"size:32;not null"` // ダウンロードURLのトークン（作成済みかつ有効期限内のみ有効）
+	CreatedAt       time.Time  `json:"createdAt"`
+	StartedAt       *time.Time `json:"startedAt"`
+	CompletedAt     *time.Time `json:"completedAt"`
+	ExpiresAt       *time.Time `json:"expiresAt"` // ダウンロードURLの有効期限
+}
+
+// エクスポートジョブの状態
+const (
+	JobPending = "pending" // 作成待ち
+	JobRunning = "running" // 作成中
+	JobDone    = "done"    // 作成済み（有効期限までダウンロード可能）
+	JobFailed  = "failed"  // 作成失敗
+	JobExpired = "expired" // 有効期限切れ（アーカイブは削除済み）
+)
+
+// 同期的に（その場でストリーミングして）エクスポートできるブログの最大件数のデフォルト値
+const DefaultSyncMaxBlogs = 100
+
+// ダウンロードURLの有効期間のデフォルト値
+const DefaultLinkTTL = 24 * time.Hour
+
+// ダウンロード可能かを判定
+func (j *Job) IsDownloadable(now time.Time) bool {
+	return j.Status == JobDone && j.ExpiresAt != nil && now.Before(*j.ExpiresAt)
+}
+
+// アーカイブの保存名
+func (j *Job) ArchiveName() string {
+	return ArchiveName(j.ID)
+}
+
+// ダウンロードURLのトークンを生成
+func NewDownloadToken() string {
+	return rand.Text()
+}
+
+// 作成待ちのエクスポートジョブのキュー
+type JobQueue interface {
+	// キューが満杯の場合はfalseを返す（作成待ちのまま後で再投入される）
+	Enqueue(jobID uint) bool
+}
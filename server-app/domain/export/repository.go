@@ -0,0 +1,15 @@
+package export
+
+import "time"
+
+// エクスポートジョブRepositoryインターフェース
+type JobRepository interface {
+	Create(job *Job) error
+	FindJobByID(id uint) (*Job, error)
+	FindJobByToken(token string) (*Job, error)
+	FindActiveJobByUserID(userID uint) (*Job, error)
+	FindJobIDsByStatus(status string, limit int) ([]uint, error)
+	FindExpiredJobIDs(now time.Time, limit int) ([]uint, error)
+	ClaimJob(id uint, startedAt time.Time) (bool, error)
+	Update(job *Job) error
+}
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+)
+
+// 作成したアーカイブを保存するストレージのインターフェース
+type ArchiveStorage interface {
+	// 書き込み用に作成する（Closeするまで読み出せない）
+	Create(name string) (io.WriteCloser, error)
+	// 保存済みのアーカイブを開く（存在しない場合はErrArchiveNotFound）
+	Open(name string) (io.ReadCloser, error)
+	// 保存済みのアーカイブを削除する（存在しない場合も成功とする）
+	Delete(name string) error
+}
+
+var archiveNamePattern = regexp.MustCompile(`^export-[0-9]+\.zip$`)
+
+// ジョブのアーカイブの保存名
+func ArchiveName(jobID uint) string {
+	return fmt.Sprintf("export-%d.zip", jobID)
+}
+
+// アーカイブの保存名として有効かを検証
+func ValidateArchiveName(name string) error {
+	if !archiveNamePattern.MatchString(name) {
+		return ErrArchiveNameInvalid
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package importer
+
+import "errors"
+
+var (
+	ErrImportFormatUnsupported = errors.New("import file format is not supported")
+	ErrImportEmpty             = errors.New("no posts found to import")
+	ErrImportTooLarge          = errors.New("import file exceeds maximum size")
+	ErrImportInvalid           = errors.New("import file is malformed")
+	ErrSourceLinkNotFound      = errors.New("import source link not found")
+)
@@ -0,0 +1,257 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"gopkg.in/yaml.v3"
+)
+
+// Jekyllの記事のファイル名（YYYY-MM-DD-スラッグ.md）
+var jekyllNameRe = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2})-(.+)$`)
+
+// メタデータの日時の形式
+var frontMatterDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05 -07:00",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Markdownのディレクトリから記事を読み込む
+// YAMLのメタデータ（---で囲んだ先頭部分）を持つ.md・.markdownファイルを対象とし、
+// 隠しディレクトリ、Hugoの一覧ページ（_index.md）、Jekyllの_posts・_drafts以外の_で始まるディレクトリは読み飛ばす
+func ParseMarkdownFS(fsys fs.FS) ([]Post, error) {
+	var paths []string
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		if d.IsDir() {
+			if p != "." && (strings.HasPrefix(name, ".") || (strings.HasPrefix(name, "_") && name != "_posts" && name != "_drafts")) {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		ext := strings.ToLower(path.Ext(name))
+		if (ext == ".md" || ext == ".markdown") && !strings.HasPrefix(name, "_") && !strings.HasPrefix(name, ".") {
+			paths = append(paths, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrImportInvalid, err)
+	}
+	sort.Strings(paths)
+
+	var posts []Post
+	for _, p := range paths {
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", p, err)
+		}
+		post, ok, err := ParseMarkdownFile(p, content)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			posts = append(posts, *post)
+		}
+	}
+	return posts, nil
+}
+
+// メタデータ付きのMarkdownファイルから記事を読み込む
+// メタデータの無いファイル（READMEなど）は記事ではないものとしてfalseを返す
+func ParseMarkdownFile(p string, content []byte) (*Post, bool, error) {
+	meta, body, ok := splitFrontMatter(content)
+	if !ok {
+		return nil, false, nil
+	}
+	fm := map[string]interface{}{}
+	if err := yaml.Unmarshal(meta, &fm); err != nil {
+		return nil, false, fmt.Errorf("%w: invalid front matter in %s: %v", ErrImportInvalid, p, err)
+	}
+
+	post := &Post{
+		Source:     SourceMarkdown,
+		SourceID:   "path:" + p,
+		Path:       p,
+		Title:      stringValue(fm["title"]),
+		Content:    body,
+		Visibility: domainBlog.VisibilityPublic,
+	}
+	if id := stringValue(fm["id"]); id != "" {
+		post.SourceID = "id:" + id
+	}
+
+	// ファイル名（Hugoのページバンドルの場合はディレクトリ名）からスラッグと日付を補う
+	name := strings.TrimSuffix(path.Base(p), path.Ext(p))
+	if name == "index" && path.Dir(p) != "." {
+		name = path.Base(path.Dir(p))
+	}
+	var nameDate time.Time
+	if m := jekyllNameRe.FindStringSubmatch(name); m != nil {
+		nameDate, _ = time.Parse("2006-01-02", m[1])
+		name = m[2]
+	}
+	post.Slug = normalizeSlug(stringValue(fm["slug"]))
+	if post.Slug == "" {
+		post.Slug = normalizeSlug(name)
+	}
+	if post.Title == "" {
+		post.Title = name
+	}
+
+	if date, ok := timeValue(fm["date"]); ok {
+		post.CreatedAt = date
+	} else {
+		post.CreatedAt = nameDate
+	}
+	post.UpdatedAt = post.CreatedAt
+	for _, key := range []string{"lastmod", "last_modified_at", "updated"} {
+		if date, ok := timeValue(fm[key]); ok && date.After(post.CreatedAt) {
+			post.UpdatedAt = date
+			break
+		}
+	}
+
+	draft := boolValue(fm["draft"]) || fm["published"] == false || strings.HasPrefix(p, "_drafts/") || strings.Contains(p, "/_drafts/")
+	publishDate, hasPublishDate := timeValue(fm["publishDate"])
+	if !hasPublishDate {
+		publishDate = post.CreatedAt
+	}
+	status := stringValue(fm["status"])
+	if status != "" && !domainBlog.IsStatus(status) {
+		post.Warnings = append(post.Warnings, fmt.Sprintf("公開状態「%s」は未対応のため無視しました", status))
+	}
+	switch {
+	case domainBlog.IsStatus(status):
+		// このアプリからエクスポートしたファイル
+		post.Status = status
+	case draft:
+		post.Status = domainBlog.StatusDraft
+	case hasPublishDate && publishDate.After(time.Now()):
+		post.Status = domainBlog.StatusScheduled
+	default:
+		post.Status = domainBlog.StatusPublished
+	}
+	switch post.Status {
+	case domainBlog.StatusPublished, domainBlog.StatusArchived:
+		if !publishDate.IsZero() {
+			post.PublishedAt = timePtr(publishDate)
+		}
+	case domainBlog.StatusScheduled:
+		post.PublishAt = timePtr(publishDate)
+	}
+	if v := stringValue(fm["visibility"]); domainBlog.IsVisibility(v) {
+		post.Visibility = v
+	} else if v != "" {
+		// 誤って公開しないよう非公開とする
+		post.Visibility = domainBlog.VisibilityPrivate
+		post.Warnings = append(post.Warnings, fmt.Sprintf("公開範囲「%s」は未対応のため非公開として取り込みました", v))
+	}
+
+	post.Categories = stringList(fm["categories"])
+	if len(post.Categories) == 0 {
+		post.Categories = stringList(fm["category"])
+	}
+	post.Tags = stringList(fm["tags"])
+	return post, true, nil
+}
+
+// 先頭の---で囲まれたメタデータと本文に分割
+func splitFrontMatter(content []byte) ([]byte, string, bool) {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	if !bytes.HasPrefix(content, []byte("---\n")) {
+		return nil, "", false
+	}
+	rest := content[4:]
+	end := bytes.Index(rest, []byte("\n---\n"))
+	var body []byte
+	switch {
+	case bytes.HasPrefix(rest, []byte("---\n")):
+		// メタデータが空
+		return nil, strings.TrimLeft(string(rest[4:]), "\n"), true
+	case end >= 0:
+		body = rest[end+5:]
+	case bytes.HasSuffix(rest, []byte("\n---")):
+		end = len(rest) - 4
+	default:
+		return nil, "", false
+	}
+	return rest[:end], strings.TrimLeft(string(body), "\n"), true
+}
+
+func stringValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(v)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	}
+	return strings.TrimSpace(fmt.Sprint(v))
+}
+
+func boolValue(v interface{}) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+func timeValue(v interface{}) (time.Time, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range frontMatterDateLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// 配列、またはカンマ・空白区切りの文字列（Jekyll）を文字列の一覧に変換
+func stringList(v interface{}) []string {
+	var values []string
+	switch v := v.(type) {
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, stringValue(item))
+		}
+	case string:
+		if strings.Contains(v, ",") {
+			values = strings.Split(v, ",")
+		} else {
+			values = strings.Fields(v)
+		}
+	}
+
+	var list []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			list = appendUnique(list, value)
+		}
+	}
+	return list
+}
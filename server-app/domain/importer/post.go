@@ -0,0 +1,45 @@
+package importer
+
+import "time"
+
+// 取り込み元の種別
+const (
+	SourceWordPress = "wordpress" // WordPressのエクスポートファイル（WXR）
+	SourceMarkdown  = "markdown"  // Hugo・Jekyll等のメタデータ付きMarkdown
+)
+
+// 取り込み元から読み込んだ記事
+type Post struct {
+	Source      string
+	SourceID    string // 取り込み元での識別子（再取り込み時の重複判定に使用）
+	Path        string // 取り込み元での位置（ファイル名等、結果の報告に使用）
+	Title       string
+	Slug        string // 空の場合はタイトルから生成する
+	Content     string
+	HTML        bool   // 本文がHTMLの場合はMarkdownに変換して取り込む
+	Status      string // ブログの状態（draft, scheduled, published）
+	Visibility  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+	PublishAt   *time.Time // 予約公開日時
+	Categories  []string
+	Tags        []string
+	Warnings    []string // 取り込み元の値を読み替えた場合の警告（結果の報告に使用）
+}
+
+// 取り込み元の記事と取り込んだブログの対応
+type SourceLink struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"not null"`
+	Source    string `gorm:"size:20;not null"`
+	SourceID  string `gorm:"size:255;not null"`
+	BlogID    uint   `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// HTMLをMarkdownに変換するインターフェース
+type HTMLConverter interface {
+	ToMarkdown(html string) (string, error)
+}
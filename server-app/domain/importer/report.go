@@ -0,0 +1,48 @@
+package importer
+
+// 記事ごとの取り込み結果
+const (
+	ActionCreate    = "create"    // 新規に作成
+	ActionUpdate    = "update"    // 取り込み済みのブログを更新
+	ActionUnchanged = "unchanged" // 取り込み済みで変更なし
+	ActionSkip      = "skip"      // 取り込まない（理由はReasonに記載）
+)
+
+// 取り込み結果
+// ドライランの場合は実際には変更せず、変更する予定の内容を報告する
+type Report struct {
+	DryRun        bool
+	Source        string
+	Created       int
+	Updated       int
+	Unchanged     int
+	Skipped       int
+	NewCategories []string // 新たに作成するカテゴリ
+	Items         []ReportItem
+}
+
+type ReportItem struct {
+	SourceID string
+	Path     string
+	Title    string
+	Action   string
+	BlogID   uint     // 作成・更新したブログのID（ドライランでの新規作成は0）
+	Changes  []string // 更新する項目（title, slug, content, visibility, categories, tags）
+	Reason   string   // 取り込まない理由
+	Warnings []string // 取り込めなかったタグ・カテゴリ等
+}
+
+// 結果を追加して件数を集計
+func (r *Report) Add(item ReportItem) {
+	switch item.Action {
+	case ActionCreate:
+		r.Created++
+	case ActionUpdate:
+		r.Updated++
+	case ActionUnchanged:
+		r.Unchanged++
+	case ActionSkip:
+		r.Skipped++
+	}
+	r.Items = append(r.Items, item)
+}
@@ -0,0 +1,7 @@
+package importer
+
+// 取り込み元の対応Repositoryインターフェース
+type SourceLinkRepository interface {
+	FindLink(userID uint, source, sourceID string) (*SourceLink, error)
+	Save(link *SourceLink) error
+}
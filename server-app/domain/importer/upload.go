@@ -0,0 +1,71 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// アップロード可能なファイルの最大サイズの既定値
+const DefaultMaxUploadSize int64 = 20 << 20
+
+// ZIPを展開したMarkdownの合計サイズの上限（アップロードサイズに対する倍率）
+const MaxExpandRatio = 10
+
+// アップロードされたファイルから記事を読み込む
+// WXR（.xml）、Markdownのディレクトリを圧縮したZIP、単一のMarkdownファイルに対応する
+// ZIPは展開後のMarkdownの合計サイズがmaxExpanded以下の場合のみ読み込む
+func ParseUpload(filename string, content []byte, maxExpanded int64) (string, []Post, error) {
+	var (
+		source string
+		posts  []Post
+		err    error
+	)
+	switch ext := strings.ToLower(path.Ext(filename)); {
+	case ext == ".zip":
+		source = SourceMarkdown
+		posts, err = parseZip(content, maxExpanded)
+	case ext == ".md" || ext == ".markdown":
+		source = SourceMarkdown
+		var post *Post
+		var ok bool
+		post, ok, err = ParseMarkdownFile(path.Base(filename), content)
+		if ok {
+			posts = []Post{*post}
+		}
+	case ext == ".xml" || bytes.HasPrefix(bytes.TrimSpace(content), []byte("<?xml")):
+		source = SourceWordPress
+		posts, err = ParseWXR(bytes.NewReader(content))
+	default:
+		return "", nil, ErrImportFormatUnsupported
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if len(posts) == 0 {
+		return "", nil, ErrImportEmpty
+	}
+	return source, posts, nil
+}
+
+func parseZip(content []byte, maxExpanded int64) ([]Post, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrImportInvalid, err)
+	}
+
+	// 展開後のサイズが極端に大きいファイルを読み込まない
+	var total uint64
+	for _, f := range zr.File {
+		ext := strings.ToLower(path.Ext(f.Name))
+		if ext == ".md" || ext == ".markdown" {
+			total += f.UncompressedSize64
+		}
+	}
+	if total > uint64(maxExpanded) {
+		return nil, ErrImportTooLarge
+	}
+	return ParseMarkdownFS(zr)
+}
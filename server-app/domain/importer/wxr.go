@@ -0,0 +1,186 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// WordPressのエクスポートファイル（WXR）
+// wp:名前空間はWXRのバージョンごとに異なるため、要素名のみで対応付ける
+type wxrDocument struct {
+	Channel struct {
+		Link        string    `xml:"link"`
+		BaseSiteURL string    `xml:"base_site_url"`
+		Items       []wxrItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+type wxrItem struct {
+	Title        string        `xml:"title"`
+	Content      string        `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PostID       string        `xml:"post_id"`
+	PostDate     string        `xml:"post_date"`
+	PostDateGMT  string        `xml:"post_date_gmt"`
+	ModifiedGMT  string        `xml:"post_modified_gmt"`
+	PostName     string        `xml:"post_name"`
+	Status       string        `xml:"status"`
+	PostType     string        `xml:"post_type"`
+	PostPassword string        `xml:"post_password"`
+	Categories   []wxrCategory `xml:"category"`
+}
+
+type wxrCategory struct {
+	Domain   string `xml:"domain,attr"`
+	Nicename string `xml:"nicename,attr"`
+	Name     string `xml:",chardata"`
+}
+
+// WXRの日時の形式（サイトのタイムゾーンまたはUTC）
+const wxrDateLayout = "2006-01-02 15:04:05"
+
+// WordPressのエクスポートファイルから投稿を読み込む
+// 固定ページ・添付ファイル等の投稿以外と、ゴミ箱内の投稿は対象外とする
+func ParseWXR(r io.Reader) ([]Post, error) {
+	var doc wxrDocument
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrImportInvalid, err)
+	}
+
+	site := strings.TrimRight(doc.Channel.BaseSiteURL, "/")
+	if site == "" {
+		site = strings.TrimRight(doc.Channel.Link, "/")
+	}
+
+	var posts []Post
+	for _, item := range doc.Channel.Items {
+		if item.PostType != "post" || item.PostID == "" {
+			continue
+		}
+		post, ok := item.toPost(site)
+		if ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts, nil
+}
+
+func (item *wxrItem) toPost(site string) (Post, bool) {
+	post := Post{
+		Source:     SourceWordPress,
+		SourceID:   site + "/?p=" + strings.TrimSpace(item.PostID),
+		Path:       "post_id=" + strings.TrimSpace(item.PostID),
+		Title:      strings.TrimSpace(item.Title),
+		Content:    item.Content,
+		HTML:       true,
+		Visibility: domainBlog.VisibilityPublic,
+	}
+
+	// 投稿日時はUTCの値を優先し、下書き等で未設定の場合はサイトの日時を使用する
+	created, ok := parseWXRDate(item.PostDateGMT)
+	if !ok {
+		created, _ = parseWXRDate(item.PostDate)
+	}
+	post.CreatedAt = created
+	post.UpdatedAt = created
+	if modified, ok := parseWXRDate(item.ModifiedGMT); ok && modified.After(created) {
+		post.UpdatedAt = modified
+	}
+
+	// WordPressの公開状態をブログの状態・公開範囲に読み替える
+	switch item.Status {
+	case "publish":
+		post.Status = domainBlog.StatusPublished
+		post.PublishedAt = timePtr(created)
+	case "private":
+		// 著者のみ閲覧できる公開済みの投稿
+		post.Status = domainBlog.StatusPublished
+		post.PublishedAt = timePtr(created)
+		post.Visibility = domainBlog.VisibilityPrivate
+	case "future":
+		post.Status = domainBlog.StatusScheduled
+		post.PublishAt = timePtr(created)
+	case "draft", "auto-draft":
+		post.Status = domainBlog.StatusDraft
+	case "pending":
+		// レビュー待ちの投稿は下書きとして取り込む
+		post.Status = domainBlog.StatusDraft
+		post.Warnings = append(post.Warnings, "レビュー待ちの投稿のため下書きとして取り込みました")
+	case "trash", "inherit":
+		// ゴミ箱内の投稿・リビジョンは取り込まない
+		return Post{}, false
+	default:
+		// プラグイン等による独自の公開状態は誤って公開しないよう非公開の下書きとする
+		post.Status = domainBlog.StatusDraft
+		post.Visibility = domainBlog.VisibilityPrivate
+		post.Warnings = append(post.Warnings, fmt.Sprintf("公開状態「%s」は未対応のため非公開の下書きとして取り込みました", item.Status))
+	}
+	// パスワード保護の投稿は公開しない
+	if item.PostPassword != "" {
+		post.Visibility = domainBlog.VisibilityPrivate
+	}
+
+	// 日本語等のスラッグはURLエンコードされている
+	if slug, err := url.PathUnescape(item.PostName); err == nil {
+		post.Slug = normalizeSlug(slug)
+	}
+
+	for _, c := range item.Categories {
+		name := strings.TrimSpace(c.Name)
+		if name == "" {
+			continue
+		}
+		switch c.Domain {
+		case "category":
+			// WordPressの既定のカテゴリは取り込まない
+			if c.Nicename != "uncategorized" {
+				post.Categories = appendUnique(post.Categories, name)
+			}
+		case "post_tag":
+			post.Tags = appendUnique(post.Tags, name)
+		}
+	}
+	return post, true
+}
+
+func parseWXRDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "0000-00-00") {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(wxrDateLayout, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// 取り込み元のスラッグを使用可能な形式に揃える
+// 使用できない文字を含む場合は空とし、タイトルから生成させる
+func normalizeSlug(slug string) string {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if domainBlog.ValidateSlug(slug) != nil {
+		return ""
+	}
+	return slug
+}
+
+func appendUnique(list []string, value string) []string {
+	for _, v := range list {
+		if v == value {
+			return list
+		}
+	}
+	return append(list, value)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
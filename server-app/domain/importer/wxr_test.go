@@ -0,0 +1,65 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+// 指定した公開状態の投稿を1件含むWXR
+func wxrWithStatus(status string) string {
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<rss xmlns:content="http://purl.org/rss/1.0/modules/content/" xmlns:wp="http://wordpress.org/export/1.2/">
+<channel>
+<wp:base_site_url>https://example.com</wp:base_site_url>
+<item>
+<title>Hello</title>
+<content:encoded><![CDATA[<p>body</p>]]></content:encoded>
+<wp:post_id>1</wp:post_id>
+<wp:post_date_gmt>2024-01-02 03:04:05</wp:post_date_gmt>
+<wp:status>%s</wp:status>
+<wp:post_type>post</wp:post_type>
+</item>
+</channel>
+</rss>`, status)
+}
+
+func TestParseWXR_Status(t *testing.T) {
+	tests := []struct {
+		status             string
+		expectedStatus     string
+		expectedVisibility string
+		expectedWarning    bool
+	}{
+		{status: "publish", expectedStatus: domainBlog.StatusPublished, expectedVisibility: domainBlog.VisibilityPublic},
+		{status: "private", expectedStatus: domainBlog.StatusPublished, expectedVisibility: domainBlog.VisibilityPrivate},
+		{status: "future", expectedStatus: domainBlog.StatusScheduled, expectedVisibility: domainBlog.VisibilityPublic},
+		{status: "draft", expectedStatus: domainBlog.StatusDraft, expectedVisibility: domainBlog.VisibilityPublic},
+		{status: "auto-draft", expectedStatus: domainBlog.StatusDraft, expectedVisibility: domainBlog.VisibilityPublic},
+		{status: "pending", expectedStatus: domainBlog.StatusDraft, expectedVisibility: domainBlog.VisibilityPublic, expectedWarning: true},
+		{status: "custom-review", expectedStatus: domainBlog.StatusDraft, expectedVisibility: domainBlog.VisibilityPrivate, expectedWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			posts, err := ParseWXR(strings.NewReader(wxrWithStatus(tt.status)))
+			if !assert.NoError(t, err) || !assert.Len(t, posts, 1) {
+				return
+			}
+			assert.Equal(t, tt.expectedStatus, posts[0].Status)
+			assert.Equal(t, tt.expectedVisibility, posts[0].Visibility)
+			assert.Equal(t, tt.expectedWarning, len(posts[0].Warnings) > 0)
+		})
+	}
+
+	t.Run("trash and inherit are excluded", func(t *testing.T) {
+		for _, status := range []string{"trash", "inherit"} {
+			posts, err := ParseWXR(strings.NewReader(wxrWithStatus(status)))
+			assert.NoError(t, err)
+			assert.Empty(t, posts, status)
+		}
+	})
+}
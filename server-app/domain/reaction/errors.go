@@ -0,0 +1,9 @@
+package reaction
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrReactionEmojiInvalid = errors.New("reaction emoji is not allowed")
+	ErrReactionInvalidQuery = errors.New("reaction query is invalid")
+)
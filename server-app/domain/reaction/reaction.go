@@ -0,0 +1,133 @@
+package reaction
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ブログへのリアクション
+// ユーザーはブログごとに絵文字1種類につき1件までリアクションできる
+type Reaction struct {
+	ID        uint      `gorm:"primaryKey"`
+	BlogID    uint      `gorm:"not null"`
+	UserID    uint      `gorm:"not null"`
+	Emoji     string    `gorm:"size:32;not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+// リアクションしたユーザー
+type Reactor struct {
+	UserID    uint
+	Username  string
+	Emoji     string
+	CreatedAt time.Time
+}
+
+// 絵文字ごとのリアクション数
+type Count struct {
+	Emoji   string
+	Count   int
+	Reacted bool // 閲覧者自身がリアクション済みか
+}
+
+// ブログのリアクションの集計
+type Summary struct {
+	BlogID uint
+	Total  int
+	Counts []Count // 設定された絵文字の順
+}
+
+// 既定のリアクションに使用できる絵文字
+var DefaultEmojis = []string{"👍", "❤️", "🎉", "😄", "😮", "😢"}
+
+// 絵文字の最大長（バイト）
+const maxEmojiBytes = 32
+
+// 設定可能な絵文字の最大種類数
+const MaxEmojis = 20
+
+// リアクションしたユーザー一覧の取得件数
+const (
+	DefaultReactorLimit = 50
+	MaxReactorLimit     = 200
+)
+
+// リアクションに使用できる絵文字の集合
+type EmojiSet struct {
+	emojis []string
+}
+
+// 絵文字の集合を作成
+// 空白を含むもの・長すぎるもの・重複は設定の誤りとしてエラーを返す
+func NewEmojiSet(emojis []string) (*EmojiSet, error) {
+	if len(emojis) == 0 || len(emojis) > MaxEmojis {
+		return nil, fmt.Errorf("%w: number of emojis must be between 1 and %d", ErrReactionEmojiInvalid, MaxEmojis)
+	}
+	seen := make(map[string]bool, len(emojis))
+	for _, e := range emojis {
+		if e == "" || len(e) > maxEmojiBytes || strings.IndexFunc(e, unicode.IsSpace) >= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrReactionEmojiInvalid, e)
+		}
+		if seen[e] {
+			return nil, fmt.Errorf("%w: duplicated %q", ErrReactionEmojiInvalid, e)
+		}
+		seen[e] = true
+	}
+	return &EmojiSet{emojis: append([]string(nil), emojis...)}, nil
+}
+
+// カンマ区切りの文字列から絵文字の集合を作成
+func ParseEmojiSet(s string) (*EmojiSet, error) {
+	var emojis []string
+	for _, e := range strings.Split(s, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			emojis = append(emojis, e)
+		}
+	}
+	return NewEmojiSet(emojis)
+}
+
+// 使用できる絵文字か判定
+func (s *EmojiSet) Contains(emoji string) bool {
+	for _, e := range s.emojis {
+		if e == emoji {
+			return true
+		}
+	}
+	return false
+}
+
+// 使用できる絵文字を設定順に取得
+func (s *EmojiSet) Emojis() []string {
+	return append([]string(nil), s.emojis...)
+}
+
+// 絵文字ごとのリアクション数から集計を作成
+// 設定から外された絵文字のリアクションは設定順の後に含める
+func NewSummary(blogID uint, set *EmojiSet, counts map[string]int, reacted []string) *Summary {
+	mine := make(map[string]bool, len(reacted))
+	for _, e := range reacted {
+		mine[e] = true
+	}
+
+	summary := &Summary{BlogID: blogID}
+	for _, e := range set.emojis {
+		summary.Counts = append(summary.Counts, Count{Emoji: e, Count: counts[e], Reacted: mine[e]})
+		summary.Total += counts[e]
+	}
+	var others []string
+	for e, n := range counts {
+		if n > 0 && !set.Contains(e) {
+			others = append(others, e)
+		}
+	}
+	sort.Strings(others)
+	for _, e := range others {
+		summary.Counts = append(summary.Counts, Count{Emoji: e, Count: counts[e], Reacted: mine[e]})
+		summary.Total += counts[e]
+	}
+	return summary
+}
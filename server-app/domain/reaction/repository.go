@@ -0,0 +1,9 @@
+package reaction
+
+// リアクションRepositoryインターフェース
+type ReactionRepository interface {
+	Toggle(blogID, userID uint, emoji string) (bool, error)
+	FindCountsByBlogID(blogID uint) (map[string]int, error)
+	FindEmojisByUser(blogID, userID uint) ([]string, error)
+	FindReactors(blogID uint, emoji string, limit int) ([]Reactor, error)
+}
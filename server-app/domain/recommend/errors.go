@@ -0,0 +1,8 @@
+package recommend
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrRelatedInvalidQuery = errors.New("related query is invalid")
+)
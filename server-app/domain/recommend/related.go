@@ -0,0 +1,119 @@
+package recommend
+
+import (
+	"math"
+	"sort"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// 関連記事の取得件数
+const (
+	DefaultRelatedLimit = 5
+	MaxRelatedLimit     = 20
+)
+
+// 候補の絞り込み条件
+const (
+	QueryTermCount = 30  // 候補検索に用いる特徴語の数
+	CandidateLimit = 100 // 類似度を計算する候補の上限
+)
+
+// カテゴリ・タグの共有による加点
+// 本文の類似度より優先されないよう合計に上限を設ける
+const (
+	CategoryBoost = 0.1
+	TagBoost      = 0.05
+	MaxLabelBoost = 0.3
+)
+
+// 関連記事の候補
+type Candidate struct {
+	BlogID           uint
+	SharedCategories int
+	SharedTags       int
+}
+
+// 関連記事
+type Related struct {
+	Blog             domainBlog.Blog
+	Score            float64
+	Similarity       float64 // 本文の類似度（コサイン類似度）
+	SharedCategories int
+	SharedTags       int
+}
+
+// L2正規化済みのTF-IDFベクトル
+type Vector map[string]float64
+
+// 索引語の出現回数と文書頻度からTF-IDFベクトルを生成
+// tfは対数で減衰させ、idfは平滑化して未登録の索引語も扱えるようにする
+func NewVector(tf map[string]int, df map[string]int, total int) Vector {
+	v := make(Vector, len(tf))
+	var norm float64
+	for term, n := range tf {
+		if n <= 0 {
+			continue
+		}
+		w := (1 + math.Log(float64(n))) * (math.Log(float64(total+1)/float64(df[term]+1)) + 1)
+		v[term] = w
+		norm += w * w
+	}
+	if norm == 0 {
+		return v
+	}
+	norm = math.Sqrt(norm)
+	for term := range v {
+		v[term] /= norm
+	}
+	return v
+}
+
+// コサイン類似度
+func (v Vector) Similarity(other Vector) float64 {
+	if len(other) < len(v) {
+		v, other = other, v
+	}
+	var dot float64
+	for term, w := range v {
+		dot += w * other[term]
+	}
+	return dot
+}
+
+// 重みの大きい順に索引語を取得
+func (v Vector) TopTerms(n int) []string {
+	terms := make([]string, 0, len(v))
+	for term := range v {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if v[terms[i]] != v[terms[j]] {
+			return v[terms[i]] > v[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+	if len(terms) > n {
+		terms = terms[:n]
+	}
+	return terms
+}
+
+// 本文の類似度にカテゴリ・タグの共有による加点を加えたスコア
+func Score(similarity float64, candidate Candidate) float64 {
+	boost := CategoryBoost*float64(candidate.SharedCategories) + TagBoost*float64(candidate.SharedTags)
+	if boost > MaxLabelBoost {
+		boost = MaxLabelBoost
+	}
+	return similarity + boost
+}
+
+// スコアの高い順に並べ替え、同点の場合は新しいブログを優先する
+func SortRelated(related []Related) {
+	sort.SliceStable(related, func(i, j int) bool {
+		if related[i].Score != related[j].Score {
+			return related[i].Score > related[j].Score
+		}
+		return related[i].Blog.ID > related[j].Blog.ID
+	})
+}
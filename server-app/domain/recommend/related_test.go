@@ -0,0 +1,146 @@
+package recommend
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		content  string
+		expected map[string]int
+	}{
+		{
+			name:    "title terms are weighted",
+			title:   "Go入門",
+			content: "Goの入門です",
+			expected: map[string]int{
+				"go": titleTermWeight + 1, "入門": titleTermWeight + 1, "の入": 1, "門で": 1,
+			},
+		},
+		{
+			name:     "stop words, numbers and single letters are excluded",
+			content:  "The 2024 API and a b",
+			expected: map[string]int{"api": 1},
+		},
+		{
+			name:     "fullwidth alphanumerics are normalized",
+			content:  "ＤＯＣＫＥＲ docker",
+			expected: map[string]int{"docker": 2},
+		},
+		{
+			name:     "single kanji is kept but single hiragana is not",
+			content:  "本 の",
+			expected: map[string]int{"本": 1},
+		},
+		{
+			name:     "katakana with long vowel mark",
+			content:  "サーバー",
+			expected: map[string]int{"サー": 1, "ーバ": 1, "バー": 1},
+		},
+		{
+			name:     "empty",
+			expected: map[string]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractTerms(tt.title, tt.content))
+		})
+	}
+
+	t.Run("terms are limited to the most frequent", func(t *testing.T) {
+		words := make([]string, 0, MaxTermsPerBlog+10)
+		for i := 0; i < MaxTermsPerBlog+10; i++ {
+			words = append(words, fmt.Sprintf("w%03d", i))
+		}
+		tf := ExtractTerms("frequent", strings.Join(words, " "))
+		assert.Len(t, tf, MaxTermsPerBlog)
+		assert.Equal(t, titleTermWeight, tf["frequent"])
+		assert.Contains(t, tf, "w000")
+		assert.NotContains(t, tf, fmt.Sprintf("w%03d", MaxTermsPerBlog+9))
+	})
+}
+
+func TestNewVector(t *testing.T) {
+	df := map[string]int{"go": 90, "gin": 5}
+
+	t.Run("vector is normalized", func(t *testing.T) {
+		v := NewVector(map[string]int{"go": 3, "gin": 1, "unknown": 2}, df, 100)
+		var norm float64
+		for _, w := range v {
+			norm += w * w
+		}
+		assert.InDelta(t, 1.0, norm, 1e-9)
+	})
+
+	t.Run("rare term weighs more than common term", func(t *testing.T) {
+		v := NewVector(map[string]int{"go": 1, "gin": 1}, df, 100)
+		assert.Greater(t, v["gin"], v["go"])
+		assert.Equal(t, []string{"gin"}, v.TopTerms(1))
+	})
+
+	t.Run("term frequency is dampened", func(t *testing.T) {
+		v := NewVector(map[string]int{"go": 100, "gin": 1}, map[string]int{}, 100)
+		assert.InDelta(t, 1+math.Log(100), v["go"]/v["gin"], 1e-9)
+	})
+
+	t.Run("non positive counts are ignored", func(t *testing.T) {
+		assert.Empty(t, NewVector(map[string]int{"go": 0}, df, 100))
+	})
+}
+
+func TestVector_Similarity(t *testing.T) {
+	df := map[string]int{}
+	a := NewVector(map[string]int{"go": 2, "gin": 1}, df, 10)
+	b := NewVector(map[string]int{"go": 1, "gorm": 1}, df, 10)
+	c := NewVector(map[string]int{"rust": 1}, df, 10)
+
+	assert.InDelta(t, 1.0, a.Similarity(a), 1e-9)
+	assert.InDelta(t, a.Similarity(b), b.Similarity(a), 1e-9)
+	assert.Greater(t, a.Similarity(b), 0.0)
+	assert.Less(t, a.Similarity(b), 1.0)
+	assert.Zero(t, a.Similarity(c))
+	assert.Zero(t, a.Similarity(Vector{}))
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate Candidate
+		expected  float64
+	}{
+		{name: "no shared labels", candidate: Candidate{}, expected: 0.5},
+		{name: "shared category and tags", candidate: Candidate{SharedCategories: 1, SharedTags: 2}, expected: 0.5 + CategoryBoost + 2*TagBoost},
+		{name: "boost is capped", candidate: Candidate{SharedCategories: 3, SharedTags: 10}, expected: 0.5 + MaxLabelBoost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, Score(0.5, tt.candidate), 1e-9)
+		})
+	}
+}
+
+func TestSortRelated(t *testing.T) {
+	related := []Related{
+		{Blog: domainBlog.Blog{ID: 1}, Score: 0.4},
+		{Blog: domainBlog.Blog{ID: 2}, Score: 0.9},
+		{Blog: domainBlog.Blog{ID: 3}, Score: 0.4},
+	}
+	SortRelated(related)
+
+	ids := make([]uint, 0, len(related))
+	for _, r := range related {
+		ids = append(ids, r.Blog.ID)
+	}
+	assert.Equal(t, []uint{2, 3, 1}, ids)
+}
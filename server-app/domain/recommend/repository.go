@@ -0,0 +1,21 @@
+package recommend
+
+import (
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// 関連記事用の索引語インデックスインターフェース
+// ブログの作成・更新・削除時にUseCaseから同期する
+type TermIndex interface {
+	Index(blog *domainBlog.Blog) error
+	Remove(id uint) error
+}
+
+// 関連記事Repositoryインターフェース
+type RelatedRepository interface {
+	FindTerms(blogIDs []uint) (map[uint]map[string]int, error)
+	FindDocumentFrequencies(terms []string) (map[string]int, int, error)
+	FindCandidates(blogID uint, terms []string, limit int) ([]Candidate, error)
+	FindPublishedBlogsByIDs(ids []uint) ([]domainBlog.Blog, error)
+	FindUnindexedBlogIDs(afterID uint, limit int) ([]uint, error)
+}
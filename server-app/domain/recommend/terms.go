@@ -0,0 +1,127 @@
+package recommend
+
+import (
+	"sort"
+	"unicode"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// ブログごとに保持する索引語の上限
+const MaxTermsPerBlog = 200
+
+// タイトルに含まれる索引語の出現回数の重み
+const titleTermWeight = 3
+
+// 索引語の最大文字数（BLOG_TERMS.termの長さに合わせる）
+const maxTermLength = 32
+
+// 頻出するため索引語から除外する英単語
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true,
+	"by": true, "for": true, "from": true, "in": true, "is": true, "it": true, "of": true,
+	"on": true, "or": true, "that": true, "the": true, "this": true, "to": true, "was": true,
+	"with": true, "http": true, "https": true, "www": true, "com": true,
+}
+
+// 文字種
+type runeKind int
+
+const (
+	kindOther runeKind = iota
+	kindWord           // 英数字等の空白区切りの文字
+	kindCJK            // 漢字・ひらがな・カタカナ
+)
+
+// タイトルと本文から索引語ごとの出現回数を抽出
+// 英数字は単語単位、日本語は文字bigram単位で分割する
+// ひらがなのみのbigramは助詞・助動詞が大半のため除外する
+func ExtractTerms(title, content string) map[string]int {
+	tf := make(map[string]int)
+	addTerms(tf, title, titleTermWeight)
+	addTerms(tf, content, 1)
+	return limitTerms(tf, MaxTermsPerBlog)
+}
+
+func addTerms(tf map[string]int, text string, weight int) {
+	var run []rune
+	kind := kindOther
+	flush := func() {
+		switch kind {
+		case kindWord:
+			word := string(run)
+			if len(run) >= 2 && len(run) <= maxTermLength && !stopWords[word] && !isNumeric(run) {
+				tf[word] += weight
+			}
+		case kindCJK:
+			if len(run) == 1 && !isHiragana(run[0]) {
+				tf[string(run)] += weight
+			}
+			for i := 0; i+1 < len(run); i++ {
+				if isHiragana(run[i]) && isHiragana(run[i+1]) {
+					continue
+				}
+				tf[string(run[i:i+2])] += weight
+			}
+		}
+		run = run[:0]
+	}
+
+	for _, r := range domainBlog.NormalizeSearchText(text) {
+		k := classifyRune(r)
+		if k != kind {
+			flush()
+			kind = k
+		}
+		if k != kindOther {
+			run = append(run, r)
+		}
+	}
+	flush()
+}
+
+func classifyRune(r rune) runeKind {
+	switch {
+	case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー':
+		return kindCJK
+	case unicode.IsLetter(r) || unicode.IsDigit(r):
+		return kindWord
+	}
+	return kindOther
+}
+
+func isHiragana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r)
+}
+
+func isNumeric(runes []rune) bool {
+	for _, r := range runes {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// 出現回数の多い順に上限件数までの索引語に絞り込む
+func limitTerms(tf map[string]int, limit int) map[string]int {
+	if len(tf) <= limit {
+		return tf
+	}
+	terms := make([]string, 0, len(tf))
+	for term := range tf {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		if tf[terms[i]] != tf[terms[j]] {
+			return tf[terms[i]] > tf[terms[j]]
+		}
+		return terms[i] < terms[j]
+	})
+
+	limited := make(map[string]int, limit)
+	for _, term := range terms[:limit] {
+		limited[term] = tf[term]
+	}
+	return limited
+}
@@ -0,0 +1,14 @@
+package series
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrSeriesNotFound      = errors.New("series not found")
+	ErrSeriesInvalid       = errors.New("series is invalid")
+	ErrSeriesUnauthorized  = errors.New("unauthorized to modify series")
+	ErrSeriesEntryNotFound = errors.New("blog is not in the series")
+	ErrSeriesBlogConflict  = errors.New("blog already belongs to a series")
+	ErrSeriesFull          = errors.New("series has too many blogs")
+	ErrSeriesOrderMismatch = errors.New("series order does not match its blogs")
+)
@@ -0,0 +1,42 @@
+package series
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DB保存用のSeriesを生成するファクトリ関数
+func NewSeries(ownerID uint, title, description string) (*Series, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || utf8.RuneCountInString(title) > 100 {
+		return nil, fmt.Errorf("%w: title must be between 1 and 100 characters", ErrSeriesInvalid)
+	}
+	if utf8.RuneCountInString(description) > 500 {
+		return nil, fmt.Errorf("%w: description must be at most 500 characters", ErrSeriesInvalid)
+	}
+
+	return &Series{
+		OwnerID:     ownerID,
+		Title:       title,
+		Description: description,
+	}, nil
+}
+
+// 並べ替え後のブログIDが現在のシリーズ内のブログIDの並べ替えであることを検証
+func ValidateOrder(current, requested []uint) error {
+	if len(current) != len(requested) {
+		return fmt.Errorf("%w: expected %d blogs, got %d", ErrSeriesOrderMismatch, len(current), len(requested))
+	}
+	remaining := make(map[uint]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	for _, id := range requested {
+		if !remaining[id] {
+			return fmt.Errorf("%w: blog %d is not in the series or duplicated", ErrSeriesOrderMismatch, id)
+		}
+		delete(remaining, id)
+	}
+	return nil
+}
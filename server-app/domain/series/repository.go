@@ -0,0 +1,11 @@
+package series
+
+// シリーズRepositoryインターフェース
+type SeriesRepository interface {
+	Create(series *Series) error
+	FindByID(id uint) (*Series, error)
+	FindSeriesIDByBlogID(blogID uint) (uint, error)
+	AddEntry(seriesID, blogID uint) error
+	RemoveEntry(seriesID, blogID uint) error
+	Reorder(seriesID uint, blogIDs []uint) error
+}
@@ -0,0 +1,79 @@
+package series
+
+import (
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// シリーズに含められるブログの上限
+const MaxEntries = 100
+
+// 連載記事等の順序付きのブログのまとまり
+type Series struct {
+	ID          uint   `gorm:"primaryKey"`
+	OwnerID     uint   `gorm:"column:user_id"`
+	Title       string `gorm:"size:100;not null"`
+	Description string `gorm:"size:500"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Entries     []Entry `gorm:"-"` // 目次（表示順）
+}
+
+// シリーズ内のブログ
+type Entry struct {
+	Position int // シリーズ内の表示順（1始まり）
+	Blog     domainBlog.Blog
+}
+
+// 閲覧者が閲覧できるブログのみの目次を持つシリーズを生成
+// 閲覧できるかはcanViewで判定し、表示順は閲覧できるブログのみで振り直す
+func (s *Series) VisibleTo(canView func(blog *domainBlog.Blog) (bool, error)) (*Series, error) {
+	visible := *s
+	visible.Entries = make([]Entry, 0, len(s.Entries))
+	for _, entry := range s.Entries {
+		ok, err := canView(&entry.Blog)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entry.Position = len(visible.Entries) + 1
+			visible.Entries = append(visible.Entries, entry)
+		}
+	}
+	return &visible, nil
+}
+
+// ブログが属するシリーズと前後のブログへの案内
+type Navigation struct {
+	SeriesID uint
+	Title    string
+	Position int
+	Total    int
+	Prev     *Entry
+	Next     *Entry
+}
+
+// シリーズの目次からブログの前後のブログを取得
+// 目次に含まれない場合はnilを返す
+func (s *Series) NavigationOf(blogID uint) *Navigation {
+	for i, entry := range s.Entries {
+		if entry.Blog.ID != blogID {
+			continue
+		}
+		nav := &Navigation{
+			SeriesID: s.ID,
+			Title:    s.Title,
+			Position: entry.Position,
+			Total:    len(s.Entries),
+		}
+		if i > 0 {
+			nav.Prev = &s.Entries[i-1]
+		}
+		if i+1 < len(s.Entries) {
+			nav.Next = &s.Entries[i+1]
+		}
+		return nav
+	}
+	return nil
+}
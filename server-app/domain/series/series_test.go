@@ -0,0 +1,70 @@
+package series
+
+import (
+	"errors"
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSeries() *Series {
+	return &Series{
+		ID:    1,
+		Title: "Go入門",
+		Entries: []Entry{
+			{Position: 1, Blog: domainBlog.Blog{ID: 10}},
+			{Position: 2, Blog: domainBlog.Blog{ID: 11}},
+			{Position: 3, Blog: domainBlog.Blog{ID: 12}},
+		},
+	}
+}
+
+func TestSeries_VisibleTo(t *testing.T) {
+	t.Run("hidden entries are removed and positions renumbered", func(t *testing.T) {
+		visible, err := newTestSeries().VisibleTo(func(blog *domainBlog.Blog) (bool, error) {
+			return blog.ID != 11, nil
+		})
+		if assert.NoError(t, err) && assert.Len(t, visible.Entries, 2) {
+			assert.Equal(t, uint(10), visible.Entries[0].Blog.ID)
+			assert.Equal(t, 1, visible.Entries[0].Position)
+			assert.Equal(t, uint(12), visible.Entries[1].Blog.ID)
+			assert.Equal(t, 2, visible.Entries[1].Position)
+		}
+	})
+
+	t.Run("original series is not modified", func(t *testing.T) {
+		series := newTestSeries()
+		_, err := series.VisibleTo(func(*domainBlog.Blog) (bool, error) { return false, nil })
+		assert.NoError(t, err)
+		assert.Len(t, series.Entries, 3)
+	})
+
+	t.Run("predicate error is returned", func(t *testing.T) {
+		predicateErr := errors.New("connection refused")
+		_, err := newTestSeries().VisibleTo(func(*domainBlog.Blog) (bool, error) { return false, predicateErr })
+		assert.ErrorIs(t, err, predicateErr)
+	})
+}
+
+func TestSeries_NavigationOf(t *testing.T) {
+	series := newTestSeries()
+
+	nav := series.NavigationOf(11)
+	if assert.NotNil(t, nav) {
+		assert.Equal(t, 2, nav.Position)
+		assert.Equal(t, 3, nav.Total)
+		assert.Equal(t, uint(10), nav.Prev.Blog.ID)
+		assert.Equal(t, uint(12), nav.Next.Blog.ID)
+	}
+
+	first := series.NavigationOf(10)
+	if assert.NotNil(t, first) {
+		assert.Nil(t, first.Prev)
+	}
+	last := series.NavigationOf(12)
+	if assert.NotNil(t, last) {
+		assert.Nil(t, last.Next)
+	}
+	assert.Nil(t, series.NavigationOf(99))
+}
@@ -0,0 +1,9 @@
+package stats
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrStatsInvalidQuery      = errors.New("stats query is invalid")
+	ErrViewCounterUnavailable = errors.New("view counter is not available")
+)
@@ -0,0 +1,19 @@
+package stats
+
+import "time"
+
+// 閲覧数の集計Repositoryインターフェース
+type StatsRepository interface {
+	SaveDailyViews(rows []DailyViews) error
+	FindBlogDailyViews(blogID uint, from, to time.Time) ([]DailyViews, error)
+	FindAuthorDailyViews(authorID uint, from, to time.Time) ([]DailyViews, error)
+}
+
+// 閲覧数を一時的に集計するカウンターのインターフェース
+// 閲覧ごとのDBへの書き込みを避けるため、集計中の値はRedisに保持する
+type ViewCounter interface {
+	Record(key ViewKey, visitorID string) error
+	PopPending(limit int) ([]ViewKey, error)
+	Counts(keys []ViewKey) ([]DailyViews, error)
+	Requeue(keys []ViewKey) error
+}
@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"fmt"
+	"time"
+)
+
+// 閲覧数の推移の取得期間
+const (
+	DefaultSeriesDays = 30
+	MaxSeriesDays     = 366
+)
+
+// 閲覧数の推移の取得条件
+type SeriesQuery struct {
+	From time.Time // 開始日（この日を含む）
+	To   time.Time // 終了日（この日を含む）
+}
+
+// 未指定項目へデフォルト値を設定し、取得条件を検証
+// 未指定の場合は本日までの直近30日間とする
+func (q *SeriesQuery) Normalize(now time.Time) error {
+	if q.To.IsZero() {
+		q.To = now
+	}
+	q.To = truncateDay(q.To)
+	if q.From.IsZero() {
+		q.From = q.To.AddDate(0, 0, -(DefaultSeriesDays - 1))
+	}
+	q.From = truncateDay(q.From)
+
+	if q.From.After(q.To) {
+		return fmt.Errorf("%w: from must not be after to", ErrStatsInvalidQuery)
+	}
+	if days := q.days(); days > MaxSeriesDays {
+		return fmt.Errorf("%w: period must be at most %d days", ErrStatsInvalidQuery, MaxSeriesDays)
+	}
+	return nil
+}
+
+func (q *SeriesQuery) days() int {
+	return int(q.To.Sub(q.From).Hours()/24+0.5) + 1
+}
+
+// 日別の閲覧数
+type Point struct {
+	Day            time.Time
+	Views          int64
+	UniqueVisitors int64
+}
+
+// 閲覧数の推移
+type Series struct {
+	BlogID         uint // 著者全体の推移の場合は0
+	From           time.Time
+	To             time.Time
+	TotalViews     int64
+	UniqueVisitors int64 // 日別のユニーク訪問者数の合計
+	Points         []Point
+}
+
+// 日別の閲覧数から推移を作成
+// 閲覧のない日は0件として補完する
+func NewSeries(blogID uint, query SeriesQuery, rows []DailyViews) *Series {
+	byDay := make(map[string]DailyViews, len(rows))
+	for _, row := range rows {
+		byDay[row.Day.Format(dayLayout)] = row
+	}
+
+	series := &Series{BlogID: blogID, From: query.From, To: query.To}
+	for day := query.From; !day.After(query.To); day = day.AddDate(0, 0, 1) {
+		row := byDay[day.Format(dayLayout)]
+		series.Points = append(series.Points, Point{
+			Day:            day,
+			Views:          row.Views,
+			UniqueVisitors: row.UniqueVisitors,
+		})
+		series.TotalViews += row.Views
+		series.UniqueVisitors += row.UniqueVisitors
+	}
+	return series
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.In(time.Local).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
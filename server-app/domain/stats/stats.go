@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ブログの日別の閲覧数
+// Redisで集計した値をバックグラウンドでDBへ書き込む
+type DailyViews struct {
+	BlogID         uint      `gorm:"primaryKey"`
+	Day            time.Time `gorm:"primaryKey;type:date"`
+	Views          int64     `gorm:"not null;default:0"`
+	UniqueVisitors int64     `gorm:"not null;default:0"` // HyperLogLogによる推定値
+}
+
+// Redisで集計中の閲覧数の識別子（ブログと日付の組）
+type ViewKey struct {
+	BlogID uint
+	Day    string // YYYYMMDD
+}
+
+// 日付の形式
+const dayLayout = "20060102"
+
+// 閲覧日時の集計対象の日付の識別子を作成
+func NewViewKey(blogID uint, at time.Time) ViewKey {
+	return ViewKey{BlogID: blogID, Day: at.Format(dayLayout)}
+}
+
+// 集計対象の日付
+func (k ViewKey) Date() (time.Time, error) {
+	return time.ParseInLocation(dayLayout, k.Day, time.Local)
+}
+
+// Redisの集合に格納する文字列表現
+func (k ViewKey) String() string {
+	return k.Day + ":" + strconv.FormatUint(uint64(k.BlogID), 10)
+}
+
+// 文字列表現から閲覧数の識別子を復元
+func ParseViewKey(s string) (ViewKey, error) {
+	if len(s) < len(dayLayout)+2 || s[len(dayLayout)] != ':' {
+		return ViewKey{}, fmt.Errorf("invalid view key %q", s)
+	}
+	id, err := strconv.ParseUint(s[len(dayLayout)+1:], 10, 64)
+	if err != nil || id == 0 {
+		return ViewKey{}, fmt.Errorf("invalid view key %q", s)
+	}
+	key := ViewKey{BlogID: uint(id), Day: s[:len(dayLayout)]}
+	if _, err := key.Date(); err != nil {
+		return ViewKey{}, fmt.Errorf("invalid view key %q", s)
+	}
+	return key, nil
+}
+
+// ユニーク訪問者の識別子を作成
+// ログインユーザーはユーザーID、未ログインの閲覧者はIPアドレスとUser-Agentのハッシュ値で識別する
+func VisitorID(userID uint, ip, userAgent string) string {
+	if userID != 0 {
+		return "u:" + strconv.FormatUint(uint64(userID), 10)
+	}
+	sum := sha256.Sum256([]byte(ip + "\n" + userAgent))
+	return "a:" + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,10 @@
+package tag
+
+import "errors"
+
+// ドメインエラーの定義
+var (
+	ErrTagNotFound    = errors.New("tag not found")
+	ErrTagInvalidData = errors.New("tag data is invalid")
+	ErrTooManyTags    = errors.New("too many tags for a blog")
+)
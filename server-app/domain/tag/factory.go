@@ -0,0 +1,57 @@
+package tag
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// タグ名の最大文字数とブログ1件あたりの最大タグ数
+const (
+	MaxNameLength  = 30
+	MaxTagsPerBlog = 10
+)
+
+// 入力されたタグ名を正規化
+// 全角英数記号・全角スペースを半角に、半角カナを全角に変換して英字を小文字化し、
+// 先頭の#と前後の空白を除いて連続する空白を1つにまとめる
+func NormalizeName(name string) string {
+	normalized := foldHalfwidthKana(domainBlog.NormalizeSearchText(name))
+	normalized = strings.TrimLeft(strings.TrimSpace(normalized), "#")
+	return strings.Join(strings.Fields(normalized), " ")
+}
+
+// 入力されたタグ名の一覧を正規化して重複を除き、検証
+// 順序は最初に現れた位置を維持する
+func NormalizeNames(names []string) ([]string, error) {
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		n := NormalizeName(name)
+		if err := ValidateName(n); err != nil {
+			return nil, err
+		}
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		normalized = append(normalized, n)
+	}
+	if len(normalized) > MaxTagsPerBlog {
+		return nil, fmt.Errorf("%w: at most %d tags are allowed", ErrTooManyTags, MaxTagsPerBlog)
+	}
+	return normalized, nil
+}
+
+// 正規化済みのタグ名を検証
+func ValidateName(name string) error {
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return fmt.Errorf("%w: tag name must be between 1 and %d characters", ErrTagInvalidData, MaxNameLength)
+	}
+	if strings.ContainsAny(name, ",#") {
+		return fmt.Errorf("%w: tag name must not contain ',' or '#'", ErrTagInvalidData)
+	}
+	return nil
+}
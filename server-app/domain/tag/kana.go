@@ -0,0 +1,85 @@
+package tag
+
+import "strings"
+
+// 半角カナ（U+FF61〜U+FF9D）に対応する全角文字
+var halfwidthKana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン")
+
+// 濁点・半濁点
+const (
+	halfwidthVoicedMark     = 'ﾞ'
+	halfwidthSemiVoicedMark = 'ﾟ'
+)
+
+// 半角カナを全角カナに変換
+// 後続の濁点・半濁点は可能な場合に直前の文字と結合する
+func foldHalfwidthKana(s string) string {
+	if !strings.ContainsFunc(s, isHalfwidthKana) {
+		return s
+	}
+
+	runes := []rune(s)
+	folded := make([]rune, 0, len(runes))
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if !isHalfwidthKana(r) {
+			folded = append(folded, r)
+			continue
+		}
+
+		switch r {
+		case halfwidthVoicedMark:
+			folded = append(folded, '゛')
+			continue
+		case halfwidthSemiVoicedMark:
+			folded = append(folded, '゜')
+			continue
+		}
+
+		kana := halfwidthKana[r-'｡']
+		if i+1 < len(runes) {
+			switch runes[i+1] {
+			case halfwidthVoicedMark:
+				if voiced, ok := withVoicedMark(kana); ok {
+					kana = voiced
+					i++
+				}
+			case halfwidthSemiVoicedMark:
+				if semiVoiced, ok := withSemiVoicedMark(kana); ok {
+					kana = semiVoiced
+					i++
+				}
+			}
+		}
+		folded = append(folded, kana)
+	}
+	return string(folded)
+}
+
+func isHalfwidthKana(r rune) bool {
+	return r >= '｡' && r <= 'ﾟ'
+}
+
+// 濁点付きの文字に変換
+// 全角カナでは清音の次のコードポイントが濁音となる
+func withVoicedMark(r rune) (rune, bool) {
+	switch {
+	case r == 'ウ':
+		return 'ヴ', true
+	case r >= 'カ' && r <= 'ト' && (r-'カ')%2 == 0 && r != 'ッ':
+		return r + 1, true
+	case r == 'ツ' || r == 'テ' || r == 'ト':
+		return r + 1, true
+	case r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0:
+		return r + 1, true
+	}
+	return r, false
+}
+
+// 半濁点付きの文字に変換
+func withSemiVoicedMark(r rune) (rune, bool) {
+	if r >= 'ハ' && r <= 'ホ' && (r-'ハ')%3 == 0 {
+		return r + 2, true
+	}
+	return r, false
+}
@@ -0,0 +1,11 @@
+package tag
+
+// タグRepositoryインターフェース
+type TagRepository interface {
+	FindTagByName(name string) (*Tag, error)
+	FindTagsByBlogID(blogID uint) ([]Tag, error)
+	FindTagsByBlogIDs(blogIDs []uint) (map[uint][]Tag, error)
+	FindTagsByPrefix(prefix string, limit int) ([]TagCount, error)
+	CountTags(limit int) ([]TagCount, error)
+	SetBlogTags(blogID uint, names []string) ([]Tag, error)
+}
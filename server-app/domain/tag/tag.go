@@ -0,0 +1,25 @@
+package tag
+
+import (
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+type Tag struct {
+	ID   uint              `gorm:"primaryKey"`
+	Name string            `gorm:"size:30;not null;unique"` // NormalizeNameで正規化済みのタグ名
+	Blog []domainBlog.Blog `gorm:"many2many:post_tags;"`
+}
+
+// タグと公開済みブログでの使用件数
+type TagCount struct {
+	Tag   Tag
+	Count int64
+}
+
+// 候補・タグクラウドの取得件数
+const (
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 50
+	DefaultCloudLimit   = 50
+	MaxCloudLimit       = 200
+)
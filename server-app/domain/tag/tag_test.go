@@ -0,0 +1,87 @@
+package tag
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "lowercase", input: "GoLang", expected: "golang"},
+		{name: "fullwidth alphanumerics", input: "Ｇｏ１２３", expected: "go123"},
+		{name: "leading hash", input: "##go", expected: "go"},
+		{name: "fullwidth hash", input: "＃go", expected: "go"},
+		{name: "surrounding and repeated spaces", input: "  web　　開発 ", expected: "web 開発"},
+		{name: "halfwidth kana", input: "ｱｲｳ", expected: "アイウ"},
+		{name: "halfwidth kana with voiced mark", input: "ｶﾞｲﾄﾞ", expected: "ガイド"},
+		{name: "halfwidth kana with semi-voiced mark", input: "ﾊﾟｿｺﾝ", expected: "パソコン"},
+		{name: "halfwidth vu", input: "ｳﾞｨ", expected: "ヴィ"},
+		{name: "halfwidth tsu with voiced mark", input: "ﾂﾞ", expected: "ヅ"},
+		{name: "uncombinable voiced mark is kept", input: "ｱﾞ", expected: "ア゛"},
+		{name: "uncombinable semi-voiced mark is kept", input: "ｶﾟ", expected: "カ゜"},
+		{name: "long vowel mark", input: "ｻｰﾊﾞｰ", expected: "サーバー"},
+		{name: "fullwidth kana is unchanged", input: "ガイド", expected: "ガイド"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeName(tt.input))
+		})
+	}
+}
+
+func TestNormalizeNames(t *testing.T) {
+	t.Run("duplicates after normalization are removed in input order", func(t *testing.T) {
+		names, err := NormalizeNames([]string{"Go", "ｶﾞｲﾄﾞ", "#go", "ガイド", "web"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"go", "ガイド", "web"}, names)
+		}
+	})
+
+	t.Run("empty name is invalid", func(t *testing.T) {
+		_, err := NormalizeNames([]string{"go", " # "})
+		assert.ErrorIs(t, err, ErrTagInvalidData)
+	})
+
+	t.Run("name containing comma is invalid", func(t *testing.T) {
+		_, err := NormalizeNames([]string{"go,web"})
+		assert.ErrorIs(t, err, ErrTagInvalidData)
+	})
+
+	t.Run("too long name is invalid", func(t *testing.T) {
+		_, err := NormalizeNames([]string{strings.Repeat("あ", MaxNameLength+1)})
+		assert.ErrorIs(t, err, ErrTagInvalidData)
+	})
+
+	t.Run("name of max length is valid", func(t *testing.T) {
+		_, err := NormalizeNames([]string{strings.Repeat("あ", MaxNameLength)})
+		assert.NoError(t, err)
+	})
+
+	t.Run("too many tags", func(t *testing.T) {
+		names := make([]string, 0, MaxTagsPerBlog+1)
+		for i := 0; i <= MaxTagsPerBlog; i++ {
+			names = append(names, strings.Repeat("a", i+1))
+		}
+		_, err := NormalizeNames(names)
+		assert.ErrorIs(t, err, ErrTooManyTags)
+	})
+
+	t.Run("duplicates do not count toward the limit", func(t *testing.T) {
+		names := make([]string, 0, MaxTagsPerBlog*2)
+		for i := 0; i < MaxTagsPerBlog; i++ {
+			tag := strings.Repeat("a", i+1)
+			names = append(names, tag, strings.ToUpper(tag))
+		}
+		normalized, err := NormalizeNames(names)
+		if assert.NoError(t, err) {
+			assert.Len(t, normalized, MaxTagsPerBlog)
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	"gopkg.in/yaml.v3"
+)
+
+// アーカイブ内のファイル配置
+const (
+	manifestPath   = "manifest.json"
+	postsDir       = "posts"
+	attachmentsDir = "attachments"
+)
+
+// Markdownファイルの先頭に付与するYAML形式のメタデータ
+// Hugo・Jekyllでそのまま読み込めるキー名とする
+type frontMatter struct {
+	ID          uint       `yaml:"id"`
+	Title       string     `yaml:"title"`
+	Slug        string     `yaml:"slug"`
+	Date        time.Time  `yaml:"date"`
+	Lastmod     time.Time  `yaml:"lastmod"`
+	PublishDate *time.Time `yaml:"publishDate,omitempty"`
+	Draft       bool       `yaml:"draft"`
+	Status      string     `yaml:"status"`
+	Visibility  string     `yaml:"visibility"`
+	Categories  []string   `yaml:"categories,omitempty"`
+	Tags        []string   `yaml:"tags,omitempty"`
+}
+
+// ZIP形式のアーカイブの書き込みの実装
+// ブログはposts/配下のMarkdown、添付ファイルはattachments/配下に保存キーのまま格納する
+type zipWriter struct{}
+
+func NewZipWriter() domainExport.ArchiveWriter {
+	return zipWriter{}
+}
+
+// ブログのアーカイブ内のパス（posts/<作成日>-<スラッグ>.md）
+// 同じパスが既に使われている場合はIDを付与する
+func blogPath(blog *domainBlog.Blog, used map[string]bool) string {
+	name := blog.Slug
+	if name == "" {
+		name = fmt.Sprintf("blog-%d", blog.ID)
+	}
+	p := path.Join(postsDir, fmt.Sprintf("%s-%s.md", blog.CreatedAt.Format("2006-01-02"), name))
+	if used[p] {
+		p = path.Join(postsDir, fmt.Sprintf("%s-%s-%d.md", blog.CreatedAt.Format("2006-01-02"), name, blog.ID))
+	}
+	used[p] = true
+	return p
+}
+
+func attachmentPath(key string) string {
+	return path.Join(attachmentsDir, key)
+}
+
+// ブログをYAMLのメタデータ付きのMarkdownに変換
+// 添付ファイルへのリンクはアーカイブ内の相対パスに書き換える
+func markdown(entry *domainExport.Entry, included map[string]bool) ([]byte, error) {
+	blog := &entry.Blog
+	fm := frontMatter{
+		ID:          blog.ID,
+		Title:       blog.Title,
+		Slug:        blog.Slug,
+		Date:        blog.CreatedAt,
+		Lastmod:     blog.UpdatedAt,
+		PublishDate: blog.PublishedAt,
+		Draft:       blog.Status != domainBlog.StatusPublished,
+		Status:      blog.Status,
+		Visibility:  blog.Visibility,
+		Categories:  entry.Categories,
+		Tags:        entry.Tags,
+	}
+	if fm.PublishDate == nil && blog.Status == domainBlog.StatusScheduled {
+		fm.PublishDate = blog.PublishAt
+	}
+	meta, err := yaml.Marshal(&fm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal front matter (blog_id=%d): %w", blog.ID, err)
+	}
+
+	content := domainExport.ReplaceAttachmentLinks(blog.Content, func(key string) string {
+		if !included[key] {
+			return ""
+		}
+		return "../" + attachmentPath(key)
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	buf.Write(meta)
+	buf.WriteString("---\n\n")
+	buf.WriteString(content)
+	if !strings.HasSuffix(content, "\n") {
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
+
+// アーカイブをZIP形式で書き込む
+// 添付ファイルの実体はopenで読み込み、ストレージに無いものはマニフェストに記録して読み飛ばす
+func (zipWriter) Write(w io.Writer, archive *domainExport.Archive, open func(key string) (io.ReadCloser, error)) (*domainExport.Manifest, error) {
+	zw := zip.NewWriter(w)
+	manifest := &domainExport.Manifest{
+		Version:     domainExport.ManifestVersion,
+		Username:    archive.Username,
+		ExportedAt:  archive.ExportedAt,
+		Blogs:       make([]domainExport.ManifestBlog, 0, len(archive.Entries)),
+		Attachments: make([]domainExport.ManifestAttachment, 0, len(archive.Attachments)),
+	}
+
+	// 添付ファイル（同一内容のものは1つだけ格納する）
+	included := map[string]bool{}
+	missing := map[string]bool{}
+	for _, a := range archive.Attachments {
+		if !included[a.StorageKey] && !missing[a.StorageKey] {
+			ok, err := writeBlob(zw, a.StorageKey, open)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				included[a.StorageKey] = true
+			} else {
+				missing[a.StorageKey] = true
+			}
+		}
+		manifest.Attachments = append(manifest.Attachments, domainExport.ManifestAttachment{
+			ID:          a.ID,
+			BlogID:      a.BlogID,
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Size:        a.Size,
+			Path:        attachmentPath(a.StorageKey),
+			Missing:     missing[a.StorageKey],
+		})
+	}
+
+	// ブログ
+	used := map[string]bool{}
+	for i := range archive.Entries {
+		entry := &archive.Entries[i]
+		p := blogPath(&entry.Blog, used)
+		content, err := markdown(entry, included)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeFile(zw, p, entry.Blog.UpdatedAt, content); err != nil {
+			return nil, err
+		}
+
+		refs := []string{}
+		for _, key := range domainExport.ReferencedKeys(entry.Blog.Content) {
+			if included[key] {
+				refs = append(refs, attachmentPath(key))
+			}
+		}
+		manifest.Blogs = append(manifest.Blogs, domainExport.ManifestBlog{
+			ID:          entry.Blog.ID,
+			Title:       entry.Blog.Title,
+			Slug:        entry.Blog.Slug,
+			Path:        p,
+			Status:      entry.Blog.Status,
+			Visibility:  entry.Blog.Visibility,
+			Categories:  nonNil(entry.Categories),
+			Tags:        nonNil(entry.Tags),
+			CreatedAt:   entry.Blog.CreatedAt,
+			UpdatedAt:   entry.Blog.UpdatedAt,
+			PublishedAt: entry.Blog.PublishedAt,
+			Attachments: refs,
+		})
+	}
+
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+	if err := writeFile(zw, manifestPath, archive.ExportedAt, content); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finish archive: %w", err)
+	}
+	return manifest, nil
+}
+
+func writeFile(zw *zip.Writer, name string, modified time.Time, content []byte) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+	return nil
+}
+
+// 添付ファイルの実体を格納
+// 画像等の圧縮済みの形式が多いため圧縮せずに格納する
+func writeBlob(zw *zip.Writer, key string, open func(key string) (io.ReadCloser, error)) (bool, error) {
+	r, err := open(key)
+	if err != nil {
+		if errors.Is(err, domainAttachment.ErrBlobNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer r.Close()
+
+	name := attachmentPath(key)
+	f, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return false, fmt.Errorf("failed to add %s to archive: %w", name, err)
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		return false, fmt.Errorf("failed to write %s to archive: %w", name, err)
+	}
+	return true, nil
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
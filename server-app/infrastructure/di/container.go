@@ -4,33 +4,94 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"time"
 
 	"go.uber.org/zap"
 
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+	"github.com/kazukimurahashi12/webapp/infrastructure/archive"
 	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"github.com/kazukimurahashi12/webapp/infrastructure/imaging"
+	"github.com/kazukimurahashi12/webapp/infrastructure/markdown"
 	"github.com/kazukimurahashi12/webapp/infrastructure/redis"
 	"github.com/kazukimurahashi12/webapp/infrastructure/repository"
+	"github.com/kazukimurahashi12/webapp/infrastructure/scheduler"
+	"github.com/kazukimurahashi12/webapp/infrastructure/storage"
+	attachmentController "github.com/kazukimurahashi12/webapp/interface/controller/attachment"
 	authController "github.com/kazukimurahashi12/webapp/interface/controller/auth"
 	blogController "github.com/kazukimurahashi12/webapp/interface/controller/blog"
+	categoryController "github.com/kazukimurahashi12/webapp/interface/controller/category"
+	collaboratorController "github.com/kazukimurahashi12/webapp/interface/controller/collaborator"
+	commentController "github.com/kazukimurahashi12/webapp/interface/controller/comment"
 	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	exportController "github.com/kazukimurahashi12/webapp/interface/controller/export"
+	feedController "github.com/kazukimurahashi12/webapp/interface/controller/feed"
+	importController "github.com/kazukimurahashi12/webapp/interface/controller/importer"
+	reactionController "github.com/kazukimurahashi12/webapp/interface/controller/reaction"
+	recommendController "github.com/kazukimurahashi12/webapp/interface/controller/recommend"
+	seriesController "github.com/kazukimurahashi12/webapp/interface/controller/series"
+	statsController "github.com/kazukimurahashi12/webapp/interface/controller/stats"
+	tagController "github.com/kazukimurahashi12/webapp/interface/controller/tag"
 	userController "github.com/kazukimurahashi12/webapp/interface/controller/user"
 	"github.com/kazukimurahashi12/webapp/interface/session"
+	attachmentUseCase "github.com/kazukimurahashi12/webapp/usecase/attachment"
 	authUseCase "github.com/kazukimurahashi12/webapp/usecase/auth"
 	blogUseCase "github.com/kazukimurahashi12/webapp/usecase/blog"
+	categoryUseCase "github.com/kazukimurahashi12/webapp/usecase/category"
+	collaboratorUseCase "github.com/kazukimurahashi12/webapp/usecase/collaborator"
+	commentUseCase "github.com/kazukimurahashi12/webapp/usecase/comment"
+	exportUseCase "github.com/kazukimurahashi12/webapp/usecase/export"
+	importerUseCase "github.com/kazukimurahashi12/webapp/usecase/importer"
+	reactionUseCase "github.com/kazukimurahashi12/webapp/usecase/reaction"
+	recommendUseCase "github.com/kazukimurahashi12/webapp/usecase/recommend"
+	seriesUseCase "github.com/kazukimurahashi12/webapp/usecase/series"
+	statsUseCase "github.com/kazukimurahashi12/webapp/usecase/stats"
+	tagUseCase "github.com/kazukimurahashi12/webapp/usecase/tag"
 	userUseCase "github.com/kazukimurahashi12/webapp/usecase/user"
 )
 
 // Container 依存性注入用の構造体
 type Container struct {
-	HomeController    *blogController.HomeController
-	LoginController   *authController.LoginController
-	BlogController    *blogController.BlogController
-	RegistController  *userController.RegistController
-	SettingController *userController.SettingController
-	LogoutController  *authController.LogoutController
-	CommonController  *common.CommonController
-	SessionManager    session.SessionManager
-	logger            *zap.Logger
+	HomeController         *blogController.HomeController
+	LoginController        *authController.LoginController
+	BlogController         *blogController.BlogController
+	PublishController      *blogController.PublishController
+	RevisionController     *blogController.RevisionController
+	TrashController        *blogController.TrashController
+	BulkController         *blogController.BulkController
+	AutosaveController     *blogController.AutosaveController
+	PermalinkController    *blogController.PermalinkController
+	PublicController       *blogController.PublicController
+	CategoryController     *categoryController.CategoryController
+	CollaboratorController *collaboratorController.CollaboratorController
+	CommentController      *commentController.CommentController
+	ReactionController     *reactionController.ReactionController
+	RecommendController    *recommendController.RecommendController
+	SeriesController       *seriesController.SeriesController
+	StatsController        *statsController.StatsController
+	FeedController         *feedController.FeedController
+	TagController          *tagController.TagController
+	AttachmentController   *attachmentController.AttachmentController
+	ExportController       *exportController.ExportController
+	ImportController       *importController.ImportController
+	RegistController       *userController.RegistController
+	SettingController      *userController.SettingController
+	LogoutController       *authController.LogoutController
+	CommonController       *common.CommonController
+	SessionManager         session.SessionManager
+	PublishScheduler       *scheduler.PublishScheduler
+	TrashPurger            *scheduler.TrashPurger
+	ImageWorkerPool        *scheduler.ImageWorkerPool
+	ExportWorker           *scheduler.ExportWorker
+	ViewFlusher            *scheduler.ViewFlusher
+	ImporterUseCase        importerUseCase.UseCase  // コマンドラインからの取り込みに使用
+	RecommendUseCase       recommendUseCase.UseCase // コマンドラインからの索引語の一括登録に使用
+	logger                 *zap.Logger
 }
 
 // DI依存性注入用のコンストラクタ
@@ -73,25 +134,300 @@ func NewContainer() *Container {
 		os.Exit(1)
 	}
 
+	// ストレージ初期化
+	blobStorage, err := storage.NewLocalStorage(attachmentStorageDirFromEnv())
+	if err != nil {
+		logger.Error("Failed to initialize attachment storage", zap.Error(err))
+		os.Exit(1)
+	}
+	attachmentMaxSize := attachmentMaxSizeFromEnv(logger)
+	imageQueue := scheduler.NewJobQueue(scheduler.ImageQueueSize)
+	imageQuality := imageJPEGQualityFromEnv(logger)
+	imageProcessor := imaging.NewProcessor(imageQuality, imaging.NewWebPEncoder(imageQuality))
+	archiveStorage, err := storage.NewLocalArchiveStorage(exportStorageDirFromEnv())
+	if err != nil {
+		logger.Error("Failed to initialize export storage", zap.Error(err))
+		os.Exit(1)
+	}
+	exportQueue := scheduler.NewJobQueue(scheduler.ExportQueueSize)
+
 	// Repository初期化
 	blogRepo := repository.NewBlogRepository(dbManager)
 	userRepo := repository.NewUserRepository(dbManager)
+	categoryRepo := repository.NewCategoryRepository(dbManager)
+	commentRepo := repository.NewCommentRepository(dbManager)
+	searchIndex := repository.NewBlogSearchIndex(dbManager)
+	termIndex := repository.NewBlogTermIndex(dbManager)
+	relatedRepo := repository.NewRelatedRepository(dbManager)
+	seriesRepo := repository.NewSeriesRepository(dbManager)
+	collabRepo := repository.NewCollaboratorRepository(dbManager)
+	revisionRepo := repository.NewBlogRevisionRepository(dbManager)
+	tagRepo := repository.NewTagRepository(dbManager)
+	renderCache := repository.NewBlogRenderCache(dbManager)
+	attachmentRepo := repository.NewAttachmentRepository(dbManager)
+	exportJobRepo := repository.NewExportJobRepository(dbManager)
+	importSourceRepo := repository.NewImportSourceRepository(dbManager)
+	reactionRepo := repository.NewReactionRepository(dbManager)
+	statsRepo := repository.NewStatsRepository(dbManager)
 
 	// UseCase初期化
-	blogUC := blogUseCase.NewBlogUseCase(blogRepo)
+	blogUC := blogUseCase.NewBlogUseCase(blogRepo, categoryRepo, searchIndex, termIndex, revisionRepo, revisionRetentionFromEnv(logger), trashRetentionFromEnv(logger), markdown.NewRenderer(), renderCache, collabRepo, redis.NewAutosaveStore(ss.Client()), autosaveTTLFromEnv(logger))
+	categoryUC := categoryUseCase.NewCategoryUseCase(categoryRepo, blogRepo, collabRepo)
+	seriesUC := seriesUseCase.NewSeriesUseCase(seriesRepo, blogRepo, collabRepo)
+	collaboratorUC := collaboratorUseCase.NewCollaboratorUseCase(collabRepo, blogRepo, userRepo)
+	commentUC := commentUseCase.NewCommentUseCase(commentRepo, blogRepo, userRepo, collabRepo)
+	statsUC := statsUseCase.NewStatsUseCase(statsRepo, blogRepo, collabRepo, redis.NewViewCounter(ss.Client()))
+	reactionUC := reactionUseCase.NewReactionUseCase(reactionRepo, blogRepo, collabRepo, reactionEmojisFromEnv(logger))
+	recommendUC := recommendUseCase.NewRecommendUseCase(relatedRepo, termIndex, blogRepo, collabRepo)
+	tagUC := tagUseCase.NewTagUseCase(tagRepo, blogRepo, collabRepo)
+	attachmentUC := attachmentUseCase.NewAttachmentUseCase(attachmentRepo, blogRepo, collabRepo, blobStorage, attachmentMaxSize, imageProcessor, imageQueue, imageVariantSpecsFromEnv(logger))
+	exportUC := exportUseCase.NewExportUseCase(exportJobRepo, blogRepo, categoryRepo, tagRepo, attachmentRepo, userRepo, blobStorage, archiveStorage, archive.NewZipWriter(), exportQueue, exportSyncMaxBlogsFromEnv(logger), exportLinkTTLFromEnv(logger))
+	importerUC := importerUseCase.NewImporterUseCase(blogUC, categoryRepo, tagRepo, importSourceRepo, markdown.NewHTMLConverter())
 	authUC := authUseCase.NewAuthUseCase(userRepo)
 	userUC := userUseCase.NewUserUseCase(userRepo)
 
 	// Controller初期化
 	return &Container{
-		HomeController:    blogController.NewHomeController(blogUC, ss, logger),
-		LoginController:   authController.NewLoginController(authUC, ss, logger),
-		BlogController:    blogController.NewBlogController(blogUC, ss, logger),
-		RegistController:  userController.NewRegistController(userUC, ss, logger),
-		SettingController: userController.NewSettingController(userUC, ss, logger),
-		LogoutController:  authController.NewLogoutController(authUC, ss, logger),
-		CommonController:  common.NewCommonController(ss, logger),
-		SessionManager:    ss,
-		logger:            logger,
+		HomeController:         blogController.NewHomeController(blogUC, ss, logger),
+		LoginController:        authController.NewLoginController(authUC, ss, logger),
+		BlogController:         blogController.NewBlogController(blogUC, seriesUC, ss, logger),
+		PublishController:      blogController.NewPublishController(blogUC, ss, logger),
+		RevisionController:     blogController.NewRevisionController(blogUC, ss, logger),
+		TrashController:        blogController.NewTrashController(blogUC, ss, logger),
+		BulkController:         blogController.NewBulkController(blogUC, ss, logger),
+		AutosaveController:     blogController.NewAutosaveController(blogUC, ss, logger),
+		PermalinkController:    blogController.NewPermalinkController(blogUC, statsUC, ss, logger),
+		PublicController:       blogController.NewPublicController(blogUC, statsUC, ss, logger),
+		CategoryController:     categoryController.NewCategoryController(categoryUC, ss, logger),
+		CollaboratorController: collaboratorController.NewCollaboratorController(collaboratorUC, ss, logger),
+		CommentController:      commentController.NewCommentController(commentUC, ss, logger),
+		ReactionController:     reactionController.NewReactionController(reactionUC, ss, logger),
+		RecommendController:    recommendController.NewRecommendController(recommendUC, ss, logger),
+		SeriesController:       seriesController.NewSeriesController(seriesUC, ss, logger),
+		StatsController:        statsController.NewStatsController(statsUC, ss, logger),
+		FeedController:         feedController.NewFeedController(blogUC, ss, logger, feedSiteFromEnv()),
+		TagController:          tagController.NewTagController(tagUC, ss, logger),
+		AttachmentController:   attachmentController.NewAttachmentController(attachmentUC, ss, logger, attachmentMaxSize),
+		ExportController:       exportController.NewExportController(exportUC, ss, logger),
+		ImportController:       importController.NewImportController(importerUC, ss, logger, importMaxSizeFromEnv(logger)),
+		RegistController:       userController.NewRegistController(userUC, ss, logger),
+		SettingController:      userController.NewSettingController(userUC, ss, logger),
+		LogoutController:       authController.NewLogoutController(authUC, ss, logger),
+		CommonController:       common.NewCommonController(ss, logger),
+		SessionManager:         ss,
+		PublishScheduler:       scheduler.NewPublishScheduler(blogUC, logger),
+		TrashPurger:            scheduler.NewTrashPurger(blogUC, logger),
+		ImageWorkerPool:        scheduler.NewImageWorkerPool(attachmentUC, imageQueue, logger),
+		ExportWorker:           scheduler.NewExportWorker(exportUC, exportQueue, logger),
+		ViewFlusher:            scheduler.NewViewFlusher(statsUC, logger),
+		ImporterUseCase:        importerUC,
+		RecommendUseCase:       recommendUC,
+		logger:                 logger,
+	}
+}
+
+// 環境変数からブログ更新履歴の保持期間を取得
+// BLOG_REVISION_KEEP: 保持する直近の履歴件数
+// BLOG_REVISION_MAX_AGE_DAYS: 保持する履歴の経過日数
+// いずれも未指定の場合は全ての履歴を保持する
+func revisionRetentionFromEnv(logger *zap.Logger) domainBlog.RevisionRetention {
+	retention := domainBlog.RevisionRetention{}
+	if v := os.Getenv("BLOG_REVISION_KEEP"); v != "" {
+		keep, err := strconv.Atoi(v)
+		if err != nil || keep < 0 {
+			logger.Warn("Invalid BLOG_REVISION_KEEP, ignored", zap.String("value", v))
+		} else {
+			retention.Keep = keep
+		}
+	}
+	if v := os.Getenv("BLOG_REVISION_MAX_AGE_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days < 0 {
+			logger.Warn("Invalid BLOG_REVISION_MAX_AGE_DAYS, ignored", zap.String("value", v))
+		} else {
+			retention.MaxAge = time.Duration(days) * 24 * time.Hour
+		}
+	}
+	return retention
+}
+
+// 環境変数からゴミ箱の保持期間を取得
+// BLOG_TRASH_RETENTION_DAYS: ゴミ箱へ移動してから完全に削除するまでの日数
+func trashRetentionFromEnv(logger *zap.Logger) time.Duration {
+	if v := os.Getenv("BLOG_TRASH_RETENTION_DAYS"); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil || days <= 0 {
+			logger.Warn("Invalid BLOG_TRASH_RETENTION_DAYS, using default",
+				zap.String("value", v),
+				zap.Duration("default", domainBlog.DefaultTrashRetention))
+		} else {
+			return time.Duration(days) * 24 * time.Hour
+		}
+	}
+	return domainBlog.DefaultTrashRetention
+}
+
+// 環境変数から自動保存の保持期間を取得
+// BLOG_AUTOSAVE_TTL_HOURS: 最後に自動保存してから破棄するまでの時間
+func autosaveTTLFromEnv(logger *zap.Logger) time.Duration {
+	if v := os.Getenv("BLOG_AUTOSAVE_TTL_HOURS"); v != "" {
+		hours, err := strconv.Atoi(v)
+		if err != nil || hours <= 0 {
+			logger.Warn("Invalid BLOG_AUTOSAVE_TTL_HOURS, using default",
+				zap.String("value", v),
+				zap.Duration("default", domainBlog.DefaultAutosaveTTL))
+		} else {
+			return time.Duration(hours) * time.Hour
+		}
+	}
+	return domainBlog.DefaultAutosaveTTL
+}
+
+// 環境変数からフィードに記載するサイト情報を取得
+// FEED_SITE_TITLE: サイト名
+// FEED_BASE_URL: 記事URLの生成に用いるサイトの絶対URL
+func feedSiteFromEnv() feedController.FeedSite {
+	site := feedController.FeedSite{
+		Title:   os.Getenv("FEED_SITE_TITLE"),
+		BaseURL: os.Getenv("FEED_BASE_URL"),
+	}
+	if site.Title == "" {
+		site.Title = "webapp"
+	}
+	if site.BaseURL == "" {
+		site.BaseURL = "http://localhost:8080"
+	}
+	return site
+}
+
+// 環境変数から添付ファイルの保存先ディレクトリを取得
+// ATTACHMENT_STORAGE_DIR: 未指定の場合はカレントディレクトリのuploads
+func attachmentStorageDirFromEnv() string {
+	if v := os.Getenv("ATTACHMENT_STORAGE_DIR"); v != "" {
+		return v
+	}
+	return "uploads"
+}
+
+// 環境変数から添付ファイルの最大サイズを取得
+// ATTACHMENT_MAX_BYTES: アップロード可能なファイルの最大サイズ（バイト）
+func attachmentMaxSizeFromEnv(logger *zap.Logger) int64 {
+	if v := os.Getenv("ATTACHMENT_MAX_BYTES"); v != "" {
+		size, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || size <= 0 {
+			logger.Warn("Invalid ATTACHMENT_MAX_BYTES, using default",
+				zap.String("value", v),
+				zap.Int64("default", domainAttachment.DefaultMaxSize))
+		} else {
+			return size
+		}
+	}
+	return domainAttachment.DefaultMaxSize
+}
+
+// 環境変数から派生画像の生成条件を取得
+// IMAGE_VARIANTS: 「名前:幅x高さ[:crop]」のカンマ区切り（例: thumbnail:320x320:crop,medium:960x960）
+func imageVariantSpecsFromEnv(logger *zap.Logger) []domainAttachment.VariantSpec {
+	if v := os.Getenv("IMAGE_VARIANTS"); v != "" {
+		specs, err := domainAttachment.ParseVariantSpecs(v)
+		if err != nil {
+			logger.Warn("Invalid IMAGE_VARIANTS, using default",
+				zap.String("value", v),
+				zap.Error(err))
+		} else {
+			return specs
+		}
+	}
+	return domainAttachment.DefaultVariantSpecs
+}
+
+// 環境変数から派生画像のJPEG・WebPの品質を取得
+// IMAGE_JPEG_QUALITY: 1〜100
+func imageJPEGQualityFromEnv(logger *zap.Logger) int {
+	if v := os.Getenv("IMAGE_JPEG_QUALITY"); v != "" {
+		quality, err := strconv.Atoi(v)
+		if err != nil || quality < 1 || quality > 100 {
+			logger.Warn("Invalid IMAGE_JPEG_QUALITY, using default",
+				zap.String("value", v),
+				zap.Int("default", imaging.DefaultJPEGQuality))
+		} else {
+			return quality
+		}
+	}
+	return imaging.DefaultJPEGQuality
+}
+
+// 環境変数からエクスポートのアーカイブの保存先ディレクトリを取得
+// EXPORT_STORAGE_DIR: 未指定の場合はカレントディレクトリのexports
+func exportStorageDirFromEnv() string {
+	if v := os.Getenv("EXPORT_STORAGE_DIR"); v != "" {
+		return v
+	}
+	return "exports"
+}
+
+// 環境変数からその場でエクスポートできるブログの最大件数を取得
+// EXPORT_SYNC_MAX_BLOGS: これを超える場合はバックグラウンドでのエクスポートを利用させる
+func exportSyncMaxBlogsFromEnv(logger *zap.Logger) int {
+	if v := os.Getenv("EXPORT_SYNC_MAX_BLOGS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			logger.Warn("Invalid EXPORT_SYNC_MAX_BLOGS, using default",
+				zap.String("value", v),
+				zap.Int("default", domainExport.DefaultSyncMaxBlogs))
+		} else {
+			return n
+		}
+	}
+	return domainExport.DefaultSyncMaxBlogs
+}
+
+// 環境変数からエクスポートのダウンロードURLの有効期間を取得
+// EXPORT_LINK_TTL: 例: 24h
+func exportLinkTTLFromEnv(logger *zap.Logger) time.Duration {
+	if v := os.Getenv("EXPORT_LINK_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			logger.Warn("Invalid EXPORT_LINK_TTL, using default",
+				zap.String("value", v),
+				zap.Duration("default", domainExport.DefaultLinkTTL))
+		} else {
+			return d
+		}
+	}
+	return domainExport.DefaultLinkTTL
+}
+
+// 環境変数から取り込みファイルの最大サイズを取得
+// IMPORT_MAX_BYTES: アップロード可能なファイルの最大サイズ（バイト）
+func importMaxSizeFromEnv(logger *zap.Logger) int64 {
+	if v := os.Getenv("IMPORT_MAX_BYTES"); v != "" {
+		size, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || size <= 0 {
+			logger.Warn("Invalid IMPORT_MAX_BYTES, using default",
+				zap.String("value", v),
+				zap.Int64("default", domainImporter.DefaultMaxUploadSize))
+		} else {
+			return size
+		}
+	}
+	return domainImporter.DefaultMaxUploadSize
+}
+
+// 環境変数からリアクションに使用できる絵文字を取得
+// REACTION_EMOJIS: 絵文字のカンマ区切り（例: 👍,❤️,🎉）
+func reactionEmojisFromEnv(logger *zap.Logger) *domainReaction.EmojiSet {
+	if v := os.Getenv("REACTION_EMOJIS"); v != "" {
+		set, err := domainReaction.ParseEmojiSet(v)
+		if err != nil {
+			logger.Warn("Invalid REACTION_EMOJIS, using default",
+				zap.String("value", v),
+				zap.Strings("default", domainReaction.DefaultEmojis),
+				zap.Error(err))
+		} else {
+			return set
+		}
 	}
+	set, _ := domainReaction.NewEmojiSet(domainReaction.DefaultEmojis)
+	return set
 }
@@ -0,0 +1,186 @@
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+)
+
+// 処理できる画像の最大画素数
+// デコード時のメモリ使用量を抑えるため、これを超える画像は処理しない
+const maxPixels = 50_000_000
+
+// JPEGの品質のデフォルト値
+const DefaultJPEGQuality = 82
+
+// 派生画像の出力形式
+type Encoder interface {
+	Format() string      // 形式名（例: webp）
+	ContentType() string // 例: image/webp
+	Ext() string         // 保存時の拡張子（例: .webp）
+	Alpha() bool         // 透過を保持できるか
+	Encode(w io.Writer, img *image.NRGBA) error
+}
+
+// 画像処理の実装
+// 追加の出力形式（WebP等）を指定した場合は、その形式とJPEG/PNGの両方を生成する
+// 透過を保持できない追加の形式は不透明な画像のみに使用する
+type processor struct {
+	extra []Encoder
+	jpeg  Encoder
+	png   Encoder
+}
+
+// 画像処理の生成
+// qualityはJPEGの品質（1〜100）で、範囲外の場合はデフォルト値を使用する
+func NewProcessor(quality int, extra ...Encoder) domainAttachment.ImageProcessor {
+	if quality < 1 || quality > 100 {
+		quality = DefaultJPEGQuality
+	}
+	return &processor{
+		extra: extra,
+		jpeg:  jpegEncoder{quality: quality},
+		png:   pngEncoder{},
+	}
+}
+
+// メタデータを除去
+func (p *processor) Sanitize(content []byte, contentType string) ([]byte, error) {
+	switch contentType {
+	case "image/jpeg":
+		return sanitizeJPEG(content)
+	case "image/png":
+		return sanitizePNG(content)
+	case "image/webp":
+		return sanitizeWebP(content)
+	}
+	return content, nil
+}
+
+// 派生画像を生成できる形式かを判定
+// WebPは標準ライブラリでデコードできないため対象外
+func (p *processor) CanDerive(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	}
+	return false
+}
+
+// 派生画像を生成
+func (p *processor) Derive(content []byte, specs []domainAttachment.VariantSpec) (*domainAttachment.DerivedImages, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainAttachment.ErrImageUnsupported, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || int64(config.Width)*int64(config.Height) > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", domainAttachment.ErrImageTooLarge, config.Width, config.Height)
+	}
+
+	src, err := decode(content, format)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainAttachment.ErrImageUnsupported, err)
+	}
+	orientation := 1
+	if format == "jpeg" {
+		orientation = jpegOrientation(content)
+	}
+
+	// 向きを補正した後のサイズ
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	ow, oh := w, h
+	if orientation >= 5 {
+		ow, oh = h, w
+	}
+
+	result := &domainAttachment.DerivedImages{Width: ow, Height: oh}
+	for _, spec := range specs {
+		rect, dw, dh := fitRect(ow, oh, spec.MaxWidth, spec.MaxHeight, spec.Crop)
+		srcRect := unorientRect(rect, w, h, orientation).Add(bounds.Min)
+		sw, sh := dw, dh
+		if orientation >= 5 {
+			sw, sh = dh, dw
+		}
+		img := orient(resample(src, srcRect, sw, sh), orientation)
+
+		for _, enc := range p.encodersFor(img) {
+			var buf bytes.Buffer
+			if err := enc.Encode(&buf, img); err != nil {
+				return nil, fmt.Errorf("failed to encode %s variant %q: %w", enc.Format(), spec.Name, err)
+			}
+			result.Images = append(result.Images, domainAttachment.EncodedImage{
+				Variant: domainAttachment.Variant{
+					Name:        spec.Name,
+					Format:      enc.Format(),
+					ContentType: enc.ContentType(),
+					Width:       dw,
+					Height:      dh,
+					Size:        int64(buf.Len()),
+				},
+				Ext:     enc.Ext(),
+				Content: buf.Bytes(),
+			})
+		}
+	}
+	return result, nil
+}
+
+// 出力形式を取得
+// 追加の形式に加え、透過を含む画像はPNG、それ以外はJPEGを生成する
+func (p *processor) encodersFor(img *image.NRGBA) []Encoder {
+	opaque := img.Opaque()
+	var encoders []Encoder
+	for _, enc := range p.extra {
+		if opaque || enc.Alpha() {
+			encoders = append(encoders, enc)
+		}
+	}
+	if opaque {
+		return append(encoders, p.jpeg)
+	}
+	return append(encoders, p.png)
+}
+
+func decode(content []byte, format string) (image.Image, error) {
+	r := bytes.NewReader(content)
+	switch format {
+	case "jpeg":
+		return jpeg.Decode(r)
+	case "png":
+		return png.Decode(r)
+	case "gif":
+		// アニメーションGIFは先頭のフレームのみ使用する
+		return gif.Decode(r)
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+type jpegEncoder struct {
+	quality int
+}
+
+func (e jpegEncoder) Format() string      { return "jpeg" }
+func (e jpegEncoder) ContentType() string { return "image/jpeg" }
+func (e jpegEncoder) Ext() string         { return ".jpg" }
+func (e jpegEncoder) Alpha() bool         { return false }
+func (e jpegEncoder) Encode(w io.Writer, img *image.NRGBA) error {
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: e.quality})
+}
+
+type pngEncoder struct{}
+
+func (pngEncoder) Format() string      { return "png" }
+func (pngEncoder) ContentType() string { return "image/png" }
+func (pngEncoder) Ext() string         { return ".png" }
+func (pngEncoder) Alpha() bool         { return true }
+func (pngEncoder) Encode(w io.Writer, img *image.NRGBA) error {
+	enc := png.Encoder{CompressionLevel: png.BestCompression}
+	return enc.Encode(w, img)
+}
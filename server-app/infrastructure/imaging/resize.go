@@ -0,0 +1,229 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// 縮小先の1画素に対応する縮小元の画素と重み
+type contribution struct {
+	start   int
+	weights []float64
+}
+
+// 面積平均による縮小の重みを計算
+// 縮小先の各画素が覆う縮小元の範囲を、重なる面積に応じて平均する
+func contributions(srcSize, dstSize int) []contribution {
+	scale := float64(srcSize) / float64(dstSize)
+	result := make([]contribution, dstSize)
+	for i := range result {
+		start := float64(i) * scale
+		end := math.Min(float64(i+1)*scale, float64(srcSize))
+		first := int(start)
+		last := int(math.Ceil(end)) - 1
+		if last < first {
+			last = first
+		}
+		weights := make([]float64, last-first+1)
+		var total float64
+		for j := first; j <= last; j++ {
+			w := math.Min(end, float64(j+1)) - math.Max(start, float64(j))
+			if w < 0 {
+				w = 0
+			}
+			weights[j-first] = w
+			total += w
+		}
+		if total == 0 {
+			weights[0], total = 1, 1
+		}
+		for j := range weights {
+			weights[j] /= total
+		}
+		result[i] = contribution{start: first, weights: weights}
+	}
+	return result
+}
+
+// 縮小元の指定範囲を指定サイズに縮小
+// 縮小元を1行ずつ読み込むため、縮小元全体を変換した複製は作らない
+// 半透明の画素の色が周囲に滲まないよう、アルファ値で重み付けして平均する
+func resample(src image.Image, rect image.Rectangle, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	read := rowReader(src)
+	cols := contributions(rect.Dx(), width)
+	rows := contributions(rect.Dy(), height)
+
+	srcRow := make([]uint8, rect.Dx()*4)
+	hRow := make([]float64, width*4) // 横方向に縮小した1行（アルファ乗算済み）
+	acc := make([]float64, width*4)  // 縦方向の累積
+	for y, row := range rows {
+		for i := range acc {
+			acc[i] = 0
+		}
+		for k, wy := range row.weights {
+			if wy == 0 {
+				continue
+			}
+			read(rect.Min.Y+row.start+k, rect.Min.X, srcRow)
+			for x, col := range cols {
+				var r, g, b, a float64
+				for j, wx := range col.weights {
+					p := srcRow[(col.start+j)*4:]
+					alpha := float64(p[3]) * wx
+					r += float64(p[0]) * alpha
+					g += float64(p[1]) * alpha
+					b += float64(p[2]) * alpha
+					a += alpha
+				}
+				hRow[x*4], hRow[x*4+1], hRow[x*4+2], hRow[x*4+3] = r, g, b, a
+			}
+			for i, v := range hRow {
+				acc[i] += v * wy
+			}
+		}
+
+		out := dst.Pix[y*dst.Stride:]
+		for x := 0; x < width; x++ {
+			a := acc[x*4+3]
+			if a <= 0 {
+				out[x*4], out[x*4+1], out[x*4+2], out[x*4+3] = 0, 0, 0, 0
+				continue
+			}
+			out[x*4] = clampUint8(acc[x*4] / a)
+			out[x*4+1] = clampUint8(acc[x*4+1] / a)
+			out[x*4+2] = clampUint8(acc[x*4+2] / a)
+			out[x*4+3] = clampUint8(a)
+		}
+	}
+	return dst
+}
+
+func clampUint8(v float64) uint8 {
+	switch {
+	case v <= 0:
+		return 0
+	case v >= 255:
+		return 255
+	}
+	return uint8(v + 0.5)
+}
+
+// 画像の1行をNRGBA形式で読み込む関数を取得
+// デコード結果によく現れる形式は画素ごとの変換を避けて直接読み込む
+func rowReader(src image.Image) func(y, x0 int, dst []uint8) {
+	switch img := src.(type) {
+	case *image.YCbCr:
+		return func(y, x0 int, dst []uint8) {
+			for i := 0; i < len(dst)/4; i++ {
+				x := x0 + i
+				yi := img.YOffset(x, y)
+				ci := img.COffset(x, y)
+				r, g, b := color.YCbCrToRGB(img.Y[yi], img.Cb[ci], img.Cr[ci])
+				dst[i*4], dst[i*4+1], dst[i*4+2], dst[i*4+3] = r, g, b, 255
+			}
+		}
+	case *image.NRGBA:
+		return func(y, x0 int, dst []uint8) {
+			copy(dst, img.Pix[img.PixOffset(x0, y):])
+		}
+	case *image.Gray:
+		return func(y, x0 int, dst []uint8) {
+			row := img.Pix[img.PixOffset(x0, y):]
+			for i := 0; i < len(dst)/4; i++ {
+				dst[i*4], dst[i*4+1], dst[i*4+2], dst[i*4+3] = row[i], row[i], row[i], 255
+			}
+		}
+	}
+	return func(y, x0 int, dst []uint8) {
+		for i := 0; i < len(dst)/4; i++ {
+			c := color.NRGBAModel.Convert(src.At(x0+i, y)).(color.NRGBA)
+			dst[i*4], dst[i*4+1], dst[i*4+2], dst[i*4+3] = c.R, c.G, c.B, c.A
+		}
+	}
+}
+
+// EXIFの向き（1〜8）に従い画像を回転・反転
+func orient(src *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], src.Pix[sy*src.Stride+sx*4:])
+		}
+	}
+	return dst
+}
+
+// 生成条件に従い縮小後のサイズと縮小元の範囲を計算
+// 縮小元のサイズは向きの補正後のもので、拡大は行わない
+func fitRect(srcW, srcH int, maxW, maxH int, crop bool) (image.Rectangle, int, int) {
+	rect := image.Rect(0, 0, srcW, srcH)
+	if crop {
+		// 生成条件の縦横比で中央を切り抜く
+		if srcW*maxH > srcH*maxW {
+			cw := int(math.Round(float64(srcH) * float64(maxW) / float64(maxH)))
+			x0 := (srcW - cw) / 2
+			rect = image.Rect(x0, 0, x0+cw, srcH)
+		} else {
+			ch := int(math.Round(float64(srcW) * float64(maxH) / float64(maxW)))
+			y0 := (srcH - ch) / 2
+			rect = image.Rect(0, y0, srcW, y0+ch)
+		}
+	}
+
+	w, h := rect.Dx(), rect.Dy()
+	scale := math.Min(float64(maxW)/float64(w), float64(maxH)/float64(h))
+	if scale >= 1 {
+		return rect, w, h
+	}
+	return rect, max(1, int(math.Round(float64(w)*scale))), max(1, int(math.Round(float64(h)*scale)))
+}
+
+// 向きの補正後の範囲を補正前の画像上の範囲に変換
+func unorientRect(r image.Rectangle, w, h, orientation int) image.Rectangle {
+	// w, hは補正前の画像のサイズ
+	switch orientation {
+	case 2:
+		return image.Rect(w-r.Max.X, r.Min.Y, w-r.Min.X, r.Max.Y)
+	case 3:
+		return image.Rect(w-r.Max.X, h-r.Max.Y, w-r.Min.X, h-r.Min.Y)
+	case 4:
+		return image.Rect(r.Min.X, h-r.Max.Y, r.Max.X, h-r.Min.Y)
+	case 5:
+		return image.Rect(r.Min.Y, r.Min.X, r.Max.Y, r.Max.X)
+	case 6:
+		return image.Rect(r.Min.Y, h-r.Max.X, r.Max.Y, h-r.Min.X)
+	case 7:
+		return image.Rect(w-r.Max.Y, h-r.Max.X, w-r.Min.Y, h-r.Min.X)
+	case 8:
+		return image.Rect(w-r.Max.Y, r.Min.X, w-r.Min.Y, r.Max.X)
+	}
+	return r
+}
@@ -0,0 +1,136 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 画素ごとに異なる色を持つ画像
+func numberedImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: uint8(y*w + x), A: 255})
+		}
+	}
+	return img
+}
+
+// 時計回りに90度回転
+func rot90cw(src *image.NRGBA) *image.NRGBA {
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, h, w))
+	for y := 0; y < w; y++ {
+		for x := 0; x < h; x++ {
+			dst.SetNRGBA(x, y, src.NRGBAAt(y, h-1-x))
+		}
+	}
+	return dst
+}
+
+// 左右反転
+func flipH(src *image.NRGBA) *image.NRGBA {
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.SetNRGBA(x, y, src.NRGBAAt(w-1-x, y))
+		}
+	}
+	return dst
+}
+
+// EXIFの向きの定義を回転と左右反転の組み合わせで表したもの
+func expectedOrientation(src *image.NRGBA, orientation int) *image.NRGBA {
+	rot180 := func(img *image.NRGBA) *image.NRGBA { return rot90cw(rot90cw(img)) }
+	switch orientation {
+	case 2:
+		return flipH(src)
+	case 3:
+		return rot180(src)
+	case 4:
+		return rot180(flipH(src))
+	case 5:
+		return flipH(rot90cw(src))
+	case 6:
+		return rot90cw(src)
+	case 7:
+		return rot180(flipH(rot90cw(src)))
+	case 8:
+		return rot90cw(rot180(src))
+	}
+	return src
+}
+
+func crop(src *image.NRGBA, r image.Rectangle) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	for y := 0; y < r.Dy(); y++ {
+		for x := 0; x < r.Dx(); x++ {
+			dst.SetNRGBA(x, y, src.NRGBAAt(r.Min.X+x, r.Min.Y+y))
+		}
+	}
+	return dst
+}
+
+func TestOrient(t *testing.T) {
+	src := numberedImage(5, 3)
+	for orientation := 1; orientation <= 8; orientation++ {
+		got := orient(src, orientation)
+		want := expectedOrientation(src, orientation)
+		assert.Equal(t, want.Rect, got.Rect, "orientation %d", orientation)
+		assert.Equal(t, want.Pix, got.Pix, "orientation %d", orientation)
+	}
+
+	// 範囲外の値は補正しない
+	for _, orientation := range []int{0, 9, -1} {
+		assert.Same(t, src, orient(src, orientation))
+	}
+}
+
+func TestUnorientRect(t *testing.T) {
+	src := numberedImage(7, 4)
+	for orientation := 1; orientation <= 8; orientation++ {
+		oriented := orient(src, orientation)
+		ow, oh := oriented.Rect.Dx(), oriented.Rect.Dy()
+		for _, r := range []image.Rectangle{
+			image.Rect(0, 0, ow, oh),
+			image.Rect(1, 0, 3, 2),
+			image.Rect(0, 1, 2, oh),
+			image.Rect(ow-2, oh-3, ow, oh-1),
+		} {
+			// 補正前の範囲を切り抜いて補正した結果が補正後の切り抜きと一致する
+			got := orient(crop(src, unorientRect(r, 7, 4, orientation)), orientation)
+			assert.Equal(t, crop(oriented, r).Pix, got.Pix, "orientation %d, rect %v", orientation, r)
+		}
+	}
+}
+
+func TestFitRect(t *testing.T) {
+	tests := []struct {
+		name                   string
+		srcW, srcH, maxW, maxH int
+		crop                   bool
+		wantRect               image.Rectangle
+		wantWidth, wantHeight  int
+	}{
+		{"landscape is shrunk to width", 4000, 3000, 1600, 1600, false, image.Rect(0, 0, 4000, 3000), 1600, 1200},
+		{"portrait is shrunk to height", 3000, 4000, 1600, 1600, false, image.Rect(0, 0, 3000, 4000), 1200, 1600},
+		{"small image is not enlarged", 640, 480, 1600, 1600, false, image.Rect(0, 0, 640, 480), 640, 480},
+		{"thin image keeps at least 1px", 10000, 3, 100, 100, false, image.Rect(0, 0, 10000, 3), 100, 1},
+		{"landscape is cropped at center", 400, 300, 100, 100, true, image.Rect(50, 0, 350, 300), 100, 100},
+		{"portrait is cropped at center", 300, 400, 100, 100, true, image.Rect(0, 50, 300, 350), 100, 100},
+		{"crop of small image is not enlarged", 80, 60, 100, 100, true, image.Rect(10, 0, 70, 60), 60, 60},
+		{"crop to wide aspect", 1000, 1000, 400, 200, true, image.Rect(0, 250, 1000, 750), 400, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rect, w, h := fitRect(tt.srcW, tt.srcH, tt.maxW, tt.maxH, tt.crop)
+			assert.Equal(t, tt.wantRect, rect)
+			assert.Equal(t, tt.wantWidth, w)
+			assert.Equal(t, tt.wantHeight, h)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+)
+
+// JPEGのマーカー
+const (
+	markerSOI  = 0xd8
+	markerEOI  = 0xd9
+	markerSOS  = 0xda
+	markerAPP0 = 0xe0
+	markerAPP1 = 0xe1
+	markerAPP2 = 0xe2
+	markerCOM  = 0xfe
+)
+
+// JPEGから位置情報・撮影機器等のメタデータを除去
+// EXIF・XMP（APP1）、IPTC（APP13）等のアプリケーションセグメントとコメントを除き、
+// JFIF（APP0）・ICCプロファイル（APP2）・Adobe（APP14）は表示に影響するため残す
+// 画像の向きは表示に必要なため、向きのみを含むEXIFを作り直す
+func sanitizeJPEG(content []byte) ([]byte, error) {
+	if len(content) < 4 || content[0] != 0xff || content[1] != markerSOI {
+		return nil, fmt.Errorf("%w: missing JPEG SOI marker", domainAttachment.ErrImageUnsupported)
+	}
+	orientation := jpegOrientation(content)
+
+	out := bytes.NewBuffer(make([]byte, 0, len(content)))
+	out.Write(content[:2])
+	wroteOrientation := orientation == 1
+	pos := 2
+	for pos < len(content) {
+		if content[pos] != 0xff {
+			return nil, fmt.Errorf("%w: invalid JPEG marker at %d", domainAttachment.ErrImageUnsupported, pos)
+		}
+		// マーカー前の埋め草（0xFFの連続）を読み飛ばす
+		for pos < len(content) && content[pos] == 0xff {
+			pos++
+		}
+		if pos >= len(content) {
+			break
+		}
+		marker := content[pos]
+		pos++
+
+		// 長さを持たないマーカー
+		if marker == markerEOI || marker == 0x01 || (marker >= 0xd0 && marker <= 0xd7) {
+			out.Write([]byte{0xff, marker})
+			continue
+		}
+		if pos+2 > len(content) {
+			return nil, fmt.Errorf("%w: truncated JPEG segment", domainAttachment.ErrImageUnsupported)
+		}
+		length := int(binary.BigEndian.Uint16(content[pos:]))
+		if length < 2 || pos+length > len(content) {
+			return nil, fmt.Errorf("%w: invalid JPEG segment length", domainAttachment.ErrImageUnsupported)
+		}
+		segment := content[pos-2 : pos+length]
+
+		// 向きのEXIFはJFIFの直後（JFIFが無い場合は先頭）に置く
+		if !wroteOrientation && marker != markerAPP0 {
+			out.Write(orientationSegment(orientation))
+			wroteOrientation = true
+		}
+
+		if marker == markerSOS {
+			// 以降は圧縮データのためそのまま複製する
+			out.Write(content[pos-2:])
+			return out.Bytes(), nil
+		}
+		if keepJPEGSegment(marker) {
+			out.Write(segment)
+		}
+		pos += length
+	}
+	return out.Bytes(), nil
+}
+
+// 残すセグメントかを判定
+func keepJPEGSegment(marker byte) bool {
+	switch {
+	case marker == markerCOM:
+		return false
+	case marker >= markerAPP0 && marker <= 0xef:
+		return marker == markerAPP0 || marker == markerAPP2 || marker == 0xee
+	}
+	return true
+}
+
+// 向きのみを含むEXIFのAPP1セグメント
+func orientationSegment(orientation int) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2a, 0x00, 0x00, 0x00, 0x08, // ビッグエンディアン、IFD0のオフセット
+		0x00, 0x01, // エントリ数
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, // Orientation, SHORT, 1件
+		0x00, byte(orientation), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // 次のIFDなし
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	segment := []byte{0xff, markerAPP1, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// JPEGのEXIFから画像の向き（1〜8）を取得
+// EXIFが無い・不正な場合は補正不要の1を返す
+func jpegOrientation(content []byte) int {
+	pos := 2
+	for pos+4 <= len(content) && content[pos] == 0xff {
+		marker := content[pos+1]
+		length := int(binary.BigEndian.Uint16(content[pos+2:]))
+		if marker == markerSOS || length < 2 || pos+2+length > len(content) {
+			break
+		}
+		data := content[pos+4 : pos+2+length]
+		if marker == markerAPP1 && bytes.HasPrefix(data, []byte("Exif\x00\x00")) {
+			if o := tiffOrientation(data[6:]); o != 0 {
+				return o
+			}
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+// TIFF形式のIFD0からOrientationタグの値を取得
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 && order.Uint16(tiff[entry+2:]) == 3 {
+			if o := int(order.Uint16(tiff[entry+8:])); o >= 1 && o <= 8 {
+				return o
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// PNGのテキスト・EXIF・更新日時のチャンクを除去
+func sanitizePNG(content []byte) ([]byte, error) {
+	const signature = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(content, []byte(signature)) {
+		return nil, fmt.Errorf("%w: missing PNG signature", domainAttachment.ErrImageUnsupported)
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(content)))
+	out.WriteString(signature)
+	pos := len(signature)
+	for pos < len(content) {
+		if pos+12 > len(content) {
+			return nil, fmt.Errorf("%w: truncated PNG chunk", domainAttachment.ErrImageUnsupported)
+		}
+		length := int(binary.BigEndian.Uint32(content[pos:]))
+		end := pos + 12 + length
+		if length < 0 || end > len(content) {
+			return nil, fmt.Errorf("%w: invalid PNG chunk length", domainAttachment.ErrImageUnsupported)
+		}
+		switch string(content[pos+4 : pos+8]) {
+		case "tEXt", "zTXt", "iTXt", "eXIf", "tIME":
+		default:
+			out.Write(content[pos:end])
+		}
+		pos = end
+	}
+	return out.Bytes(), nil
+}
+
+// WebPのEXIF・XMPチャンクを除去
+func sanitizeWebP(content []byte) ([]byte, error) {
+	if len(content) < 12 || string(content[:4]) != "RIFF" || string(content[8:12]) != "WEBP" {
+		return nil, fmt.Errorf("%w: missing WebP header", domainAttachment.ErrImageUnsupported)
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(content)))
+	out.Write(content[:12])
+	pos := 12
+	for pos < len(content) {
+		if pos+8 > len(content) {
+			return nil, fmt.Errorf("%w: truncated WebP chunk", domainAttachment.ErrImageUnsupported)
+		}
+		size := int(binary.LittleEndian.Uint32(content[pos+4:]))
+		end := pos + 8 + size + size%2
+		if size < 0 || end > len(content) {
+			return nil, fmt.Errorf("%w: invalid WebP chunk size", domainAttachment.ErrImageUnsupported)
+		}
+		chunk := content[pos:end]
+		switch string(chunk[:4]) {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			// 拡張ヘッダーのEXIF・XMPの有無フラグを落とす
+			chunk = append([]byte(nil), chunk...)
+			if len(chunk) > 8 {
+				chunk[8] &^= 0x08 | 0x04
+			}
+			out.Write(chunk)
+		default:
+			out.Write(chunk)
+		}
+		pos = end
+	}
+
+	result := out.Bytes()
+	binary.LittleEndian.PutUint32(result[4:], uint32(len(result)-8))
+	return result, nil
+}
@@ -0,0 +1,236 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/webp"
+)
+
+// 位置情報の代わりに埋め込む目印
+const secret = "GPS 35.6812N 139.7671E"
+
+// 指定した向きと目印を含むEXIF（リトルエンディアン）
+func exifPayload(orientation int) []byte {
+	tiff := []byte{
+		'I', 'I', 0x2a, 0x00, 0x08, 0x00, 0x00, 0x00,
+		0x01, 0x00,
+		0x12, 0x01, 0x03, 0x00, 0x01, 0x00, 0x00, 0x00,
+		byte(orientation), 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+	}
+	tiff = append(tiff, secret...)
+	return append([]byte("Exif\x00\x00"), tiff...)
+}
+
+func jpegSegment(marker byte, payload []byte) []byte {
+	segment := []byte{0xff, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	return append(segment, payload...)
+}
+
+// SOIの直後にセグメントを挿入したJPEG
+func testJPEG(t *testing.T, segments ...[]byte) []byte {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, testPhoto(32, 24), nil); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+	out := append([]byte(nil), encoded[:2]...)
+	for _, s := range segments {
+		out = append(out, s...)
+	}
+	return append(out, encoded[2:]...)
+}
+
+// JPEGのセグメントのマーカーを先頭から順に取得
+func jpegMarkers(content []byte) []byte {
+	var markers []byte
+	pos := 2
+	for pos+4 <= len(content) && content[pos] == 0xff {
+		marker := content[pos+1]
+		markers = append(markers, marker)
+		if marker == markerSOS {
+			break
+		}
+		pos += 2 + int(binary.BigEndian.Uint16(content[pos+2:]))
+	}
+	return markers
+}
+
+func TestSanitizeJPEG(t *testing.T) {
+	t.Run("metadata is removed and orientation is kept", func(t *testing.T) {
+		content := testJPEG(t,
+			jpegSegment(markerAPP1, exifPayload(6)),
+			jpegSegment(markerAPP1, []byte("http://ns.adobe.com/xap/1.0/\x00"+secret)),
+			jpegSegment(0xed, []byte("Photoshop 3.0\x00"+secret)),
+			jpegSegment(markerCOM, []byte(secret)),
+		)
+
+		sanitized, err := sanitizeJPEG(content)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotContains(t, string(sanitized), secret)
+		assert.Equal(t, 6, jpegOrientation(sanitized))
+		// 向きのみを含むEXIFが1つだけ残る
+		app1 := 0
+		for _, m := range jpegMarkers(sanitized) {
+			assert.NotEqual(t, byte(0xed), m)
+			assert.NotEqual(t, byte(markerCOM), m)
+			if m == markerAPP1 {
+				app1++
+			}
+		}
+		assert.Equal(t, 1, app1)
+		_, err = jpeg.Decode(bytes.NewReader(sanitized))
+		assert.NoError(t, err)
+	})
+
+	t.Run("no EXIF is written for normal orientation", func(t *testing.T) {
+		sanitized, err := sanitizeJPEG(testJPEG(t, jpegSegment(markerAPP1, exifPayload(1))))
+		if assert.NoError(t, err) {
+			assert.NotContains(t, jpegMarkers(sanitized), byte(markerAPP1))
+			assert.NotContains(t, string(sanitized), secret)
+		}
+	})
+
+	t.Run("JFIF and ICC profile are kept", func(t *testing.T) {
+		content := testJPEG(t,
+			jpegSegment(markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00")),
+			jpegSegment(markerAPP2, []byte("ICC_PROFILE\x00\x01\x01")),
+		)
+		sanitized, err := sanitizeJPEG(content)
+		if assert.NoError(t, err) {
+			markers := jpegMarkers(sanitized)
+			assert.Contains(t, markers, byte(markerAPP0))
+			assert.Contains(t, markers, byte(markerAPP2))
+		}
+	})
+
+	t.Run("invalid data is rejected", func(t *testing.T) {
+		_, err := sanitizeJPEG([]byte("not a jpeg"))
+		assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+		content := testJPEG(t)
+		_, err = sanitizeJPEG(append(content[:2:2], 0xff, markerAPP1, 0xff, 0xff))
+		assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+	})
+}
+
+func pngChunk(kind string, data []byte) []byte {
+	chunk := make([]byte, 8, 12+len(data))
+	binary.BigEndian.PutUint32(chunk, uint32(len(data)))
+	copy(chunk[4:], kind)
+	chunk = append(chunk, data...)
+	return binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+}
+
+// IHDRの直後にチャンクを挿入したPNG
+func testPNG(t *testing.T, chunks ...[]byte) []byte {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, testPhoto(16, 16)); err != nil {
+		t.Fatal(err)
+	}
+	encoded := buf.Bytes()
+	ihdrEnd := 8 + 12 + int(binary.BigEndian.Uint32(encoded[8:]))
+	out := append([]byte(nil), encoded[:ihdrEnd]...)
+	for _, c := range chunks {
+		out = append(out, c...)
+	}
+	return append(out, encoded[ihdrEnd:]...)
+}
+
+func TestSanitizePNG(t *testing.T) {
+	content := testPNG(t,
+		pngChunk("eXIf", exifPayload(6)[6:]),
+		pngChunk("tEXt", []byte("Comment\x00"+secret)),
+		pngChunk("iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00"+secret)),
+		pngChunk("tIME", []byte{0x07, 0xe8, 1, 2, 3, 4, 5}),
+		pngChunk("gAMA", []byte{0, 0, 0xb1, 0x8f}),
+	)
+
+	sanitized, err := sanitizePNG(content)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, string(sanitized), secret)
+	for _, kind := range []string{"eXIf", "tEXt", "iTXt", "tIME"} {
+		assert.NotContains(t, string(sanitized), kind)
+	}
+	// 表示に影響するチャンクは残す
+	assert.Contains(t, string(sanitized), "gAMA")
+	_, err = png.Decode(bytes.NewReader(sanitized))
+	assert.NoError(t, err)
+
+	_, err = sanitizePNG([]byte("not a png"))
+	assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+	_, err = sanitizePNG(content[:len(content)-4])
+	assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+}
+
+func riffChunk(kind string, data []byte) []byte {
+	chunk := make([]byte, 8, 8+len(data)+1)
+	copy(chunk, kind)
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(len(data)))
+	chunk = append(chunk, data...)
+	if len(data)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+// EXIF・XMPを含む拡張形式（VP8X）のWebP
+func testExtendedWebP(t *testing.T) []byte {
+	var buf bytes.Buffer
+	if err := NewWebPEncoder(80).Encode(&buf, testPhoto(16, 16)); err != nil {
+		t.Fatal(err)
+	}
+	vp8 := buf.Bytes()[12:]
+
+	vp8x := make([]byte, 10)
+	vp8x[0] = 0x08 | 0x04 // EXIF・XMPあり
+	vp8x[4], vp8x[7] = 15, 15
+	body := []byte("WEBP")
+	body = append(body, riffChunk("VP8X", vp8x)...)
+	body = append(body, vp8...)
+	body = append(body, riffChunk("EXIF", exifPayload(6)[6:])...)
+	body = append(body, riffChunk("XMP ", []byte(secret+"!"))...)
+	out := []byte("RIFF\x00\x00\x00\x00")
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(body)))
+	return append(out, body...)
+}
+
+func TestSanitizeWebP(t *testing.T) {
+	content := testExtendedWebP(t)
+	_, err := webp.Decode(bytes.NewReader(content))
+	if !assert.NoError(t, err, "test data must be a valid WebP") {
+		return
+	}
+
+	sanitized, err := sanitizeWebP(content)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotContains(t, string(sanitized), secret)
+	assert.NotContains(t, string(sanitized), "EXIF")
+	assert.NotContains(t, string(sanitized), "XMP ")
+	// 拡張ヘッダーのEXIF・XMPの有無フラグとRIFFのサイズを更新する
+	assert.Equal(t, byte(0), sanitized[20]&(0x08|0x04))
+	assert.Equal(t, len(sanitized)-8, int(binary.LittleEndian.Uint32(sanitized[4:])))
+	decoded, err := webp.Decode(bytes.NewReader(sanitized))
+	if assert.NoError(t, err) {
+		assert.Equal(t, image.Pt(16, 16), decoded.Bounds().Size())
+	}
+
+	_, err = sanitizeWebP([]byte("RIFF\x00\x00\x00\x00WAVE"))
+	assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+	_, err = sanitizeWebP(content[:len(content)-3])
+	assert.ErrorIs(t, err, domainAttachment.ErrImageUnsupported)
+}
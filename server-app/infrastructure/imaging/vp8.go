@@ -0,0 +1,849 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"math"
+)
+
+// VP8（RFC 6386）のキーフレームの符号化
+// 予測は輝度16x16・色差8x8のDC/V/H/TMのみを使用し、4x4単位の予測は行わない
+
+const (
+	vp8NumPlanes   = 4
+	vp8NumBands    = 8
+	vp8NumContexts = 3
+	vp8NumProbs    = 11
+
+	// 係数の種類（13.3節）
+	vp8PlaneY1WithY2 = 0
+	vp8PlaneY2       = 1
+	vp8PlaneUV       = 2
+
+	// VP8で表現できる画像の一辺の最大値
+	vp8MaxDimension = 1<<14 - 1
+
+	// 符号化できる係数の絶対値の最大値
+	vp8MaxLevel = 2047
+)
+
+// 予測モード
+const (
+	vp8PredDC = iota
+	vp8PredV
+	vp8PredH
+	vp8PredTM
+)
+
+var (
+	// 係数の位置と確率の帯域の対応（13.3節）
+	vp8Bands = [17]uint8{0, 1, 2, 3, 6, 4, 5, 6, 6, 6, 6, 6, 6, 6, 6, 7, 0}
+	// ジグザグ走査の順序
+	vp8Zigzag = [16]uint8{0, 1, 4, 8, 5, 2, 3, 6, 9, 12, 13, 10, 7, 11, 14, 15}
+	// 大きな係数（カテゴリ3〜6）の追加ビットの確率（13.2節）
+	vp8Cat3456 = [4][]uint8{
+		{173, 148, 140},
+		{176, 155, 140, 135},
+		{180, 157, 141, 134, 130},
+		{254, 254, 243, 230, 196, 177, 153, 140, 133, 130, 129},
+	}
+)
+
+// 量子化の係数（DC, AC）
+type vp8Quant struct {
+	y1, y2, uv [2]int32
+}
+
+func newVP8Quant(q int) vp8Quant {
+	var m vp8Quant
+	m.y1 = [2]int32{vp8DequantDC[q], vp8DequantAC[q]}
+	m.y2 = [2]int32{vp8DequantDC[q] * 2, vp8DequantAC[q] * 155 / 100}
+	if m.y2[1] < 8 {
+		m.y2[1] = 8
+	}
+	m.uv = [2]int32{vp8DequantDC[min(q, 117)], vp8DequantAC[q]}
+	return m
+}
+
+// 係数の符号化に使用するビット
+// probは確率の表の位置で、fixedが0以外の場合はその固定の確率を使用する
+type vp8Token struct {
+	prob  uint16
+	fixed uint8
+	bit   uint8
+}
+
+// 隣接するブロックに0以外の係数があるか
+type vp8NonZero struct {
+	y  [4]uint8
+	u  [2]uint8
+	v  [2]uint8
+	y2 uint8
+}
+
+// マクロブロックの符号化結果
+type vp8Macroblock struct {
+	yMode, uvMode int
+	skip          bool   // 係数がすべて0
+	tokens        [2]int // 係数のビットの範囲
+}
+
+type vp8Encoder struct {
+	width, height int
+	mbw, mbh      int
+	quant         vp8Quant
+	qIndex        int
+
+	// 原画像と再構成画像（マクロブロック単位に拡張したもの）
+	y, u, v    []uint8
+	ry, ru, rv []uint8
+	yStride    int
+	cStride    int
+
+	top  []vp8NonZero
+	left vp8NonZero
+
+	mbs    []vp8Macroblock
+	tokens []vp8Token
+}
+
+// 画像をVP8のキーフレームとして符号化
+// qIndexは量子化インデックス（0〜127）、filterLevelはループフィルタの強さ（0〜63）
+// 透過は保持しない
+func encodeVP8(img *image.NRGBA, qIndex, filterLevel int) ([]byte, error) {
+	b := img.Bounds()
+	if b.Dx() <= 0 || b.Dy() <= 0 || b.Dx() > vp8MaxDimension || b.Dy() > vp8MaxDimension {
+		return nil, fmt.Errorf("vp8: unsupported image size %dx%d", b.Dx(), b.Dy())
+	}
+	e := newVP8Encoder(img, qIndex)
+	for mby := 0; mby < e.mbh; mby++ {
+		e.left = vp8NonZero{}
+		for mbx := 0; mbx < e.mbw; mbx++ {
+			e.encodeMacroblock(mbx, mby)
+		}
+	}
+	return e.bitstream(filterLevel), nil
+}
+
+func newVP8Encoder(img *image.NRGBA, qIndex int) *vp8Encoder {
+	b := img.Bounds()
+	e := &vp8Encoder{
+		width:  b.Dx(),
+		height: b.Dy(),
+		mbw:    (b.Dx() + 15) / 16,
+		mbh:    (b.Dy() + 15) / 16,
+		quant:  newVP8Quant(qIndex),
+		qIndex: qIndex,
+	}
+	e.yStride = e.mbw * 16
+	e.cStride = e.mbw * 8
+	ySize := e.yStride * e.mbh * 16
+	cSize := e.cStride * e.mbh * 8
+	e.y, e.ry = make([]uint8, ySize), make([]uint8, ySize)
+	e.u, e.ru = make([]uint8, cSize), make([]uint8, cSize)
+	e.v, e.rv = make([]uint8, cSize), make([]uint8, cSize)
+	e.top = make([]vp8NonZero, e.mbw)
+	e.mbs = make([]vp8Macroblock, 0, e.mbw*e.mbh)
+	e.convert(img)
+	return e
+}
+
+// RGBをYUV 4:2:0（BT.601）に変換
+// 画像の外側は端の画素を繰り返してマクロブロックの境界まで埋める
+func (e *vp8Encoder) convert(img *image.NRGBA) {
+	b := img.Bounds()
+	at := func(x, y int) (r, g, b2 int32) {
+		x, y = min(x, e.width-1), min(y, e.height-1)
+		i := img.PixOffset(b.Min.X+x, b.Min.Y+y)
+		return int32(img.Pix[i]), int32(img.Pix[i+1]), int32(img.Pix[i+2])
+	}
+	for y := 0; y < e.mbh*16; y++ {
+		for x := 0; x < e.mbw*16; x++ {
+			r, g, bl := at(x, y)
+			e.y[y*e.yStride+x] = uint8((16839*r + 33059*g + 6420*bl + 16<<16 + 1<<15) >> 16)
+		}
+	}
+	for y := 0; y < e.mbh*8; y++ {
+		for x := 0; x < e.mbw*8; x++ {
+			var r, g, bl int32
+			for _, d := range [4][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+				pr, pg, pb := at(2*x+d[0], 2*y+d[1])
+				r, g, bl = r+pr, g+pg, bl+pb
+			}
+			e.u[y*e.cStride+x] = clip8((-9719*r - 19081*g + 28800*bl + 128<<18 + 1<<17) >> 18)
+			e.v[y*e.cStride+x] = clip8((28800*r - 24116*g - 4684*bl + 128<<18 + 1<<17) >> 18)
+		}
+	}
+}
+
+// マクロブロックの予測・変換・量子化を行い、係数のビットを記録
+func (e *vp8Encoder) encodeMacroblock(mbx, mby int) {
+	mb := vp8Macroblock{tokens: [2]int{len(e.tokens), 0}}
+	top := &e.top[mbx]
+
+	// 輝度
+	var yPred [256]uint8
+	mb.yMode = e.choosePrediction(e.y, e.ry, e.yStride, 16, mbx, mby, yPred[:])
+	var coeffs [16][16]int32
+	var dc [16]int32
+	for n := 0; n < 16; n++ {
+		x0, y0 := mbx*16+(n%4)*4, mby*16+(n/4)*4
+		var res [16]int32
+		for j := 0; j < 4; j++ {
+			for i := 0; i < 4; i++ {
+				res[j*4+i] = int32(e.y[(y0+j)*e.yStride+x0+i]) - int32(yPred[((n/4)*4+j)*16+(n%4)*4+i])
+			}
+		}
+		coeffs[n] = vp8ForwardDCT(res)
+		dc[n] = coeffs[n][0]
+	}
+	y2 := vp8ForwardWHT(dc)
+	var y2Levels [16]int32
+	for i := range y2 {
+		y2Levels[i] = vp8Quantize(y2[i], e.quant.y2[min(i, 1)], true)
+		y2[i] = y2Levels[i] * e.quant.y2[min(i, 1)]
+	}
+	dcRecon := vp8InverseWHT(y2)
+	nz := e.putCoefficients(vp8PlaneY2, top.y2+e.left.y2, &y2Levels, 0)
+	top.y2, e.left.y2 = nz, nz
+	empty := nz == 0
+
+	for n := 0; n < 16; n++ {
+		var levels [16]int32
+		for i := 1; i < 16; i++ {
+			levels[i] = vp8Quantize(coeffs[n][i], e.quant.y1[1], false)
+			coeffs[n][i] = levels[i] * e.quant.y1[1]
+		}
+		coeffs[n][0] = dcRecon[n]
+		bx, by := n%4, n/4
+		nz := e.putCoefficients(vp8PlaneY1WithY2, top.y[bx]+e.left.y[by], &levels, 1)
+		top.y[bx], e.left.y[by] = nz, nz
+		empty = empty && nz == 0
+
+		x0, y0 := mbx*16+bx*4, mby*16+by*4
+		vp8InverseDCT(&coeffs[n], yPred[by*4*16+bx*4:], 16, e.ry[y0*e.yStride+x0:], e.yStride)
+	}
+
+	// 色差（U/Vで同じ予測モードを使用する）
+	var uPred, vPred [64]uint8
+	mb.uvMode = e.chooseChromaPrediction(mbx, mby, uPred[:], vPred[:])
+	for _, plane := range []struct {
+		src, recon []uint8
+		pred       []uint8
+		top, left  *[2]uint8
+	}{
+		{e.u, e.ru, uPred[:], &top.u, &e.left.u},
+		{e.v, e.rv, vPred[:], &top.v, &e.left.v},
+	} {
+		for n := 0; n < 4; n++ {
+			bx, by := n%2, n/2
+			x0, y0 := mbx*8+bx*4, mby*8+by*4
+			var res [16]int32
+			for j := 0; j < 4; j++ {
+				for i := 0; i < 4; i++ {
+					res[j*4+i] = int32(plane.src[(y0+j)*e.cStride+x0+i]) - int32(plane.pred[(by*4+j)*8+bx*4+i])
+				}
+			}
+			c := vp8ForwardDCT(res)
+			var levels [16]int32
+			for i := range c {
+				levels[i] = vp8Quantize(c[i], e.quant.uv[min(i, 1)], i == 0)
+				c[i] = levels[i] * e.quant.uv[min(i, 1)]
+			}
+			nz := e.putCoefficients(vp8PlaneUV, plane.top[bx]+plane.left[by], &levels, 0)
+			plane.top[bx], plane.left[by] = nz, nz
+			empty = empty && nz == 0
+			vp8InverseDCT(&c, plane.pred[by*4*8+bx*4:], 8, plane.recon[y0*e.cStride+x0:], e.cStride)
+		}
+	}
+
+	mb.skip = empty
+	mb.tokens[1] = len(e.tokens)
+	e.mbs = append(e.mbs, mb)
+}
+
+// 輝度の予測モードを選択し、予測画素をpredに設定
+// 原画像との差の二乗和が最小のモードを選択する
+func (e *vp8Encoder) choosePrediction(src, recon []uint8, stride, size, mbx, mby int, pred []uint8) int {
+	best, bestCost := vp8PredDC, int64(math.MaxInt64)
+	var tmp [256]uint8
+	for mode := vp8PredDC; mode <= vp8PredTM; mode++ {
+		p := tmp[:size*size]
+		vp8Predict(recon, stride, size, mbx, mby, mode, p)
+		cost := sse(src[mby*size*stride+mbx*size:], stride, p, size)
+		if cost < bestCost {
+			best, bestCost = mode, cost
+			copy(pred, p)
+		}
+	}
+	return best
+}
+
+// 色差の予測モードを選択し、U/Vそれぞれの予測画素を設定
+func (e *vp8Encoder) chooseChromaPrediction(mbx, mby int, uPred, vPred []uint8) int {
+	best, bestCost := vp8PredDC, int64(math.MaxInt64)
+	var pu, pv [64]uint8
+	for mode := vp8PredDC; mode <= vp8PredTM; mode++ {
+		vp8Predict(e.ru, e.cStride, 8, mbx, mby, mode, pu[:])
+		vp8Predict(e.rv, e.cStride, 8, mbx, mby, mode, pv[:])
+		origin := mby*8*e.cStride + mbx*8
+		cost := sse(e.u[origin:], e.cStride, pu[:], 8) + sse(e.v[origin:], e.cStride, pv[:], 8)
+		if cost < bestCost {
+			best, bestCost = mode, cost
+			copy(uPred, pu[:])
+			copy(vPred, pv[:])
+		}
+	}
+	return best
+}
+
+func sse(src []uint8, stride int, pred []uint8, size int) int64 {
+	var sum int64
+	for j := 0; j < size; j++ {
+		for i := 0; i < size; i++ {
+			d := int64(src[j*stride+i]) - int64(pred[j*size+i])
+			sum += d * d
+		}
+	}
+	return sum
+}
+
+// 再構成画像の隣接画素から予測画素を生成（12.2節）
+// 画像の上端は127、左端は129を隣接画素とみなす
+func vp8Predict(recon []uint8, stride, size, mbx, mby, mode int, pred []uint8) {
+	origin := mby*size*stride + mbx*size
+	above := make([]int32, size)
+	left := make([]int32, size)
+	var corner int32
+	for i := 0; i < size; i++ {
+		if mby > 0 {
+			above[i] = int32(recon[origin-stride+i])
+		} else {
+			above[i] = 127
+		}
+		if mbx > 0 {
+			left[i] = int32(recon[origin+i*stride-1])
+		} else {
+			left[i] = 129
+		}
+	}
+	switch {
+	case mby == 0:
+		corner = 127
+	case mbx == 0:
+		corner = 129
+	default:
+		corner = int32(recon[origin-stride-1])
+	}
+
+	for j := 0; j < size; j++ {
+		for i := 0; i < size; i++ {
+			var p int32
+			switch mode {
+			case vp8PredV:
+				p = above[i]
+			case vp8PredH:
+				p = left[j]
+			case vp8PredTM:
+				p = left[j] + above[i] - corner
+			}
+			pred[j*size+i] = clip8(p)
+		}
+	}
+	if mode != vp8PredDC {
+		return
+	}
+
+	// 上端・左端のマクロブロックは存在する側の隣接画素のみで平均を取る
+	shift := 3
+	if size == 16 {
+		shift = 4
+	}
+	var sum, count int32
+	if mby > 0 {
+		for _, a := range above {
+			sum += a
+		}
+		count++
+	}
+	if mbx > 0 {
+		for _, l := range left {
+			sum += l
+		}
+		count++
+	}
+	dc := int32(128)
+	if count > 0 {
+		s := shift + int(count) - 1
+		dc = (sum + 1<<(s-1)) >> s
+	}
+	for i := range pred[:size*size] {
+		pred[i] = uint8(dc)
+	}
+}
+
+// 4x4の順方向DCT（libvpxと同じ整数演算）
+func vp8ForwardDCT(in [16]int32) [16]int32 {
+	var tmp, out [16]int32
+	for i := 0; i < 4; i++ {
+		a := (in[i*4+0] + in[i*4+3]) * 8
+		b := (in[i*4+1] + in[i*4+2]) * 8
+		c := (in[i*4+1] - in[i*4+2]) * 8
+		d := (in[i*4+0] - in[i*4+3]) * 8
+		tmp[i*4+0] = a + b
+		tmp[i*4+2] = a - b
+		tmp[i*4+1] = (c*2217 + d*5352 + 14500) >> 12
+		tmp[i*4+3] = (d*2217 - c*5352 + 7500) >> 12
+	}
+	for i := 0; i < 4; i++ {
+		a := tmp[i] + tmp[12+i]
+		b := tmp[4+i] + tmp[8+i]
+		c := tmp[4+i] - tmp[8+i]
+		d := tmp[i] - tmp[12+i]
+		out[i] = (a + b + 7) >> 4
+		out[8+i] = (a - b + 7) >> 4
+		out[4+i] = (c*2217 + d*5352 + 12000) >> 16
+		if d != 0 {
+			out[4+i]++
+		}
+		out[12+i] = (d*2217 - c*5352 + 51000) >> 16
+	}
+	return out
+}
+
+// 4x4の逆方向DCTを行い、予測画素に加えて再構成画像に書き込む（14.3節）
+func vp8InverseDCT(in *[16]int32, pred []uint8, predStride int, out []uint8, outStride int) {
+	const (
+		c1 = 85627 // 65536 * cos(pi/8) * sqrt(2)
+		c2 = 35468 // 65536 * sin(pi/8) * sqrt(2)
+	)
+	var m [4][4]int32
+	for i := 0; i < 4; i++ {
+		a := in[i] + in[8+i]
+		b := in[i] - in[8+i]
+		c := (in[4+i]*c2)>>16 - (in[12+i]*c1)>>16
+		d := (in[4+i]*c1)>>16 + (in[12+i]*c2)>>16
+		m[i][0] = a + d
+		m[i][1] = b + c
+		m[i][2] = b - c
+		m[i][3] = a - d
+	}
+	for j := 0; j < 4; j++ {
+		dc := m[0][j] + 4
+		a := dc + m[2][j]
+		b := dc - m[2][j]
+		c := (m[1][j]*c2)>>16 - (m[3][j]*c1)>>16
+		d := (m[1][j]*c1)>>16 + (m[3][j]*c2)>>16
+		p, o := pred[j*predStride:], out[j*outStride:]
+		o[0] = clip8(int32(p[0]) + (a+d)>>3)
+		o[1] = clip8(int32(p[1]) + (b+c)>>3)
+		o[2] = clip8(int32(p[2]) + (b-c)>>3)
+		o[3] = clip8(int32(p[3]) + (a-d)>>3)
+	}
+}
+
+// 輝度のDC成分の順方向WHT（libvpxと同じ整数演算）
+func vp8ForwardWHT(in [16]int32) [16]int32 {
+	var tmp, out [16]int32
+	for i := 0; i < 4; i++ {
+		a := (in[i*4+0] + in[i*4+2]) * 4
+		d := (in[i*4+1] + in[i*4+3]) * 4
+		c := (in[i*4+1] - in[i*4+3]) * 4
+		b := (in[i*4+0] - in[i*4+2]) * 4
+		tmp[i*4+0] = a + d
+		if a != 0 {
+			tmp[i*4+0]++
+		}
+		tmp[i*4+1] = b + c
+		tmp[i*4+2] = b - c
+		tmp[i*4+3] = a - d
+	}
+	for i := 0; i < 4; i++ {
+		a := tmp[i] + tmp[8+i]
+		d := tmp[4+i] + tmp[12+i]
+		c := tmp[4+i] - tmp[12+i]
+		b := tmp[i] - tmp[8+i]
+		for k, v := range [4]int32{a + d, b + c, b - c, a - d} {
+			if v < 0 {
+				v++
+			}
+			out[k*4+i] = (v + 3) >> 3
+		}
+	}
+	return out
+}
+
+// 輝度のDC成分の逆方向WHT（14.3節）
+func vp8InverseWHT(in [16]int32) [16]int32 {
+	var m, out [16]int32
+	for i := 0; i < 4; i++ {
+		a0 := in[i] + in[12+i]
+		a1 := in[4+i] + in[8+i]
+		a2 := in[4+i] - in[8+i]
+		a3 := in[i] - in[12+i]
+		m[i] = a0 + a1
+		m[8+i] = a0 - a1
+		m[4+i] = a3 + a2
+		m[12+i] = a3 - a2
+	}
+	for i := 0; i < 4; i++ {
+		dc := m[i*4] + 3
+		a0 := dc + m[i*4+3]
+		a1 := m[i*4+1] + m[i*4+2]
+		a2 := m[i*4+1] - m[i*4+2]
+		a3 := dc - m[i*4+3]
+		out[i*4+0] = (a0 + a1) >> 3
+		out[i*4+1] = (a3 + a2) >> 3
+		out[i*4+2] = (a0 - a1) >> 3
+		out[i*4+3] = (a3 - a2) >> 3
+	}
+	return out
+}
+
+// 係数を量子化
+// AC成分は0に丸める範囲を広げ、細かな成分を落として符号量を抑える
+func vp8Quantize(c, q int32, dc bool) int32 {
+	bias := q * 3 / 8
+	if dc {
+		bias = q / 2
+	}
+	neg := c < 0
+	if neg {
+		c = -c
+	}
+	level := min((c+bias)/q, vp8MaxLevel)
+	if neg {
+		return -level
+	}
+	return level
+}
+
+// 4x4ブロックの係数をジグザグ順に記録（13.2〜13.3節）
+// firstから記録を始め、0以外の係数があれば1を返す
+func (e *vp8Encoder) putCoefficients(plane int, ctx uint8, levels *[16]int32, first int) uint8 {
+	last := -1
+	for n := first; n < 16; n++ {
+		if levels[vp8Zigzag[n]] != 0 {
+			last = n
+		}
+	}
+	n := first
+	p := vp8ProbIndex(plane, n, ctx)
+	if last < 0 {
+		e.put(p, 0)
+		return 0
+	}
+	e.put(p, 1)
+	for n < 16 {
+		v := levels[vp8Zigzag[n]]
+		n++
+		if v == 0 {
+			e.put(p+1, 0)
+			p = vp8ProbIndex(plane, n, 0)
+			continue
+		}
+		e.put(p+1, 1)
+		abs := v
+		if abs < 0 {
+			abs = -abs
+		}
+		if abs == 1 {
+			e.put(p+2, 0)
+			ctx = 1
+		} else {
+			e.put(p+2, 1)
+			e.putLevel(p, abs)
+			ctx = 2
+		}
+		sign := uint8(0)
+		if v < 0 {
+			sign = 1
+		}
+		e.putFixed(128, sign)
+		p = vp8ProbIndex(plane, n, ctx)
+		if n == 16 {
+			break
+		}
+		if n > last {
+			e.put(p, 0)
+			break
+		}
+		e.put(p, 1)
+	}
+	return 1
+}
+
+// 2以上の係数の絶対値を記録
+func (e *vp8Encoder) putLevel(p uint16, abs int32) {
+	switch {
+	case abs <= 4:
+		e.put(p+3, 0)
+		if abs == 2 {
+			e.put(p+4, 0)
+		} else {
+			e.put(p+4, 1)
+			e.put(p+5, uint8(abs-3))
+		}
+	case abs <= 10:
+		e.put(p+3, 1)
+		e.put(p+6, 0)
+		if abs <= 6 {
+			e.put(p+7, 0)
+			e.putFixed(159, uint8(abs-5))
+		} else {
+			e.put(p+7, 1)
+			e.putFixed(165, uint8((abs-7)>>1))
+			e.putFixed(145, uint8((abs-7)&1))
+		}
+	default:
+		e.put(p+3, 1)
+		e.put(p+6, 1)
+		cat := 3
+		for cat > 0 && abs < 3+8<<cat {
+			cat--
+		}
+		e.put(p+8, uint8(cat>>1))
+		e.put(p+9+uint16(cat>>1), uint8(cat&1))
+		extra := abs - (3 + 8<<cat)
+		probs := vp8Cat3456[cat]
+		for i, prob := range probs {
+			e.putFixed(prob, uint8(extra>>(len(probs)-1-i))&1)
+		}
+	}
+}
+
+func vp8ProbIndex(plane, n int, ctx uint8) uint16 {
+	return uint16(((plane*vp8NumBands+int(vp8Bands[n]))*vp8NumContexts + int(ctx)) * vp8NumProbs)
+}
+
+func (e *vp8Encoder) put(prob uint16, bit uint8) {
+	e.tokens = append(e.tokens, vp8Token{prob: prob, bit: bit})
+}
+
+func (e *vp8Encoder) putFixed(prob uint8, bit uint8) {
+	e.tokens = append(e.tokens, vp8Token{fixed: prob, bit: bit})
+}
+
+// フレームのビット列を生成（9節）
+// 係数の出現確率は実際の出現頻度から求め、符号量が減る場合のみ更新する
+func (e *vp8Encoder) bitstream(filterLevel int) []byte {
+	skipped := 0
+	for _, mb := range e.mbs {
+		if mb.skip {
+			skipped++
+		}
+	}
+	useSkip := skipped > 0
+
+	var counts [vp8NumPlanes * vp8NumBands * vp8NumContexts * vp8NumProbs][2]int
+	for _, mb := range e.mbs {
+		if useSkip && mb.skip {
+			continue
+		}
+		for _, t := range e.tokens[mb.tokens[0]:mb.tokens[1]] {
+			if t.fixed == 0 {
+				counts[t.prob][t.bit]++
+			}
+		}
+	}
+
+	var hdr vp8BoolEncoder
+	hdr.putLiteral(0, 1) // 色空間
+	hdr.putLiteral(0, 1) // 画素値のクランプ
+	hdr.putLiteral(0, 1) // セグメント
+	hdr.putLiteral(0, 1) // ループフィルタの種類（通常）
+	hdr.putLiteral(uint32(filterLevel), 6)
+	hdr.putLiteral(0, 3) // シャープネス
+	hdr.putLiteral(0, 1) // モード・参照フレームごとのフィルタ調整
+	hdr.putLiteral(0, 2) // 係数のパーティション数（1）
+	hdr.putLiteral(uint32(e.qIndex), 7)
+	for i := 0; i < 5; i++ {
+		hdr.putLiteral(0, 1) // 量子化インデックスの差分
+	}
+	hdr.putLiteral(0, 1) // 出現確率の保存
+
+	var probs [vp8NumPlanes * vp8NumBands * vp8NumContexts * vp8NumProbs]uint8
+	for i := range probs {
+		def := vp8DefaultTokenProb[i/264][i/33%8][i/11%3][i%11]
+		upd := vp8TokenUpdateProb[i/264][i/33%8][i/11%3][i%11]
+		c0, c1 := counts[i][0], counts[i][1]
+		probs[i] = def
+		if c0+c1 == 0 {
+			hdr.putBit(upd, 0)
+			continue
+		}
+		p := uint8(min(max((c0*256+(c0+c1)/2)/(c0+c1), 1), 255))
+		oldCost := bitCost(def, c0, c1) + bitCost(upd, 1, 0)
+		newCost := bitCost(p, c0, c1) + bitCost(upd, 0, 1) + 8
+		if newCost < oldCost {
+			probs[i] = p
+			hdr.putBit(upd, 1)
+			hdr.putLiteral(uint32(p), 8)
+		} else {
+			hdr.putBit(upd, 0)
+		}
+	}
+
+	var skipProb uint8
+	if useSkip {
+		skipProb = uint8(min(max((len(e.mbs)-skipped)*256/len(e.mbs), 1), 255))
+		hdr.putLiteral(1, 1)
+		hdr.putLiteral(uint32(skipProb), 8)
+	} else {
+		hdr.putLiteral(0, 1)
+	}
+
+	var data vp8BoolEncoder
+	for _, mb := range e.mbs {
+		if useSkip {
+			hdr.putBit(skipProb, boolBit(mb.skip))
+		}
+		hdr.putBit(145, 1) // 16x16単位の予測
+		switch mb.yMode {
+		case vp8PredDC:
+			hdr.putBit(156, 0)
+			hdr.putBit(163, 0)
+		case vp8PredV:
+			hdr.putBit(156, 0)
+			hdr.putBit(163, 1)
+		case vp8PredH:
+			hdr.putBit(156, 1)
+			hdr.putBit(128, 0)
+		case vp8PredTM:
+			hdr.putBit(156, 1)
+			hdr.putBit(128, 1)
+		}
+		switch mb.uvMode {
+		case vp8PredDC:
+			hdr.putBit(142, 0)
+		case vp8PredV:
+			hdr.putBit(142, 1)
+			hdr.putBit(114, 0)
+		case vp8PredH:
+			hdr.putBit(142, 1)
+			hdr.putBit(114, 1)
+			hdr.putBit(183, 0)
+		case vp8PredTM:
+			hdr.putBit(142, 1)
+			hdr.putBit(114, 1)
+			hdr.putBit(183, 1)
+		}
+
+		if useSkip && mb.skip {
+			continue
+		}
+		for _, t := range e.tokens[mb.tokens[0]:mb.tokens[1]] {
+			if t.fixed != 0 {
+				data.putBit(t.fixed, t.bit)
+			} else {
+				data.putBit(probs[t.prob], t.bit)
+			}
+		}
+	}
+
+	first, second := hdr.finish(), data.finish()
+	out := make([]byte, 10, 10+len(first)+len(second))
+	tag := uint32(len(first))<<5 | 1<<4 // キーフレーム、表示する
+	out[0], out[1], out[2] = byte(tag), byte(tag>>8), byte(tag>>16)
+	out[3], out[4], out[5] = 0x9d, 0x01, 0x2a
+	binary.LittleEndian.PutUint16(out[6:], uint16(e.width))
+	binary.LittleEndian.PutUint16(out[8:], uint16(e.height))
+	out = append(out, first...)
+	return append(out, second...)
+}
+
+// 確率probで0がc0回、1がc1回出現する場合の符号量（ビット）
+func bitCost(prob uint8, c0, c1 int) float64 {
+	p := float64(prob) / 256
+	return -float64(c0)*math.Log2(p) - float64(c1)*math.Log2(1-p)
+}
+
+func boolBit(b bool) uint8 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func clip8(v int32) uint8 {
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return uint8(v)
+}
+
+// ブール算術符号化（7節）
+type vp8BoolEncoder struct {
+	out      []byte
+	rng      uint32
+	bottom   uint32
+	bitCount int
+	started  bool
+}
+
+func (b *vp8BoolEncoder) putBit(prob uint8, bit uint8) {
+	if !b.started {
+		b.rng, b.bitCount, b.started = 255, 24, true
+	}
+	split := 1 + ((b.rng - 1) * uint32(prob) >> 8)
+	if bit != 0 {
+		b.bottom += split
+		b.rng -= split
+	} else {
+		b.rng = split
+	}
+	for b.rng < 128 {
+		b.rng <<= 1
+		if b.bottom&(1<<31) != 0 {
+			b.carry()
+		}
+		b.bottom <<= 1
+		b.bitCount--
+		if b.bitCount == 0 {
+			b.out = append(b.out, byte(b.bottom>>24))
+			b.bottom &= 1<<24 - 1
+			b.bitCount = 8
+		}
+	}
+}
+
+// 値を上位ビットから確率1/2で記録
+func (b *vp8BoolEncoder) putLiteral(v uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		b.putBit(128, uint8(v>>i)&1)
+	}
+}
+
+// 繰り上がりを出力済みのバイトに反映
+func (b *vp8BoolEncoder) carry() {
+	i := len(b.out) - 1
+	for ; i >= 0 && b.out[i] == 255; i-- {
+		b.out[i] = 0
+	}
+	b.out[i]++
+}
+
+// 残りのビットを出力してビット列を返す
+func (b *vp8BoolEncoder) finish() []byte {
+	if !b.started {
+		b.rng, b.bitCount, b.started = 255, 24, true
+	}
+	c := b.bitCount
+	v := b.bottom
+	if v&(1<<(32-c)) != 0 {
+		b.carry()
+	}
+	v <<= c & 7
+	for c >>= 3; c > 0; c-- {
+		v <<= 8
+	}
+	for i := 0; i < 4; i++ {
+		b.out = append(b.out, byte(v>>24))
+		v <<= 8
+	}
+	return b.out
+}
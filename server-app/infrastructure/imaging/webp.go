@@ -0,0 +1,65 @@
+package imaging
+
+import (
+	"encoding/binary"
+	"image"
+	"io"
+	"math"
+)
+
+// WebP（非可逆）の出力形式
+// 透過は保持できないため、不透明な画像のみに使用する
+type webpEncoder struct {
+	quality int
+}
+
+// WebPの出力形式の生成
+// qualityは品質（1〜100）で、範囲外の場合はJPEGの品質のデフォルト値を使用する
+func NewWebPEncoder(quality int) Encoder {
+	if quality < 1 || quality > 100 {
+		quality = DefaultJPEGQuality
+	}
+	return webpEncoder{quality: quality}
+}
+
+func (e webpEncoder) Format() string      { return "webp" }
+func (e webpEncoder) ContentType() string { return "image/webp" }
+func (e webpEncoder) Ext() string         { return ".webp" }
+func (e webpEncoder) Alpha() bool         { return false }
+func (e webpEncoder) Encode(w io.Writer, img *image.NRGBA) error {
+	q := e.quantizer()
+	frame, err := encodeVP8(img, q, webpFilterLevel(q))
+	if err != nil {
+		return err
+	}
+
+	// RIFFコンテナ（チャンクは偶数長に揃える）
+	padded := len(frame) + len(frame)&1
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+padded))
+	copy(header[8:], "WEBPVP8 ")
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(frame)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(frame); err != nil {
+		return err
+	}
+	if padded != len(frame) {
+		_, err = w.Write([]byte{0})
+	}
+	return err
+}
+
+// 品質をVP8の量子化インデックス（0〜127）に変換
+// 同じ品質のJPEGと同程度の画質になるよう、高品質側を細かく割り当てる
+func (e webpEncoder) quantizer() int {
+	return int(math.Round(127 * math.Pow(float64(100-e.quality)/100, 1.2)))
+}
+
+// 量子化インデックスに応じたループフィルタの強さ
+// 量子化が粗いほどブロック境界が目立つため強くする
+func webpFilterLevel(q int) int {
+	return min(q*5/8, 63)
+}
@@ -0,0 +1,123 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/webp"
+)
+
+// 写真に近い検証用の画像
+// 縦横ともマクロブロック（16画素）の倍数でないサイズとし、端の処理も検証する
+func testPhoto(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	seed := uint32(1)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			fx, fy := float64(x)/float64(w), float64(y)/float64(h)
+			r := 128 + 100*math.Sin(fx*7+fy*3)
+			g := 255 * fy
+			b := 128 + 90*math.Cos(fx*fy*20)
+			// 右半分は細かい模様（固定の擬似乱数）
+			if x > w/2 {
+				seed = seed*1664525 + 1013904223
+				n := float64(seed>>24) - 128
+				r, g, b = r+n/4, g+n/5, b+n/6
+			}
+			// 境界のはっきりした図形
+			if (x-w/3)*(x-w/3)+(y-h/2)*(y-h/2) < (h/4)*(h/4) {
+				r, g, b = 230, 40, 30
+			}
+			r, g, b = math.Max(0, math.Min(255, r)), math.Max(0, math.Min(255, g)), math.Max(0, math.Min(255, b))
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 255})
+		}
+	}
+	return img
+}
+
+// 輝度（Y）のPSNR
+// VP8はBT.601のスタジオレンジ（16〜235）で輝度を持つため、元画像も同じ式で変換して比較する
+func psnrY(src *image.NRGBA, decoded *image.YCbCr) float64 {
+	var sum float64
+	bounds := src.Rect
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := src.NRGBAAt(x, y)
+			want := 16 + (65.481*float64(c.R)+128.553*float64(c.G)+24.966*float64(c.B))/255
+			got := decoded.Y[decoded.YOffset(x, y)]
+			d := want - float64(got)
+			sum += d * d
+		}
+	}
+	mse := sum / float64(bounds.Dx()*bounds.Dy())
+	if mse == 0 {
+		return math.Inf(1)
+	}
+	return 10 * math.Log10(255*255/mse)
+}
+
+func TestWebPEncoder_RoundTrip(t *testing.T) {
+	src := testPhoto(203, 157)
+
+	tests := []struct {
+		quality int
+		minPSNR float64
+	}{
+		{quality: 95, minPSNR: 46},
+		{quality: DefaultJPEGQuality, minPSNR: 38},
+		{quality: 50, minPSNR: 29},
+		{quality: 10, minPSNR: 26},
+	}
+	prevSize := math.MaxInt
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if !assert.NoError(t, NewWebPEncoder(tt.quality).Encode(&buf, src)) {
+			continue
+		}
+		size := buf.Len()
+		decoded, err := webp.Decode(&buf)
+		if !assert.NoError(t, err, "quality %d", tt.quality) {
+			continue
+		}
+		assert.Equal(t, src.Rect.Size(), decoded.Bounds().Size(), "quality %d", tt.quality)
+		ycc, ok := decoded.(*image.YCbCr)
+		if !assert.True(t, ok, "quality %d", tt.quality) {
+			continue
+		}
+		psnr := psnrY(src, ycc)
+		assert.GreaterOrEqual(t, psnr, tt.minPSNR, "quality %d", tt.quality)
+		// 品質を下げるほど小さくなる
+		assert.Less(t, size, prevSize, "quality %d", tt.quality)
+		prevSize = size
+	}
+}
+
+func TestWebPEncoder_Container(t *testing.T) {
+	var buf bytes.Buffer
+	// 奇数長のフレームでも偶数長に揃えられることを確認するため小さな画像を複数試す
+	for _, size := range []int{1, 2, 15, 17} {
+		buf.Reset()
+		if !assert.NoError(t, NewWebPEncoder(80).Encode(&buf, testPhoto(size, size))) {
+			continue
+		}
+		data := buf.Bytes()
+		assert.Equal(t, "RIFF", string(data[:4]))
+		assert.Equal(t, "WEBPVP8 ", string(data[8:16]))
+		assert.Equal(t, 0, len(data)%2, "size %d", size)
+		assert.Equal(t, len(data)-8, int(uint32(data[4])|uint32(data[5])<<8|uint32(data[6])<<16|uint32(data[7])<<24), "size %d", size)
+		decoded, err := webp.Decode(bytes.NewReader(data))
+		if assert.NoError(t, err, "size %d", size) {
+			assert.Equal(t, image.Pt(size, size), decoded.Bounds().Size())
+		}
+	}
+}
+
+func TestNewWebPEncoder_QualityOutOfRange(t *testing.T) {
+	assert.Equal(t, webpEncoder{quality: DefaultJPEGQuality}, NewWebPEncoder(0))
+	assert.Equal(t, webpEncoder{quality: DefaultJPEGQuality}, NewWebPEncoder(101))
+	assert.Equal(t, webpEncoder{quality: 60}, NewWebPEncoder(60))
+}
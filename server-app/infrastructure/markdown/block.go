@@ -0,0 +1,491 @@
+package markdown
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 表の列数の上限
+const maxTableColumns = 64
+
+type blockKind int
+
+const (
+	blockParagraph blockKind = iota
+	blockHeading
+	blockCode
+	blockQuote
+	blockList
+	blockItem
+	blockRule
+	blockTable
+)
+
+// タスクリストの状態
+const (
+	taskNone = iota
+	taskUnchecked
+	taskChecked
+)
+
+// ブロック要素
+type block struct {
+	kind     blockKind
+	level    int    // 見出しレベル
+	text     string // 段落・見出しのインライン文字列、コードブロックの内容
+	lang     string // コードブロックの言語
+	ordered  bool
+	start    int
+	tight    bool
+	task     int
+	children []*block
+	aligns   []string
+	header   []string
+	rows     [][]string
+}
+
+var (
+	atxHeadingRe     = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ ]+(.*?))?[ ]*$`)
+	atxClosingRe     = regexp.MustCompile(`(?:^|[ ]+)#+[ ]*$`)
+	setextRe         = regexp.MustCompile(`^ {0,3}(=+|-+)[ ]*$`)
+	fenceRe          = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})(.*)$")
+	quoteRe          = regexp.MustCompile(`^ {0,3}> ?`)
+	taskRe           = regexp.MustCompile(`^\[([ xX])\](?:[ ]+|$)`)
+	tableDelimCellRe = regexp.MustCompile(`^:?-+:?$`)
+	langRe           = regexp.MustCompile(`^[A-Za-z0-9_+#.-]+$`)
+)
+
+// 行の並びをブロック要素に分割
+func parseBlocks(lines []string) []*block {
+	var blocks []*block
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			i++
+			continue
+		}
+
+		var b *block
+		n := 1
+		switch {
+		case matchFence(line) != nil:
+			b, n = parseFencedCode(lines[i:])
+		case indentOf(line) >= 4:
+			b, n = parseIndentedCode(lines[i:])
+		case atxHeadingRe.MatchString(line):
+			b = parseATXHeading(line)
+		case isThematicBreak(line):
+			b = &block{kind: blockRule}
+		case quoteRe.MatchString(line):
+			b, n = parseQuote(lines[i:])
+		case matchListMarker(line) != nil:
+			b, n = parseList(lines[i:])
+		default:
+			if b, n = parseTable(lines[i:]); b == nil {
+				b, n = parseParagraph(lines[i:])
+			}
+		}
+		blocks = append(blocks, b)
+		i += n
+	}
+	return blocks
+}
+
+// 段落の途中で新しいブロックを開始する行かを判定
+func interruptsParagraph(line string) bool {
+	if isBlank(line) || matchFence(line) != nil || atxHeadingRe.MatchString(line) ||
+		isThematicBreak(line) || quoteRe.MatchString(line) {
+		return true
+	}
+	// 空の項目と1以外から始まる番号付きリストは段落を中断しない
+	m := matchListMarker(line)
+	return m != nil && !m.empty && (!m.ordered || m.start == 1)
+}
+
+func parseParagraph(lines []string) (*block, int) {
+	text := []string{strings.TrimLeft(lines[0], " ")}
+	i := 1
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlank(line) {
+			break
+		}
+		// 段落直後の=または-の行は見出しとする
+		if m := setextRe.FindStringSubmatch(line); m != nil {
+			level := 2
+			if m[1][0] == '=' {
+				level = 1
+			}
+			return &block{kind: blockHeading, level: level, text: strings.TrimSpace(strings.Join(text, "\n"))}, i + 1
+		}
+		if interruptsParagraph(line) {
+			break
+		}
+		text = append(text, strings.TrimLeft(line, " "))
+	}
+	return &block{kind: blockParagraph, text: strings.TrimRight(strings.Join(text, "\n"), " ")}, i
+}
+
+func parseATXHeading(line string) *block {
+	m := atxHeadingRe.FindStringSubmatch(line)
+	text := atxClosingRe.ReplaceAllString(m[2], "")
+	return &block{kind: blockHeading, level: len(m[1]), text: strings.TrimSpace(text)}
+}
+
+// フェンスコードブロックの開始行
+type fenceInfo struct {
+	indent int
+	char   byte
+	length int
+	info   string
+}
+
+func matchFence(line string) *fenceInfo {
+	m := fenceRe.FindStringSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	// バッククォートのフェンスでは情報文字列にバッククォートを含められない
+	if m[2][0] == '`' && strings.Contains(m[3], "`") {
+		return nil
+	}
+	return &fenceInfo{
+		indent: len(m[1]),
+		char:   m[2][0],
+		length: len(m[2]),
+		info:   strings.TrimSpace(m[3]),
+	}
+}
+
+// 開始行と同じ文字で同じ長さ以上のフェンスかを判定
+func (f *fenceInfo) isClosing(line string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return false
+	}
+	trimmed = strings.TrimRight(trimmed, " ")
+	return len(trimmed) >= f.length && strings.Trim(trimmed, string(f.char)) == ""
+}
+
+func parseFencedCode(lines []string) (*block, int) {
+	fence := matchFence(lines[0])
+	b := &block{kind: blockCode}
+	if fields := strings.Fields(fence.info); len(fields) > 0 {
+		// 言語名はclass属性に出力するため使用できる文字を限定する
+		if lang := unescapeText(fields[0]); langRe.MatchString(lang) {
+			b.lang = lang
+		}
+	}
+
+	var content []string
+	i := 1
+	for ; i < len(lines); i++ {
+		if fence.isClosing(lines[i]) {
+			i++
+			break
+		}
+		content = append(content, trimIndent(lines[i], fence.indent))
+	}
+	if len(content) > 0 {
+		b.text = strings.Join(content, "\n") + "\n"
+	}
+	return b, i
+}
+
+func parseIndentedCode(lines []string) (*block, int) {
+	var content []string
+	i := 0
+	for ; i < len(lines); i++ {
+		if !isBlank(lines[i]) && indentOf(lines[i]) < 4 {
+			break
+		}
+		content = append(content, trimIndent(lines[i], 4))
+	}
+	// 末尾の空行は含めない
+	for len(content) > 0 && isBlank(content[len(content)-1]) {
+		content = content[:len(content)-1]
+	}
+	return &block{kind: blockCode, text: strings.Join(content, "\n") + "\n"}, i
+}
+
+func parseQuote(lines []string) (*block, int) {
+	var content []string
+	i := 0
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if loc := quoteRe.FindStringIndex(line); loc != nil {
+			content = append(content, line[loc[1]:])
+			continue
+		}
+		// 段落の遅延継続行は引用に含める
+		if len(content) > 0 && !isBlank(content[len(content)-1]) && !interruptsParagraph(line) &&
+			matchListMarker(line) == nil && indentOf(content[len(content)-1]) < 4 {
+			content = append(content, line)
+			continue
+		}
+		break
+	}
+	return &block{kind: blockQuote, children: parseBlocks(content)}, i
+}
+
+// リストの項目記号
+type listMarker struct {
+	ordered       bool
+	char          byte // 箇条書きの記号、または番号の後の区切り文字
+	start         int
+	contentIndent int // 項目の内容が始まる桁
+	empty         bool
+}
+
+func matchListMarker(line string) *listMarker {
+	indent := indentOf(line)
+	if indent > 3 || isThematicBreak(line) {
+		return nil
+	}
+
+	m := &listMarker{}
+	pos := indent
+	switch {
+	case pos < len(line) && strings.IndexByte("-+*", line[pos]) >= 0:
+		m.char = line[pos]
+		pos++
+	default:
+		digits := pos
+		for digits < len(line) && digits-pos < 9 && line[digits] >= '0' && line[digits] <= '9' {
+			digits++
+		}
+		if digits == pos || digits >= len(line) || (line[digits] != '.' && line[digits] != ')') {
+			return nil
+		}
+		m.ordered = true
+		m.start, _ = strconv.Atoi(line[pos:digits])
+		m.char = line[digits]
+		pos = digits + 1
+	}
+
+	// 記号の後には空白が必要
+	if pos == len(line) || isBlank(line[pos:]) {
+		m.empty = true
+		m.contentIndent = pos + 1
+		return m
+	}
+	if line[pos] != ' ' {
+		return nil
+	}
+	spaces := indentOf(line[pos:])
+	// 5桁以上の空白は項目内の字下げコードブロックとして扱う
+	if spaces > 4 {
+		spaces = 1
+	}
+	m.contentIndent = pos + spaces
+	return m
+}
+
+func parseList(lines []string) (*block, int) {
+	first := matchListMarker(lines[0])
+	list := &block{kind: blockList, ordered: first.ordered, start: first.start, tight: true}
+
+	i := 0
+	for i < len(lines) {
+		m := matchListMarker(lines[i])
+		if m == nil || m.ordered != first.ordered || m.char != first.char {
+			break
+		}
+
+		head := ""
+		if m.contentIndent < len(lines[i]) {
+			head = lines[i][m.contentIndent:]
+		}
+		content := []string{head}
+		j := i + 1
+		for j < len(lines) {
+			line := lines[j]
+			if isBlank(line) {
+				// 空行の後に字下げされた行が続く場合は同じ項目の内容とする
+				next := j
+				for next < len(lines) && isBlank(lines[next]) {
+					next++
+				}
+				if next < len(lines) && indentOf(lines[next]) >= m.contentIndent {
+					for ; j < next; j++ {
+						content = append(content, "")
+					}
+					continue
+				}
+				break
+			}
+			if indentOf(line) >= m.contentIndent {
+				content = append(content, line[m.contentIndent:])
+				j++
+				continue
+			}
+			// 段落の遅延継続行
+			if !isBlank(content[len(content)-1]) && !interruptsParagraph(line) && matchListMarker(line) == nil {
+				content = append(content, line)
+				j++
+				continue
+			}
+			break
+		}
+
+		item := &block{kind: blockItem}
+		if tm := taskRe.FindStringSubmatch(content[0]); tm != nil {
+			item.task = taskUnchecked
+			if tm[1] != " " {
+				item.task = taskChecked
+			}
+			content[0] = content[0][len(tm[0]):]
+		}
+		item.children = parseBlocks(content)
+		// 項目内のブロック間に空行がある場合は項目を段落として表示する
+		if len(item.children) > 1 && hasInnerBlank(content) {
+			list.tight = false
+		}
+		list.children = append(list.children, item)
+
+		// 項目間の空行
+		next := j
+		for next < len(lines) && isBlank(lines[next]) {
+			next++
+		}
+		if next > j {
+			nm := matchListMarker(lineAt(lines, next))
+			if nm == nil || nm.ordered != first.ordered || nm.char != first.char {
+				i = j
+				break
+			}
+			list.tight = false
+		}
+		i = next
+	}
+	return list, i
+}
+
+// 末尾以外に空行を含むかを判定
+func hasInnerBlank(lines []string) bool {
+	end := len(lines)
+	for end > 0 && isBlank(lines[end-1]) {
+		end--
+	}
+	for _, line := range lines[:end] {
+		if isBlank(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// 見出し行・区切り行からなるGFMの表
+func parseTable(lines []string) (*block, int) {
+	if len(lines) < 2 || !strings.Contains(lines[0], "|") || !strings.Contains(lines[1], "|") ||
+		indentOf(lines[0]) > 3 {
+		return nil, 0
+	}
+	header := splitTableRow(lines[0])
+	delims := splitTableRow(lines[1])
+	if len(header) != len(delims) || len(header) > maxTableColumns {
+		return nil, 0
+	}
+
+	b := &block{kind: blockTable, header: header, aligns: make([]string, len(delims))}
+	for i, cell := range delims {
+		if !tableDelimCellRe.MatchString(cell) {
+			return nil, 0
+		}
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			b.aligns[i] = "center"
+		case right:
+			b.aligns[i] = "right"
+		case left:
+			b.aligns[i] = "left"
+		}
+	}
+
+	i := 2
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if isBlank(line) || interruptsParagraph(line) {
+			break
+		}
+		// 列数は見出し行に合わせる
+		row := splitTableRow(line)
+		cells := make([]string, len(header))
+		copy(cells, row)
+		b.rows = append(b.rows, cells)
+	}
+	return b, i
+}
+
+// 表の行をセルに分割
+// エスケープされた|はセルの区切りとしない
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteString(`\|`)
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func isThematicBreak(line string) bool {
+	if indentOf(line) > 3 {
+		return false
+	}
+	var char byte
+	count := 0
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == ' ':
+		case (c == '*' || c == '-' || c == '_') && (char == 0 || char == c):
+			char = c
+			count++
+		default:
+			return false
+		}
+	}
+	return count >= 3
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// 行頭の空白の桁数
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// 行頭の空白を最大n桁取り除く
+func trimIndent(line string, n int) string {
+	indent := indentOf(line)
+	if indent > n {
+		indent = n
+	}
+	return line[indent:]
+}
+
+func lineAt(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
@@ -0,0 +1,183 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// 1回の変換で共有する状態
+type document struct {
+	refs          map[string]linkRef
+	footnotes     map[string]*footnote
+	footnoteOrder []*footnote // 参照された順
+	toc           []domainBlog.TOCEntry
+	anchors       map[string]bool
+	inFootnote    bool
+}
+
+// リンク参照定義
+type linkRef struct {
+	dest  string
+	title string
+}
+
+// 脚注定義
+type footnote struct {
+	lines []string
+	index int // 参照された順の番号（未参照は0）
+	refs  int
+}
+
+var (
+	refDefRe      = regexp.MustCompile(`^ {0,3}\[([^\]^][^\]]*)\]:[ ]*(<[^<>\n]*>|\S+)(?:[ ]+("(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|\((?:[^()\\]|\\.)*\)))?[ ]*$`)
+	footnoteDefRe = regexp.MustCompile(`^ {0,3}\[\^([^\]\s]+)\]:[ ]?(.*)$`)
+)
+
+func newDocument() *document {
+	return &document{
+		refs:      make(map[string]linkRef),
+		footnotes: make(map[string]*footnote),
+		anchors:   make(map[string]bool),
+	}
+}
+
+// リンク参照定義と脚注定義を取り出し、それ以外の行を返す
+// 定義は空行の直後（または文書先頭・他の定義の直後）にあるもののみ対象とする
+func (d *document) extractDefinitions(lines []string) []string {
+	rest := make([]string, 0, len(lines))
+	canDefine := true
+	var fence *fenceInfo
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		// コードブロック内は対象外
+		if fence != nil {
+			if fence.isClosing(line) {
+				fence = nil
+			}
+			rest = append(rest, line)
+			continue
+		}
+		if f := matchFence(line); f != nil {
+			fence = f
+			rest = append(rest, line)
+			canDefine = false
+			continue
+		}
+
+		if canDefine {
+			if m := footnoteDefRe.FindStringSubmatch(line); m != nil {
+				fn := &footnote{lines: []string{m[2]}}
+				i = collectFootnote(lines, i+1, fn) - 1
+				label := normalizeLabel(m[1])
+				if _, exists := d.footnotes[label]; !exists {
+					d.footnotes[label] = fn
+				}
+				continue
+			}
+			if m := refDefRe.FindStringSubmatch(line); m != nil {
+				label := normalizeLabel(m[1])
+				if _, exists := d.refs[label]; !exists && label != "" {
+					dest := m[2]
+					if strings.HasPrefix(dest, "<") {
+						dest = dest[1 : len(dest)-1]
+					}
+					title := ""
+					if len(m[3]) >= 2 {
+						title = m[3][1 : len(m[3])-1]
+					}
+					d.refs[label] = linkRef{dest: unescapeText(dest), title: unescapeText(title)}
+				}
+				continue
+			}
+		}
+
+		rest = append(rest, line)
+		canDefine = isBlank(line)
+	}
+	return rest
+}
+
+// 脚注定義の継続行を取得し、次に処理する行の位置を返す
+// 4桁以上字下げされた行と、空行以外の遅延継続行を脚注の内容とする
+func collectFootnote(lines []string, start int, fn *footnote) int {
+	i := start
+	for i < len(lines) {
+		line := lines[i]
+		if isBlank(line) {
+			next := i
+			for next < len(lines) && isBlank(lines[next]) {
+				next++
+			}
+			if next < len(lines) && indentOf(lines[next]) >= 4 {
+				for ; i < next; i++ {
+					fn.lines = append(fn.lines, "")
+				}
+				continue
+			}
+			return i
+		}
+		if indentOf(line) >= 4 {
+			fn.lines = append(fn.lines, line[4:])
+			i++
+			continue
+		}
+		last := fn.lines[len(fn.lines)-1]
+		if isBlank(last) || interruptsParagraph(line) || footnoteDefRe.MatchString(line) {
+			return i
+		}
+		fn.lines = append(fn.lines, line)
+		i++
+	}
+	return i
+}
+
+// リンク参照のラベルを比較用に正規化
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+// 見出しのid属性の値を生成
+// 英数字・かな漢字などの文字以外を除き、空白をハイフンに変換して重複時は連番を付与する
+func (d *document) anchor(text string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), unicode.Is(unicode.Mn, r), r == '_', r == '-':
+			sb.WriteRune(r)
+		case unicode.IsSpace(r):
+			sb.WriteRune('-')
+		}
+	}
+	base := sb.String()
+	if base == "" {
+		base = "section"
+	}
+
+	slug := base
+	for n := 1; d.anchors[slug]; n++ {
+		slug = fmt.Sprintf("%s-%d", base, n)
+	}
+	d.anchors[slug] = true
+	return slug
+}
+
+// 脚注参照の番号と参照元のid属性の値を取得
+// 初めて参照された脚注に参照順の番号を付与する
+func (d *document) footnoteRef(label string) (int, string) {
+	fn := d.footnotes[label]
+	if fn.index == 0 {
+		d.footnoteOrder = append(d.footnoteOrder, fn)
+		fn.index = len(d.footnoteOrder)
+	}
+	fn.refs++
+	if fn.refs == 1 {
+		return fn.index, fmt.Sprintf("fnref-%d", fn.index)
+	}
+	return fn.index, fmt.Sprintf("fnref-%d-%d", fn.index, fn.refs)
+}
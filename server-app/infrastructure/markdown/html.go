@@ -0,0 +1,280 @@
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// リンク・画像に使用できるURLスキーム
+var (
+	linkSchemes  = map[string]bool{"http": true, "https": true, "mailto": true}
+	imageSchemes = map[string]bool{"http": true, "https": true}
+)
+
+// ブロック要素をHTMLに出力
+// tightの場合は段落をpタグで囲まない
+func (d *document) renderBlocks(sb *strings.Builder, blocks []*block, tight bool) {
+	for _, b := range blocks {
+		switch b.kind {
+		case blockParagraph:
+			if tight {
+				d.renderInline(sb, b.text)
+				continue
+			}
+			sb.WriteString("<p>")
+			d.renderInline(sb, b.text)
+			sb.WriteString("</p>\n")
+		case blockHeading:
+			d.renderHeading(sb, b)
+		case blockCode:
+			sb.WriteString("<pre><code")
+			if b.lang != "" {
+				fmt.Fprintf(sb, ` class="language-%s"`, html.EscapeString(b.lang))
+			}
+			sb.WriteString(">")
+			sb.WriteString(html.EscapeString(b.text))
+			sb.WriteString("</code></pre>\n")
+		case blockQuote:
+			sb.WriteString("<blockquote>\n")
+			d.renderBlocks(sb, b.children, false)
+			sb.WriteString("</blockquote>\n")
+		case blockList:
+			d.renderList(sb, b)
+		case blockRule:
+			sb.WriteString("<hr>\n")
+		case blockTable:
+			d.renderTable(sb, b)
+		}
+	}
+}
+
+// 見出しにid属性を付与し、脚注以外の見出しを目次に追加
+func (d *document) renderHeading(sb *strings.Builder, b *block) {
+	root := d.parseInline(b.text)
+	text := plainText(root)
+	anchor := d.anchor(text)
+	if !d.inFootnote {
+		d.toc = append(d.toc, domainBlog.TOCEntry{Level: b.level, Text: text, Anchor: anchor})
+	}
+	fmt.Fprintf(sb, `<h%d id="%s">`, b.level, html.EscapeString(anchor))
+	d.writeInline(sb, root, false)
+	fmt.Fprintf(sb, "</h%d>\n", b.level)
+}
+
+func (d *document) renderList(sb *strings.Builder, b *block) {
+	tag := "ul"
+	if b.ordered {
+		tag = "ol"
+	}
+	if b.ordered && b.start != 1 {
+		fmt.Fprintf(sb, "<ol start=\"%d\">\n", b.start)
+	} else {
+		fmt.Fprintf(sb, "<%s>\n", tag)
+	}
+
+	for _, item := range b.children {
+		switch item.task {
+		case taskUnchecked:
+			sb.WriteString(`<li class="task-list-item"><input type="checkbox" disabled> `)
+		case taskChecked:
+			sb.WriteString(`<li class="task-list-item"><input type="checkbox" disabled checked> `)
+		default:
+			sb.WriteString("<li>")
+		}
+		// 段落以外のブロックから始まる項目は改行してから出力する
+		if len(item.children) > 0 && (!b.tight || item.children[0].kind != blockParagraph) {
+			sb.WriteString("\n")
+		}
+		for i, child := range item.children {
+			if i > 0 && !strings.HasSuffix(sb.String(), "\n") {
+				sb.WriteString("\n")
+			}
+			d.renderBlocks(sb, []*block{child}, b.tight)
+		}
+		sb.WriteString("</li>\n")
+	}
+	fmt.Fprintf(sb, "</%s>\n", tag)
+}
+
+func (d *document) renderTable(sb *strings.Builder, b *block) {
+	writeRow := func(cells []string, tag string) {
+		sb.WriteString("<tr>\n")
+		for i, cell := range cells {
+			if b.aligns[i] != "" {
+				fmt.Fprintf(sb, `<%s style="text-align:%s">`, tag, b.aligns[i])
+			} else {
+				fmt.Fprintf(sb, "<%s>", tag)
+			}
+			d.renderInline(sb, cell)
+			fmt.Fprintf(sb, "</%s>\n", tag)
+		}
+		sb.WriteString("</tr>\n")
+	}
+
+	sb.WriteString("<table>\n<thead>\n")
+	writeRow(b.header, "th")
+	sb.WriteString("</thead>\n")
+	if len(b.rows) > 0 {
+		sb.WriteString("<tbody>\n")
+		for _, row := range b.rows {
+			writeRow(row, "td")
+		}
+		sb.WriteString("</tbody>\n")
+	}
+	sb.WriteString("</table>\n")
+}
+
+// 参照された脚注を参照順に出力
+// 脚注の中から参照された脚注も対象とする
+func (d *document) renderFootnotes(sb *strings.Builder) {
+	if len(d.footnoteOrder) == 0 {
+		return
+	}
+	d.inFootnote = true
+	defer func() { d.inFootnote = false }()
+
+	sb.WriteString("<section class=\"footnotes\">\n<ol>\n")
+	for i := 0; i < len(d.footnoteOrder); i++ {
+		fn := d.footnoteOrder[i]
+		var body strings.Builder
+		d.renderBlocks(&body, parseBlocks(fn.lines), false)
+
+		var backrefs strings.Builder
+		for k := 1; k <= fn.refs; k++ {
+			id := fmt.Sprintf("fnref-%d", fn.index)
+			if k > 1 {
+				id = fmt.Sprintf("fnref-%d-%d", fn.index, k)
+			}
+			fmt.Fprintf(&backrefs, ` <a href="#%s" class="footnote-backref">↩</a>`, id)
+		}
+
+		// 戻りリンクは最後の段落の末尾に置く
+		content := body.String()
+		if strings.HasSuffix(content, "</p>\n") {
+			content = strings.TrimSuffix(content, "</p>\n") + backrefs.String() + "</p>\n"
+		} else {
+			content += "<p>" + strings.TrimSpace(backrefs.String()) + "</p>\n"
+		}
+		fmt.Fprintf(sb, "<li id=\"fn-%d\">\n%s</li>\n", fn.index, content)
+	}
+	sb.WriteString("</ol>\n</section>\n")
+}
+
+func (d *document) renderInline(sb *strings.Builder, text string) {
+	d.writeInline(sb, d.parseInline(text), false)
+}
+
+// インライン要素の子要素をHTMLに出力
+// 許可しないスキームのリンク・画像はテキストのみ出力する
+func (d *document) writeInline(sb *strings.Builder, parent *inline, inLink bool) {
+	for n := parent.first; n != nil; n = n.next {
+		switch n.kind {
+		case inlineText:
+			sb.WriteString(html.EscapeString(n.text))
+		case inlineCode:
+			sb.WriteString("<code>")
+			sb.WriteString(html.EscapeString(n.text))
+			sb.WriteString("</code>")
+		case inlineSoftBreak:
+			sb.WriteString("\n")
+		case inlineHardBreak:
+			sb.WriteString("<br>\n")
+		case inlineEmph, inlineStrong, inlineDel:
+			tag := map[inlineKind]string{inlineEmph: "em", inlineStrong: "strong", inlineDel: "del"}[n.kind]
+			fmt.Fprintf(sb, "<%s>", tag)
+			d.writeInline(sb, n, inLink)
+			fmt.Fprintf(sb, "</%s>", tag)
+		case inlineLink:
+			href, external, ok := safeURL(n.dest, linkSchemes)
+			if inLink || !ok {
+				d.writeInline(sb, n, inLink)
+				continue
+			}
+			fmt.Fprintf(sb, `<a href="%s"`, html.EscapeString(href))
+			if n.title != "" {
+				fmt.Fprintf(sb, ` title="%s"`, html.EscapeString(n.title))
+			}
+			if external {
+				sb.WriteString(` rel="nofollow noopener"`)
+			}
+			sb.WriteString(">")
+			d.writeInline(sb, n, true)
+			sb.WriteString("</a>")
+		case inlineImage:
+			alt := plainText(n)
+			src, _, ok := safeURL(n.dest, imageSchemes)
+			if !ok {
+				sb.WriteString(html.EscapeString(alt))
+				continue
+			}
+			fmt.Fprintf(sb, `<img src="%s" alt="%s"`, html.EscapeString(src), html.EscapeString(alt))
+			if n.title != "" {
+				fmt.Fprintf(sb, ` title="%s"`, html.EscapeString(n.title))
+			}
+			sb.WriteString(` loading="lazy">`)
+		case inlineFootnoteRef:
+			fmt.Fprintf(sb, `<sup class="footnote-ref"><a href="#fn-%d" id="%s">%d</a></sup>`, n.index, n.id, n.index)
+		}
+	}
+}
+
+// 装飾を除いたテキスト
+func plainText(parent *inline) string {
+	var sb strings.Builder
+	for n := parent.first; n != nil; n = n.next {
+		switch n.kind {
+		case inlineText, inlineCode:
+			sb.WriteString(n.text)
+		case inlineSoftBreak, inlineHardBreak:
+			sb.WriteString(" ")
+		case inlineEmph, inlineStrong, inlineDel, inlineLink, inlineImage:
+			sb.WriteString(plainText(n))
+		}
+	}
+	return sb.String()
+}
+
+// URLのスキームを検証し、属性値に使用できる形式に変換
+// スキームのない相対URLは許可し、外部サイトへのリンクかを合わせて返す
+func safeURL(dest string, schemes map[string]bool) (string, bool, bool) {
+	for _, r := range dest {
+		if r < 0x20 || r == 0x7f {
+			return "", false, false
+		}
+	}
+	if i := strings.IndexAny(dest, ":/?#"); i >= 0 && dest[i] == ':' {
+		scheme := strings.ToLower(dest[:i])
+		if !schemes[scheme] {
+			return "", false, false
+		}
+		return encodeURL(dest), scheme == "http" || scheme == "https", true
+	}
+	return encodeURL(dest), strings.HasPrefix(dest, "//"), true
+}
+
+// URLに使用できない文字をパーセントエンコード
+func encodeURL(s string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '%' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]):
+			sb.WriteByte(c)
+		case c != '%' && c < 0x80 && c > ' ' && strings.IndexByte(`"<>\^`+"`{|}", c) < 0:
+			sb.WriteByte(c)
+		default:
+			sb.WriteByte('%')
+			sb.WriteByte(hex[c>>4])
+			sb.WriteByte(hex[c&0x0f])
+		}
+	}
+	return sb.String()
+}
+
+func isHex(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
@@ -0,0 +1,406 @@
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// HTMLをMarkdownに変換する
+// 生のHTMLは表示時に全てエスケープされるため、取り込んだHTMLの本文はMarkdownに変換して保存する
+// 段落タグの外にある文字列はWordPressの自動整形に合わせ、空行を段落、改行を強制改行として扱う
+type htmlConverter struct{}
+
+func NewHTMLConverter() domainImporter.HTMLConverter {
+	return &htmlConverter{}
+}
+
+func (c *htmlConverter) ToMarkdown(src string) (string, error) {
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(src), context)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse HTML: %w", err)
+	}
+	content := strings.Join(convertBlocks(nodes), "\n\n")
+	if content == "" {
+		return "", nil
+	}
+	return content + "\n", nil
+}
+
+var (
+	blankLineRe  = regexp.MustCompile(`\n[ \t]*\n\s*`)
+	spacesRe     = regexp.MustCompile(`[ \t\r\f]+`)
+	whitespaceRe = regexp.MustCompile(`\s+`)
+	orderedRe    = regexp.MustCompile(`^(\d+)([.)])`)
+)
+
+// ブロック要素の並びをMarkdownのブロックに変換
+// 段落タグの外の文字列・インライン要素は次のブロック要素までを段落としてまとめる
+func convertBlocks(nodes []*html.Node) []string {
+	var blocks []string
+	var loose strings.Builder
+	flush := func() {
+		for _, para := range blankLineRe.Split(loose.String(), -1) {
+			if p := formatParagraph(para); p != "" {
+				blocks = append(blocks, p)
+			}
+		}
+		loose.Reset()
+	}
+
+	for _, n := range nodes {
+		if n.Type == html.ElementNode && isBlockElement(n.DataAtom) {
+			flush()
+			blocks = append(blocks, convertBlock(n)...)
+			continue
+		}
+		loose.WriteString(convertInline(n, true))
+	}
+	flush()
+	return blocks
+}
+
+func children(n *html.Node) []*html.Node {
+	var nodes []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		nodes = append(nodes, c)
+	}
+	return nodes
+}
+
+func isBlockElement(a atom.Atom) bool {
+	switch a {
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Main, atom.Aside,
+		atom.Figure, atom.Figcaption, atom.Center, atom.Address, atom.Details, atom.Summary,
+		atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6,
+		atom.Ul, atom.Ol, atom.Dl, atom.Dt, atom.Dd, atom.Blockquote, atom.Pre, atom.Hr, atom.Table,
+		atom.Script, atom.Style, atom.Noscript, atom.Template, atom.Iframe, atom.Video, atom.Audio:
+		return true
+	}
+	return false
+}
+
+func convertBlock(n *html.Node) []string {
+	switch n.DataAtom {
+	case atom.P, atom.Figcaption, atom.Dt, atom.Summary:
+		if p := formatParagraph(inlineChildren(n, false)); p != "" {
+			return []string{p}
+		}
+		return nil
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := strings.TrimSpace(whitespaceRe.ReplaceAllString(strings.ReplaceAll(inlineChildren(n, false), hardBreak, " "), " "))
+		if text == "" {
+			return nil
+		}
+		level := int(n.Data[1] - '0')
+		return []string{strings.Repeat("#", level) + " " + text}
+	case atom.Ul, atom.Ol:
+		if list := convertList(n); list != "" {
+			return []string{list}
+		}
+		return nil
+	case atom.Blockquote:
+		inner := strings.Join(convertBlocks(children(n)), "\n\n")
+		if inner == "" {
+			return nil
+		}
+		return []string{prefixLines(inner, "> ", ">")}
+	case atom.Pre:
+		return []string{convertPre(n)}
+	case atom.Hr:
+		return []string{"---"}
+	case atom.Table:
+		if table := convertTable(n); table != "" {
+			return []string{table}
+		}
+		return nil
+	case atom.Script, atom.Style, atom.Noscript, atom.Template:
+		return nil
+	case atom.Iframe, atom.Video, atom.Audio:
+		// 埋め込みは表示できないため元のURLへのリンクとする
+		if src := attr(n, "src"); src != "" {
+			return []string{fmt.Sprintf("[%s](%s)", escapeText(src), escapeURL(src))}
+		}
+		return convertBlocks(children(n))
+	}
+	return convertBlocks(children(n))
+}
+
+// 段落を整形
+// 改行（段落タグの外の改行と<br>）は強制改行とする
+func formatParagraph(text string) string {
+	text = strings.ReplaceAll(text, hardBreak+"\n", "\n")
+	text = strings.TrimSpace(strings.ReplaceAll(text, hardBreak, "\n"))
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+
+	out := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		out = append(out, escapeLineStart(line))
+	}
+	return strings.Join(out, "\\\n")
+}
+
+// 行頭でブロック要素の記法と解釈される文字をエスケープ
+func escapeLineStart(line string) string {
+	switch {
+	case strings.HasPrefix(line, "#"), strings.HasPrefix(line, ">"),
+		strings.HasPrefix(line, "- "), strings.HasPrefix(line, "+ "), strings.HasPrefix(line, "="),
+		line == "-" || line == "+":
+		return "\\" + line
+	}
+	if m := orderedRe.FindStringSubmatch(line); m != nil {
+		return m[1] + "\\" + line[len(m[1]):]
+	}
+	return line
+}
+
+func inlineChildren(n *html.Node, autop bool) string {
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sb.WriteString(convertInline(c, autop))
+	}
+	return sb.String()
+}
+
+// <br>による強制改行を表す文字
+// 直後の改行文字と合わせて1つの強制改行とするため、段落の整形まで改行文字と区別する
+const hardBreak = "\x00"
+
+// インライン要素をMarkdownに変換
+// 段落タグの外の文字列（autop）は改行を維持し、それ以外は空白にまとめる
+func convertInline(n *html.Node, autop bool) string {
+	switch n.Type {
+	case html.TextNode:
+		text := escapeText(n.Data)
+		if autop {
+			return spacesRe.ReplaceAllString(text, " ")
+		}
+		return whitespaceRe.ReplaceAllString(text, " ")
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return hardBreak
+	case atom.Strong, atom.B:
+		return wrapInline(inlineChildren(n, autop), "**")
+	case atom.Em, atom.I, atom.Cite:
+		return wrapInline(inlineChildren(n, autop), "*")
+	case atom.Del, atom.S, atom.Strike:
+		return wrapInline(inlineChildren(n, autop), "~~")
+	case atom.Code, atom.Kbd, atom.Tt, atom.Samp:
+		return codeSpan(textContent(n))
+	case atom.A:
+		text := strings.TrimSpace(strings.ReplaceAll(inlineChildren(n, false), hardBreak, " "))
+		href := attr(n, "href")
+		if href == "" {
+			return text
+		}
+		if text == "" {
+			text = escapeText(href)
+		}
+		return fmt.Sprintf("[%s](%s)", text, escapeURL(href))
+	case atom.Img:
+		src := attr(n, "src")
+		if src == "" {
+			return ""
+		}
+		return fmt.Sprintf("![%s](%s)", escapeText(attr(n, "alt")), escapeURL(src))
+	case atom.Script, atom.Style, atom.Noscript, atom.Template:
+		return ""
+	}
+	if isBlockElement(n.DataAtom) {
+		// インライン要素の中のブロック要素は区切りの改行として扱う
+		return "\n" + inlineChildren(n, autop) + "\n"
+	}
+	return inlineChildren(n, autop)
+}
+
+// 強調等の記号で囲む
+// 記号の内側が空白で始まる・終わると強調と解釈されないため、空白は外側に出す
+func wrapInline(inner, marker string) string {
+	trimmed := strings.TrimSpace(inner)
+	if trimmed == "" {
+		return inner
+	}
+	lead := inner[:len(inner)-len(strings.TrimLeft(inner, " \n"))]
+	trail := inner[len(strings.TrimRight(inner, " \n")):]
+	return lead + marker + trimmed + marker + trail
+}
+
+func codeSpan(text string) string {
+	text = whitespaceRe.ReplaceAllString(text, " ")
+	if text == "" {
+		return ""
+	}
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+func convertList(n *html.Node) string {
+	ordered := n.DataAtom == atom.Ol
+	var items []string
+	index := 1
+	if start, err := strconv.Atoi(attr(n, "start")); err == nil && start >= 0 && start < 1e9 {
+		index = start
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if ordered {
+			marker = fmt.Sprintf("%d. ", index)
+			index++
+		}
+		content := strings.Join(convertBlocks(children(c)), "\n\n")
+		if content == "" {
+			content = " "
+		}
+		indent := strings.Repeat(" ", len(marker))
+		items = append(items, marker+prefixLines(content, indent, "")[len(indent):])
+	}
+	return strings.Join(items, "\n")
+}
+
+func convertPre(n *html.Node) string {
+	lang := ""
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == atom.Code {
+			for _, class := range strings.Fields(attr(c, "class")) {
+				if l, ok := strings.CutPrefix(class, "language-"); ok {
+					lang = l
+				}
+			}
+		}
+	}
+	code := strings.TrimSuffix(strings.TrimPrefix(textContent(n), "\n"), "\n")
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	return fence + lang + "\n" + code + "\n" + fence
+}
+
+func convertTable(n *html.Node) string {
+	var rows [][]string
+	var walk func(*html.Node)
+	walk = func(node *html.Node) {
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			switch c.DataAtom {
+			case atom.Tr:
+				var cells []string
+				for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+					if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+						text := strings.TrimSpace(whitespaceRe.ReplaceAllString(strings.ReplaceAll(inlineChildren(cell, false), hardBreak, " "), " "))
+						cells = append(cells, strings.ReplaceAll(text, "|", "\\|"))
+					}
+				}
+				rows = append(rows, cells)
+			case atom.Thead, atom.Tbody, atom.Tfoot:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	if len(rows) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+	if width == 0 {
+		return ""
+	}
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, "|"+strings.Repeat(" --- |", width))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// 各行の先頭に文字列を付与（空行にはemptyを付与）
+func prefixLines(s, prefix, empty string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = empty
+		} else {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var sb strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == atom.Br {
+			sb.WriteString("\n")
+			continue
+		}
+		sb.WriteString(textContent(c))
+	}
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+// Markdownの記号として解釈される文字をエスケープ
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `~`, `\~`,
+	`[`, `\[`, `]`, `\]`, `<`, `\<`, `&`, `\&`,
+)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// URL中の空白・括弧は記法と衝突するためパーセントエンコードする
+var urlEscaper = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29", "<", "%3C", ">", "%3E")
+
+func escapeURL(s string) string {
+	return urlEscaper.Replace(s)
+}
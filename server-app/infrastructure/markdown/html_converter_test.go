@@ -0,0 +1,117 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTMLConverter_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		markdown string
+		html     string // 変換したMarkdownを表示用に変換した結果
+	}{
+		{
+			"inline elements",
+			"<p>Hello <strong>world</strong> and <em>you</em> <del>gone</del></p>",
+			"Hello **world** and *you* ~~gone~~\n",
+			"<p>Hello <strong>world</strong> and <em>you</em> <del>gone</del></p>\n",
+		},
+		{
+			"text outside paragraphs is auto formatted",
+			"line1\nline2\n\npara2",
+			"line1\\\nline2\n\npara2\n",
+			"<p>line1<br>\nline2</p>\n<p>para2</p>\n",
+		},
+		{
+			"line break",
+			"<p>a<br>b</p>",
+			"a\\\nb\n",
+			"<p>a<br>\nb</p>\n",
+		},
+		{
+			"heading and rule",
+			"<h2>Title</h2><p>x</p><hr>",
+			"## Title\n\nx\n\n---\n",
+			"<h2 id=\"title\">Title</h2>\n<p>x</p>\n<hr>\n",
+		},
+		{
+			"unordered list with link",
+			"<ul><li>a</li><li>b <a href=\"https://example.com/a b\">link</a></li></ul>",
+			"- a\n- b [link](https://example.com/a%20b)\n",
+			"<ul>\n<li>a</li>\n<li>b <a href=\"https://example.com/a%20b\" rel=\"nofollow noopener\">link</a></li>\n</ul>\n",
+		},
+		{
+			"ordered list keeps start",
+			"<ol start=\"3\"><li>x</li><li>y</li></ol>",
+			"3. x\n4. y\n",
+			"<ol start=\"3\">\n<li>x</li>\n<li>y</li>\n</ol>\n",
+		},
+		{
+			"code block keeps language",
+			"<pre><code class=\"language-go\">if a < b {\n}</code></pre>",
+			"```go\nif a < b {\n}\n```\n",
+			"<pre><code class=\"language-go\">if a &lt; b {\n}\n</code></pre>\n",
+		},
+		{
+			"code span containing backtick",
+			"<p><code>a`b</code></p>",
+			"``a`b``\n",
+			"<p><code>a`b</code></p>\n",
+		},
+		{
+			"blockquote",
+			"<blockquote><p>quoted</p></blockquote>",
+			"> quoted\n",
+			"<blockquote>\n<p>quoted</p>\n</blockquote>\n",
+		},
+		{
+			"table with pipe in cell",
+			"<table><tr><th>a</th><th>b</th></tr><tr><td>1</td><td>2|3</td></tr></table>",
+			"| a | b |\n| --- | --- |\n| 1 | 2\\|3 |\n",
+			"<table>\n<thead>\n<tr>\n<th>a</th>\n<th>b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>1</td>\n<td>2|3</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			"image",
+			"<p><img src=\"/i.png\" alt=\"pic\"></p>",
+			"![pic](/i.png)\n",
+			"<p><img src=\"/i.png\" alt=\"pic\" loading=\"lazy\"></p>\n",
+		},
+		{
+			"Markdown syntax in text is escaped",
+			"<p># not heading</p><p>1. not list</p><p>*not em*</p>",
+			"\\# not heading\n\n1\\. not list\n\n\\*not em\\*\n",
+			"<p># not heading</p>\n<p>1. not list</p>\n<p>*not em*</p>\n",
+		},
+		{
+			"script is dropped",
+			"<script>alert(1)</script><p>ok</p>",
+			"ok\n",
+			"<p>ok</p>\n",
+		},
+		{
+			"javascript link is not rendered",
+			"<p><a href=\"javascript:alert(1)\">x</a></p>",
+			"[x](javascript:alert%281%29)\n",
+			"<p>x</p>\n",
+		},
+		{
+			"empty",
+			"<p> </p>",
+			"",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markdown, err := NewHTMLConverter().ToMarkdown(tt.src)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.markdown, markdown)
+			assert.Equal(t, tt.html, render(t, markdown).HTML)
+		})
+	}
+}
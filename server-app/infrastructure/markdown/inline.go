@@ -0,0 +1,701 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type inlineKind int
+
+const (
+	inlineRoot inlineKind = iota
+	inlineText
+	inlineCode
+	inlineSoftBreak
+	inlineHardBreak
+	inlineEmph
+	inlineStrong
+	inlineDel
+	inlineLink
+	inlineImage
+	inlineFootnoteRef
+)
+
+// インライン要素
+// 子要素は連結リストで保持し、強調やリンクの確定時に付け替える
+type inline struct {
+	kind   inlineKind
+	text   string
+	fixed  bool // 強調記号・括弧のテキスト
+	dest   string
+	title  string
+	index  int    // 脚注番号
+	id     string // 脚注参照元のid属性の値
+	parent *inline
+	prev   *inline
+	next   *inline
+	first  *inline
+	last   *inline
+}
+
+// 強調記号の連続
+type delimiter struct {
+	node      *inline
+	char      byte
+	count     int
+	origCount int
+	canOpen   bool
+	canClose  bool
+	prev      *delimiter
+	next      *delimiter
+}
+
+// リンク・画像の開始括弧
+type bracket struct {
+	node      *inline
+	prevDelim *delimiter
+	image     bool
+	active    bool
+	textStart int // リンクテキストの開始位置
+	prev      *bracket
+}
+
+var (
+	entityRe        = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+	footnoteRefRe   = regexp.MustCompile(`^\[\^([^\]\s]+)\]`)
+	uriAutolinkRe   = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^\s<>]*)>`)
+	emailAutolinkRe = regexp.MustCompile("^<([A-Za-z0-9.!#$%&'*+/=?^_`{|}~-]+@[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?(?:\\.[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*)>")
+	bareAutolinkRe  = regexp.MustCompile(`^(?i:https?://|www\.)[A-Za-z0-9_-]+(?:\.[A-Za-z0-9_-]+)*[^\s<]*`)
+	trailingEntRe   = regexp.MustCompile(`&[A-Za-z0-9]+;$`)
+)
+
+// 解析を打ち切る上限
+// 閉じられない括弧が大量に続く入力で処理時間が増大しないようにする
+const (
+	maxLabelLength    = 999
+	maxLinkParenDepth = 32
+)
+
+type inlineParser struct {
+	doc      *document
+	src      string
+	pos      int
+	root     *inline
+	delims   *delimiter
+	brackets *bracket
+}
+
+// インライン文字列を要素に分割
+func (d *document) parseInline(src string) *inline {
+	p := &inlineParser{doc: d, src: src, root: &inline{kind: inlineRoot}}
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; c {
+		case '\\':
+			p.parseBackslash()
+		case '`':
+			p.parseCodeSpan()
+		case '*', '_', '~':
+			p.parseDelimiterRun(c)
+		case '!':
+			if p.pos+1 < len(p.src) && p.src[p.pos+1] == '[' {
+				p.openBracket(true)
+			} else {
+				p.addText("!")
+				p.pos++
+			}
+		case '[':
+			if !p.parseFootnoteRef() {
+				p.openBracket(false)
+			}
+		case ']':
+			p.closeBracket()
+		case '<':
+			p.parseAngleAutolink()
+		case '&':
+			p.parseEntity()
+		case '\n':
+			p.parseNewline()
+		default:
+			if p.parseBareAutolink() {
+				continue
+			}
+			end := p.pos + 1
+			for end < len(p.src) && !isSpecialByte(p.src[end]) {
+				end++
+			}
+			p.addText(p.src[p.pos:end])
+			p.pos = end
+		}
+	}
+	p.processEmphasis(nil)
+	return p.root
+}
+
+func isSpecialByte(c byte) bool {
+	return strings.IndexByte("\\`*_~![]<&\nhwHW", c) >= 0
+}
+
+func (p *inlineParser) addText(s string) {
+	appendChild(p.root, &inline{kind: inlineText, text: s})
+}
+
+func (p *inlineParser) parseBackslash() {
+	if p.pos+1 < len(p.src) {
+		next := p.src[p.pos+1]
+		if next == '\n' {
+			appendChild(p.root, &inline{kind: inlineHardBreak})
+			p.pos += 2
+			p.skipLeadingSpaces()
+			return
+		}
+		if isASCIIPunct(next) {
+			p.addText(string(next))
+			p.pos += 2
+			return
+		}
+	}
+	p.addText("\\")
+	p.pos++
+}
+
+// 同じ長さのバッククォートで囲まれた範囲をコードとする
+func (p *inlineParser) parseCodeSpan() {
+	start := p.pos
+	n := runLength(p.src, start)
+	for i := start + n; i < len(p.src); {
+		if p.src[i] != '`' {
+			i++
+			continue
+		}
+		m := runLength(p.src, i)
+		if m == n {
+			code := strings.ReplaceAll(p.src[start+n:i], "\n", " ")
+			if len(code) >= 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.Trim(code, " ") != "" {
+				code = code[1 : len(code)-1]
+			}
+			appendChild(p.root, &inline{kind: inlineCode, text: code})
+			p.pos = i + m
+			return
+		}
+		i += m
+	}
+	p.addText(p.src[start : start+n])
+	p.pos = start + n
+}
+
+func runLength(s string, start int) int {
+	n := 0
+	for start+n < len(s) && s[start+n] == s[start] {
+		n++
+	}
+	return n
+}
+
+// 強調・取り消し線の記号の連続を前後の文字から開始・終了に使えるか判定して登録
+func (p *inlineParser) parseDelimiterRun(c byte) {
+	start := p.pos
+	n := runLength(p.src, start)
+	p.pos += n
+
+	before, after := ' ', ' '
+	if start > 0 {
+		before, _ = utf8.DecodeLastRuneInString(p.src[:start])
+	}
+	if p.pos < len(p.src) {
+		after, _ = utf8.DecodeRuneInString(p.src[p.pos:])
+	}
+	beforeSpace, afterSpace := unicode.IsSpace(before), unicode.IsSpace(after)
+	beforePunct, afterPunct := isPunct(before), isPunct(after)
+	left := !afterSpace && (!afterPunct || beforeSpace || beforePunct)
+	right := !beforeSpace && (!beforePunct || afterSpace || afterPunct)
+
+	canOpen, canClose := left, right
+	switch {
+	case c == '_':
+		canOpen = left && (!right || beforePunct)
+		canClose = right && (!left || afterPunct)
+	case c == '~' && n > 2:
+		canOpen, canClose = false, false
+	}
+
+	node := &inline{kind: inlineText, text: p.src[start:p.pos], fixed: true}
+	appendChild(p.root, node)
+	if !canOpen && !canClose {
+		return
+	}
+	d := &delimiter{node: node, char: c, count: n, origCount: n, canOpen: canOpen, canClose: canClose, prev: p.delims}
+	if p.delims != nil {
+		p.delims.next = d
+	}
+	p.delims = d
+}
+
+func (p *inlineParser) parseFootnoteRef() bool {
+	m := footnoteRefRe.FindStringSubmatch(p.src[p.pos:])
+	if m == nil {
+		return false
+	}
+	label := normalizeLabel(m[1])
+	if _, ok := p.doc.footnotes[label]; !ok {
+		return false
+	}
+	index, id := p.doc.footnoteRef(label)
+	appendChild(p.root, &inline{kind: inlineFootnoteRef, index: index, id: id})
+	p.pos += len(m[0])
+	return true
+}
+
+func (p *inlineParser) openBracket(image bool) {
+	text := "["
+	if image {
+		text = "!["
+	}
+	node := &inline{kind: inlineText, text: text, fixed: true}
+	appendChild(p.root, node)
+	p.pos += len(text)
+	p.brackets = &bracket{
+		node:      node,
+		prevDelim: p.delims,
+		image:     image,
+		active:    true,
+		textStart: p.pos,
+		prev:      p.brackets,
+	}
+}
+
+// 閉じ括弧に続くリンク先を解析し、対応する開始括弧からの要素をリンク・画像にまとめる
+func (p *inlineParser) closeBracket() {
+	p.pos++
+	opener := p.brackets
+	if opener == nil {
+		p.addText("]")
+		return
+	}
+	if !opener.active {
+		p.brackets = opener.prev
+		p.addText("]")
+		return
+	}
+
+	dest, title, end, ok := p.parseLinkTail(p.src[opener.textStart : p.pos-1])
+	if !ok {
+		p.brackets = opener.prev
+		p.addText("]")
+		return
+	}
+	p.pos = end
+
+	kind := inlineLink
+	if opener.image {
+		kind = inlineImage
+	}
+	link := &inline{kind: kind, dest: dest, title: title}
+	p.processEmphasis(opener.prevDelim)
+	for n := opener.node.next; n != nil; {
+		next := n.next
+		unlink(n)
+		appendChild(link, n)
+		n = next
+	}
+	appendChild(p.root, link)
+	unlink(opener.node)
+	p.brackets = opener.prev
+
+	// リンクの中にリンクは作らない
+	if !opener.image {
+		for b := p.brackets; b != nil; b = b.prev {
+			if !b.image {
+				b.active = false
+			}
+		}
+	}
+}
+
+// インラインリンク、参照リンク（完全・省略・短縮形式）の順にリンク先を解析
+func (p *inlineParser) parseLinkTail(text string) (dest, title string, end int, ok bool) {
+	if p.pos < len(p.src) && p.src[p.pos] == '(' {
+		if dest, title, end, ok = p.parseInlineLinkTail(); ok {
+			return dest, title, end, true
+		}
+	}
+
+	label := text
+	end = p.pos
+	if p.pos < len(p.src) && p.src[p.pos] == '[' {
+		if close := strings.IndexAny(p.src[p.pos+1:], "[]"); close >= 0 && p.src[p.pos+1+close] == ']' {
+			if inner := p.src[p.pos+1 : p.pos+1+close]; inner != "" {
+				label = inner
+			}
+			end = p.pos + close + 2
+		}
+	}
+	if len(label) > maxLabelLength {
+		return "", "", 0, false
+	}
+	ref, found := p.doc.refs[normalizeLabel(label)]
+	if !found {
+		return "", "", 0, false
+	}
+	return ref.dest, ref.title, end, true
+}
+
+func (p *inlineParser) parseInlineLinkTail() (dest, title string, end int, ok bool) {
+	src := p.src
+	i := skipWhitespace(src, p.pos+1)
+	if i < len(src) && src[i] == '<' {
+		j := i + 1
+		for ; j < len(src) && src[j] != '>'; j++ {
+			if src[j] == '\n' || src[j] == '<' {
+				return "", "", 0, false
+			}
+			if src[j] == '\\' && j+1 < len(src) {
+				j++
+			}
+		}
+		if j >= len(src) {
+			return "", "", 0, false
+		}
+		dest = src[i+1 : j]
+		i = j + 1
+	} else {
+		depth := 0
+		j := i
+		for j < len(src) {
+			c := src[j]
+			if c == '\\' && j+1 < len(src) && isASCIIPunct(src[j+1]) {
+				j += 2
+				continue
+			}
+			if c == '(' {
+				if depth++; depth > maxLinkParenDepth {
+					return "", "", 0, false
+				}
+			} else if c == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			} else if c <= ' ' {
+				break
+			}
+			j++
+		}
+		if depth != 0 {
+			return "", "", 0, false
+		}
+		dest = src[i:j]
+		i = j
+	}
+
+	if k := skipWhitespace(src, i); k > i && k < len(src) && strings.IndexByte(`"'(`, src[k]) >= 0 {
+		closer := src[k]
+		if closer == '(' {
+			closer = ')'
+		}
+		j := k + 1
+		for ; j < len(src) && src[j] != closer; j++ {
+			if src[j] == '\\' && j+1 < len(src) {
+				j++
+			} else if closer == ')' && src[j] == '(' {
+				return "", "", 0, false
+			}
+		}
+		if j >= len(src) {
+			return "", "", 0, false
+		}
+		title = src[k+1 : j]
+		i = j + 1
+	}
+
+	i = skipWhitespace(src, i)
+	if i >= len(src) || src[i] != ')' {
+		return "", "", 0, false
+	}
+	return unescapeText(dest), unescapeText(title), i + 1, true
+}
+
+func skipWhitespace(s string, i int) int {
+	for i < len(s) && (s[i] == ' ' || s[i] == '\n') {
+		i++
+	}
+	return i
+}
+
+func (p *inlineParser) parseAngleAutolink() {
+	rest := p.src[p.pos:]
+	if m := uriAutolinkRe.FindStringSubmatch(rest); m != nil {
+		p.addAutolink(m[1], m[1])
+		p.pos += len(m[0])
+		return
+	}
+	if m := emailAutolinkRe.FindStringSubmatch(rest); m != nil {
+		p.addAutolink("mailto:"+m[1], m[1])
+		p.pos += len(m[0])
+		return
+	}
+	p.addText("<")
+	p.pos++
+}
+
+// 行頭・空白・一部の記号の直後にあるhttp(s)://またはwww.から始まるURLをリンクにする
+// 末尾の句読点と対応しない閉じ括弧はURLに含めない
+func (p *inlineParser) parseBareAutolink() bool {
+	if p.pos > 0 && strings.IndexByte(" \n*_~(", p.src[p.pos-1]) < 0 {
+		return false
+	}
+	url := bareAutolinkRe.FindString(p.src[p.pos:])
+	if url == "" {
+		return false
+	}
+	for {
+		trimmed := strings.TrimRight(url, "?!.,:*_~'\"")
+		if strings.HasSuffix(trimmed, ")") && strings.Count(trimmed, "(") < strings.Count(trimmed, ")") {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if strings.HasSuffix(trimmed, ";") {
+			trimmed = trailingEntRe.ReplaceAllString(trimmed, "")
+		}
+		if trimmed == url {
+			break
+		}
+		url = trimmed
+	}
+	if !bareAutolinkRe.MatchString(url) {
+		return false
+	}
+
+	dest := url
+	if strings.HasPrefix(strings.ToLower(url), "www.") {
+		dest = "http://" + url
+	}
+	p.addAutolink(dest, url)
+	p.pos += len(url)
+	return true
+}
+
+func (p *inlineParser) addAutolink(dest, text string) {
+	link := &inline{kind: inlineLink, dest: dest}
+	appendChild(link, &inline{kind: inlineText, text: text})
+	appendChild(p.root, link)
+}
+
+func (p *inlineParser) parseEntity() {
+	if m := entityRe.FindString(p.src[p.pos:]); m != "" {
+		if decoded := html.UnescapeString(m); decoded != m {
+			p.addText(decoded)
+			p.pos += len(m)
+			return
+		}
+	}
+	p.addText("&")
+	p.pos++
+}
+
+// 行末に2つ以上の空白がある改行は強制改行とする
+func (p *inlineParser) parseNewline() {
+	kind := inlineSoftBreak
+	if last := p.root.last; last != nil && last.kind == inlineText && !last.fixed {
+		if strings.HasSuffix(last.text, "  ") {
+			kind = inlineHardBreak
+		}
+		last.text = strings.TrimRight(last.text, " ")
+	}
+	appendChild(p.root, &inline{kind: kind})
+	p.pos++
+	p.skipLeadingSpaces()
+}
+
+func (p *inlineParser) skipLeadingSpaces() {
+	for p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// 強調記号の組を内側から確定させる
+// bottomより後に登録された記号のみを対象とし、処理後は登録を解除する
+func (p *inlineParser) processEmphasis(bottom *delimiter) {
+	type openerKey struct {
+		char    byte
+		canOpen bool
+		mod     int
+	}
+	openersBottom := make(map[openerKey]*delimiter)
+
+	closer := p.delims
+	for closer != nil && closer.prev != bottom {
+		closer = closer.prev
+	}
+
+	for closer != nil {
+		if !closer.canClose {
+			closer = closer.next
+			continue
+		}
+
+		key := openerKey{closer.char, closer.canOpen, closer.origCount % 3}
+		limit, limited := openersBottom[key]
+		var opener *delimiter
+		for o := closer.prev; o != nil && o != bottom && (!limited || o != limit); o = o.prev {
+			if o.char == closer.char && o.canOpen && delimitersMatch(o, closer) {
+				opener = o
+				break
+			}
+		}
+
+		if opener == nil {
+			openersBottom[key] = closer.prev
+			next := closer.next
+			if !closer.canOpen {
+				p.removeDelimiter(closer)
+			}
+			closer = next
+			continue
+		}
+
+		use := 1
+		if closer.count >= 2 && opener.count >= 2 {
+			use = 2
+		}
+		kind := inlineEmph
+		switch {
+		case closer.char == '~':
+			kind = inlineDel
+			use = closer.count
+		case use == 2:
+			kind = inlineStrong
+		}
+		opener.count -= use
+		closer.count -= use
+		opener.node.text = opener.node.text[:opener.count]
+		closer.node.text = closer.node.text[:closer.count]
+
+		emph := &inline{kind: kind}
+		for n := opener.node.next; n != nil && n != closer.node; {
+			next := n.next
+			unlink(n)
+			appendChild(emph, n)
+			n = next
+		}
+		insertAfter(opener.node, emph)
+
+		for d := closer.prev; d != nil && d != opener; {
+			prev := d.prev
+			p.removeDelimiter(d)
+			d = prev
+		}
+		if opener.count == 0 {
+			unlink(opener.node)
+			p.removeDelimiter(opener)
+		}
+		if closer.count == 0 {
+			next := closer.next
+			unlink(closer.node)
+			p.removeDelimiter(closer)
+			closer = next
+		}
+	}
+
+	for p.delims != nil && p.delims != bottom {
+		p.removeDelimiter(p.delims)
+	}
+}
+
+// 組にできる強調記号かを判定
+// 取り消し線は同じ長さの記号のみ、強調は長さの合計が3の倍数になる組を除く
+func delimitersMatch(opener, closer *delimiter) bool {
+	if closer.char == '~' {
+		return opener.count == closer.count
+	}
+	if opener.canClose || closer.canOpen {
+		if (opener.origCount+closer.origCount)%3 == 0 &&
+			!(opener.origCount%3 == 0 && closer.origCount%3 == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *inlineParser) removeDelimiter(d *delimiter) {
+	if d.prev != nil {
+		d.prev.next = d.next
+	}
+	if d.next != nil {
+		d.next.prev = d.prev
+	} else {
+		p.delims = d.prev
+	}
+}
+
+func appendChild(parent, node *inline) {
+	node.parent = parent
+	node.prev = parent.last
+	node.next = nil
+	if parent.last != nil {
+		parent.last.next = node
+	} else {
+		parent.first = node
+	}
+	parent.last = node
+}
+
+func insertAfter(ref, node *inline) {
+	node.parent = ref.parent
+	node.prev = ref
+	node.next = ref.next
+	if ref.next != nil {
+		ref.next.prev = node
+	} else {
+		ref.parent.last = node
+	}
+	ref.next = node
+}
+
+func unlink(node *inline) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		node.parent.first = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		node.parent.last = node.prev
+	}
+	node.parent, node.prev, node.next = nil, nil, nil
+}
+
+// バックスラッシュエスケープと文字参照を展開
+func unescapeText(s string) string {
+	if !strings.ContainsAny(s, `\&`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && isASCIIPunct(s[i+1]):
+			sb.WriteByte(s[i+1])
+			i++
+		case s[i] == '&':
+			if m := entityRe.FindString(s[i:]); m != "" {
+				sb.WriteString(html.UnescapeString(m))
+				i += len(m) - 1
+			} else {
+				sb.WriteByte('&')
+			}
+		default:
+			sb.WriteByte(s[i])
+		}
+	}
+	return sb.String()
+}
+
+func isASCIIPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isPunct(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
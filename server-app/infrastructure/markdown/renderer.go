@@ -0,0 +1,70 @@
+package markdown
+
+import (
+	"strings"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// 変換方式の識別子
+// 出力するHTMLが変わる修正を行った場合は更新し、キャッシュを無効にする
+const rendererName = "markdown-v1"
+
+// Markdownを本文HTMLに変換する
+// GFMの表・取り消し線・タスクリスト・URLの自動リンク、フェンスコードブロック、脚注に対応する
+// 生のHTMLは全てエスケープし、出力するタグ・属性は変換処理で生成したもののみとすることでサニタイズする
+type renderer struct{}
+
+func NewRenderer() domainBlog.ContentRenderer {
+	return &renderer{}
+}
+
+func (r *renderer) Name() string {
+	return rendererName
+}
+
+// Markdownをサニタイズ済みのHTMLと目次に変換
+func (r *renderer) Render(content string) (*domainBlog.RenderedContent, error) {
+	doc := newDocument()
+	lines := doc.extractDefinitions(splitLines(content))
+
+	var sb strings.Builder
+	doc.renderBlocks(&sb, parseBlocks(lines), false)
+	doc.renderFootnotes(&sb)
+
+	return &domainBlog.RenderedContent{
+		HTML: sb.String(),
+		TOC:  doc.toc,
+	}, nil
+}
+
+// 改行コードを統一し、タブを4桁単位の空白に展開して行に分割
+func splitLines(s string) []string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	s = strings.ReplaceAll(s, "\x00", "�")
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.Contains(line, "\t") {
+			lines[i] = expandTabs(line)
+		}
+	}
+	return lines
+}
+
+func expandTabs(line string) string {
+	var sb strings.Builder
+	col := 0
+	for _, r := range line {
+		if r == '\t' {
+			n := 4 - col%4
+			sb.WriteString(strings.Repeat(" ", n))
+			col += n
+			continue
+		}
+		sb.WriteRune(r)
+		col++
+	}
+	return sb.String()
+}
@@ -0,0 +1,146 @@
+package markdown
+
+import (
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+func render(t *testing.T, content string) *domainBlog.RenderedContent {
+	t.Helper()
+	rendered, err := NewRenderer().Render(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rendered
+}
+
+func TestRenderer_EscapesRawHTML(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"script tag", "<script>alert(1)</script>", "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n"},
+		{"inline tag with event handler", "a <img src=x onerror=alert(1)> b", "<p>a &lt;img src=x onerror=alert(1)&gt; b</p>\n"},
+		{"tag in link text", "[<b>](/p)", "<p><a href=\"/p\">&lt;b&gt;</a></p>\n"},
+		{"tag in code block", "```go\nfmt.Println(\"<x>\")\n```", "<pre><code class=\"language-go\">fmt.Println(&#34;&lt;x&gt;&#34;)\n</code></pre>\n"},
+		{"quote in fence info", "```js\" onload=\"x\n<b>\n```", "<pre><code>&lt;b&gt;\n</code></pre>\n"},
+		{"quote in link title", `[x](/path "a\" onmouseover=\"x")`, "<p><a href=\"/path\" title=\"a&#34; onmouseover=&#34;x\">x</a></p>\n"},
+		{"quote in link destination", `[x](https://example.com/"onclick=x)`, "<p><a href=\"https://example.com/%22onclick=x\" rel=\"nofollow noopener\">x</a></p>\n"},
+		{"quote in image alt", `![a" onerror="x](/i.png)`, "<p><img src=\"/i.png\" alt=\"a&#34; onerror=&#34;x\" loading=\"lazy\"></p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(t, tt.content).HTML)
+		})
+	}
+}
+
+func TestRenderer_RejectsUnsafeURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"javascript", "[x](javascript:alert(1))", "<p>x</p>\n"},
+		{"javascript in mixed case", "[x](JaVaScRiPt:alert(1))", "<p>x</p>\n"},
+		{"javascript in angle brackets", "[x](<javascript:alert(1)>)", "<p>x</p>\n"},
+		{"javascript with entity", "[x](&#106;avascript:alert(1))", "<p>x</p>\n"},
+		{"javascript in reference", "[x][r]\n\n[r]: javascript:alert(1)", "<p>x</p>\n"},
+		{"javascript autolink", "<javascript:alert(1)>", "<p>javascript:alert(1)</p>\n"},
+		{"vbscript", "[x](vbscript:msgbox)", "<p>x</p>\n"},
+		{"data link", "[x](data:text/html;base64,PHNjcmlwdD4=)", "<p>x</p>\n"},
+		{"data image", "![x](data:image/png;base64,AAAA)", "<p>x</p>\n"},
+		{"https", "[x](https://example.com/)", "<p><a href=\"https://example.com/\" rel=\"nofollow noopener\">x</a></p>\n"},
+		{"mailto", "[x](mailto:a@example.com)", "<p><a href=\"mailto:a@example.com\">x</a></p>\n"},
+		{"relative", "[x](/blogs/1)", "<p><a href=\"/blogs/1\">x</a></p>\n"},
+		{"protocol relative", "[x](//example.com/)", "<p><a href=\"//example.com/\" rel=\"nofollow noopener\">x</a></p>\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(t, tt.content).HTML)
+		})
+	}
+}
+
+func TestRenderer_GFM(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			"table with alignment",
+			"| a | b |\n|:--|--:|\n| 1 | **2** |",
+			"<table>\n<thead>\n<tr>\n<th style=\"text-align:left\">a</th>\n<th style=\"text-align:right\">b</th>\n</tr>\n</thead>\n" +
+				"<tbody>\n<tr>\n<td style=\"text-align:left\">1</td>\n<td style=\"text-align:right\"><strong>2</strong></td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{
+			"table without body",
+			"| a |\n|---|",
+			"<table>\n<thead>\n<tr>\n<th>a</th>\n</tr>\n</thead>\n</table>\n",
+		},
+		{
+			"footnote referenced twice",
+			"text[^1] and[^1]\n\n[^1]: note",
+			"<p>text<sup class=\"footnote-ref\"><a href=\"#fn-1\" id=\"fnref-1\">1</a></sup>" +
+				" and<sup class=\"footnote-ref\"><a href=\"#fn-1\" id=\"fnref-1-2\">1</a></sup></p>\n" +
+				"<section class=\"footnotes\">\n<ol>\n<li id=\"fn-1\">\n" +
+				"<p>note <a href=\"#fnref-1\" class=\"footnote-backref\">↩</a> <a href=\"#fnref-1-2\" class=\"footnote-backref\">↩</a></p>\n" +
+				"</li>\n</ol>\n</section>\n",
+		},
+		{
+			"undefined footnote",
+			"[^missing] text",
+			"<p>[^missing] text</p>\n",
+		},
+		{
+			"task list",
+			"- [ ] todo\n- [x] done",
+			"<ul>\n<li class=\"task-list-item\"><input type=\"checkbox\" disabled> todo</li>\n" +
+				"<li class=\"task-list-item\"><input type=\"checkbox\" disabled checked> done</li>\n</ul>\n",
+		},
+		{
+			"strikethrough",
+			"~~del~~",
+			"<p><del>del</del></p>\n",
+		},
+		{
+			"bare autolink excludes trailing punctuation",
+			"https://example.com/a.",
+			"<p><a href=\"https://example.com/a\" rel=\"nofollow noopener\">https://example.com/a</a>.</p>\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, render(t, tt.content).HTML)
+		})
+	}
+}
+
+func TestRenderer_TOC(t *testing.T) {
+	t.Run("anchors are unique", func(t *testing.T) {
+		rendered := render(t, "# Intro\n## Intro\n# Intro\n# Intro-1")
+		assert.Equal(t, []domainBlog.TOCEntry{
+			{Level: 1, Text: "Intro", Anchor: "intro"},
+			{Level: 2, Text: "Intro", Anchor: "intro-1"},
+			{Level: 1, Text: "Intro", Anchor: "intro-2"},
+			{Level: 1, Text: "Intro-1", Anchor: "intro-1-1"},
+		}, rendered.TOC)
+		assert.Contains(t, rendered.HTML, `<h1 id="intro-1-1">Intro-1</h1>`)
+	})
+
+	t.Run("symbols are removed and Japanese is kept", func(t *testing.T) {
+		rendered := render(t, "# 日本語 見出し!")
+		assert.Equal(t, []domainBlog.TOCEntry{{Level: 1, Text: "日本語 見出し!", Anchor: "日本語-見出し"}}, rendered.TOC)
+	})
+
+	t.Run("headings in footnotes are excluded", func(t *testing.T) {
+		rendered := render(t, "# A\n\nx[^n]\n\n[^n]: note\n\n    # A")
+		assert.Equal(t, []domainBlog.TOCEntry{{Level: 1, Text: "A", Anchor: "a"}}, rendered.TOC)
+		// 脚注内の見出しも本文の見出しと重複しないidとする
+		assert.Contains(t, rendered.HTML, `<h1 id="a-1">A</h1>`)
+	})
+}
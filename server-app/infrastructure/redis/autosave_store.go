@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// 自動保存のキー
+// autosave:<ユーザーID>:<ブログID>  編集中の内容（JSON、新規作成中のブログIDは0）
+const autosavePrefix = "autosave:"
+
+type autosaveStore struct {
+	conn *redis.Client
+}
+
+func NewAutosaveStore(conn *redis.Client) domainBlog.AutosaveStore {
+	return &autosaveStore{conn: conn}
+}
+
+// 編集中の内容を保存
+// 同じユーザー・ブログの以前の内容は上書きし、保持期間を延長する
+func (s *autosaveStore) Save(autosave *domainBlog.Autosave, ttl time.Duration) error {
+	if s.conn == nil {
+		return domainBlog.ErrAutosaveUnavailable
+	}
+	value, err := json.Marshal(autosave)
+	if err != nil {
+		return fmt.Errorf("failed to encode autosave (user_id=%d, blog_id=%d): %w", autosave.UserID, autosave.BlogID, err)
+	}
+	if err := s.conn.Set(context.Background(), autosaveKey(autosave.UserID, autosave.BlogID), value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to save autosave (user_id=%d, blog_id=%d): %w", autosave.UserID, autosave.BlogID, err)
+	}
+	return nil
+}
+
+// 編集中の内容を取得
+// 保持期間を過ぎて削除された場合はErrAutosaveNotFoundを返す
+func (s *autosaveStore) Find(userID, blogID uint) (*domainBlog.Autosave, error) {
+	if s.conn == nil {
+		return nil, domainBlog.ErrAutosaveUnavailable
+	}
+	value, err := s.conn.Get(context.Background(), autosaveKey(userID, blogID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, domainBlog.ErrAutosaveNotFound
+		}
+		return nil, fmt.Errorf("failed to find autosave (user_id=%d, blog_id=%d): %w", userID, blogID, err)
+	}
+	autosave := domainBlog.Autosave{}
+	if err := json.Unmarshal(value, &autosave); err != nil {
+		return nil, fmt.Errorf("failed to decode autosave (user_id=%d, blog_id=%d): %w", userID, blogID, err)
+	}
+	return &autosave, nil
+}
+
+// 編集中の内容を削除
+// 存在しない場合も成功とする
+func (s *autosaveStore) Delete(userID, blogID uint) error {
+	if s.conn == nil {
+		return domainBlog.ErrAutosaveUnavailable
+	}
+	if err := s.conn.Del(context.Background(), autosaveKey(userID, blogID)).Err(); err != nil {
+		return fmt.Errorf("failed to delete autosave (user_id=%d, blog_id=%d): %w", userID, blogID, err)
+	}
+	return nil
+}
+
+func autosaveKey(userID, blogID uint) string {
+	return fmt.Sprintf("%s%d:%d", autosavePrefix, userID, blogID)
+}
@@ -175,3 +175,9 @@ func (s *RedisSessionStore) UpdateSession(c *gin.Context, newID string) error {
 	http.SetCookie(c.Writer, cookie)
 	return nil
 }
+
+// セッション以外の用途で共有するRedisクライアントを取得
+// 接続設定の読み込みに失敗した場合はnil
+func (s *RedisSessionStore) Client() *redis.Client {
+	return s.conn
+}
@@ -0,0 +1,139 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+)
+
+// 集計中の閲覧数のキー
+// views:count:<日付>:<ブログID>  閲覧数（INCR）
+// views:uv:<日付>:<ブログID>     ユニーク訪問者（HyperLogLog）
+// views:pending                  DBへの書き込み待ちの<日付>:<ブログID>の集合
+const (
+	viewCountPrefix   = "views:count:"
+	viewVisitorPrefix = "views:uv:"
+	viewPendingKey    = "views:pending"
+)
+
+// 集計中のキーの保持期間
+// 日付が変わった後もDBへの書き込みが完了するまで保持する
+const viewKeyTTL = 72 * time.Hour
+
+type viewCounter struct {
+	conn *redis.Client
+}
+
+func NewViewCounter(conn *redis.Client) domainStats.ViewCounter {
+	return &viewCounter{conn: conn}
+}
+
+// 閲覧を記録
+func (v *viewCounter) Record(key domainStats.ViewKey, visitorID string) error {
+	if v.conn == nil {
+		return domainStats.ErrViewCounterUnavailable
+	}
+	ctx := context.Background()
+	member := key.String()
+	_, err := v.conn.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, viewCountPrefix+member)
+		pipe.Expire(ctx, viewCountPrefix+member, viewKeyTTL)
+		pipe.PFAdd(ctx, viewVisitorPrefix+member, visitorID)
+		pipe.Expire(ctx, viewVisitorPrefix+member, viewKeyTTL)
+		pipe.SAdd(ctx, viewPendingKey, member)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record view (blog_id=%d): %w", key.BlogID, err)
+	}
+	return nil
+}
+
+// DBへの書き込み待ちの閲覧数の識別子を取り出す
+// 取り出した後に閲覧された場合は再び書き込み待ちになる
+func (v *viewCounter) PopPending(limit int) ([]domainStats.ViewKey, error) {
+	if v.conn == nil {
+		return nil, domainStats.ErrViewCounterUnavailable
+	}
+	members, err := v.conn.SPopN(context.Background(), viewPendingKey, int64(limit)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to pop pending views: %w", err)
+	}
+
+	keys := make([]domainStats.ViewKey, 0, len(members))
+	for _, m := range members {
+		// 不正な値は書き込めないため破棄する
+		key, err := domainStats.ParseViewKey(m)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// 集計中の閲覧数・ユニーク訪問者数を取得
+// 保持期間を過ぎて削除された値は0件となる
+func (v *viewCounter) Counts(keys []domainStats.ViewKey) ([]domainStats.DailyViews, error) {
+	if v.conn == nil {
+		return nil, domainStats.ErrViewCounterUnavailable
+	}
+	ctx := context.Background()
+	views := make([]*redis.StringCmd, len(keys))
+	visitors := make([]*redis.IntCmd, len(keys))
+	_, err := v.conn.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			views[i] = pipe.Get(ctx, viewCountPrefix+key.String())
+			visitors[i] = pipe.PFCount(ctx, viewVisitorPrefix+key.String())
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("failed to get view counts: %w", err)
+	}
+
+	rows := make([]domainStats.DailyViews, 0, len(keys))
+	for i, key := range keys {
+		day, err := key.Date()
+		if err != nil {
+			return nil, err
+		}
+		count, err := views[i].Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("failed to get view count (blog_id=%d): %w", key.BlogID, err)
+		}
+		unique, err := visitors[i].Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count unique visitors (blog_id=%d): %w", key.BlogID, err)
+		}
+		rows = append(rows, domainStats.DailyViews{
+			BlogID:         key.BlogID,
+			Day:            day,
+			Views:          count,
+			UniqueVisitors: unique,
+		})
+	}
+	return rows, nil
+}
+
+// DBへの書き込みに失敗した識別子を書き込み待ちに戻す
+func (v *viewCounter) Requeue(keys []domainStats.ViewKey) error {
+	if v.conn == nil {
+		return domainStats.ErrViewCounterUnavailable
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	members := make([]interface{}, len(keys))
+	for i, key := range keys {
+		members[i] = key.String()
+	}
+	if err := v.conn.SAdd(context.Background(), viewPendingKey, members...).Err(); err != nil {
+		return fmt.Errorf("failed to requeue pending views: %w", err)
+	}
+	return nil
+}
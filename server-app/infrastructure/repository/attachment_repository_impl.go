@@ -0,0 +1,250 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type attachmentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewAttachmentRepository(manager *db.DBManager) domainAttachment.AttachmentRepository {
+	return &attachmentRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 添付ファイルを登録
+func (r *attachmentRepository) Create(attachment *domainAttachment.Attachment) error {
+	if err := r.db.Table("ATTACHMENTS").Create(attachment).Error; err != nil {
+		return fmt.Errorf("failed to create attachment (user_id=%d): %w", attachment.OwnerID, err)
+	}
+	return nil
+}
+
+// 添付ファイルを取得
+func (r *attachmentRepository) FindAttachmentByID(id uint) (*domainAttachment.Attachment, error) {
+	attachment := domainAttachment.Attachment{}
+	if err := r.db.Table("ATTACHMENTS").Where("id = ?", id).First(&attachment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainAttachment.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to find attachment (id=%d): %w", id, err)
+	}
+	return &attachment, nil
+}
+
+// 保存キーの実体を参照する添付ファイルを登録順に取得
+// 同一内容のファイルは複数の添付ファイルで共有され、派生画像の保存キーの場合は派生元の添付ファイルを返す
+func (r *attachmentRepository) FindAttachmentsByKey(key string) ([]domainAttachment.Attachment, error) {
+	variantOwners := r.db.Table("ATTACHMENT_VARIANTS").Select("attachment_id").Where("storage_key = ?", key)
+
+	var attachments []domainAttachment.Attachment
+	if err := r.db.Table("ATTACHMENTS").
+		Where("storage_key = ? OR id IN (?)", key, variantOwners).
+		Order("id").
+		Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachments (key=%s): %w", key, err)
+	}
+	return attachments, nil
+}
+
+// ユーザーの添付ファイルを新しい順に取得
+func (r *attachmentRepository) FindAttachmentsByOwnerID(ownerID uint) ([]domainAttachment.Attachment, error) {
+	var attachments []domainAttachment.Attachment
+	if err := r.db.Table("ATTACHMENTS").
+		Where("user_id = ?", ownerID).
+		Order("created_at DESC, id DESC").
+		Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachments (user_id=%d): %w", ownerID, err)
+	}
+	return attachments, nil
+}
+
+// ブログに紐づく添付ファイルを新しい順に取得
+func (r *attachmentRepository) FindAttachmentsByBlogID(blogID uint) ([]domainAttachment.Attachment, error) {
+	var attachments []domainAttachment.Attachment
+	if err := r.db.Table("ATTACHMENTS").
+		Where("blog_id = ?", blogID).
+		Order("created_at DESC, id DESC").
+		Find(&attachments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachments (blog_id=%d): %w", blogID, err)
+	}
+	return attachments, nil
+}
+
+// 添付ファイルと派生画像を削除
+func (r *attachmentRepository) Delete(id uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Table("ATTACHMENTS").Where("id = ?", id).Delete(&domainAttachment.Attachment{})
+	if err = result.Error; err != nil {
+		return fmt.Errorf("failed to delete attachment (id=%d): %w", id, err)
+	}
+	if result.RowsAffected == 0 {
+		return domainAttachment.ErrAttachmentNotFound
+	}
+	if err = tx.Table("ATTACHMENT_VARIANTS").Where("attachment_id = ?", id).Delete(&domainAttachment.Variant{}).Error; err != nil {
+		return fmt.Errorf("failed to delete variants of attachment (id=%d): %w", id, err)
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// どの添付ファイル・派生画像からも参照されていない実体を削除
+// 参照の確認から実体の削除までは保存キーの範囲をロックし、同じ内容の登録を待たせる
+func (r *attachmentRepository) DeleteBlobIfUnreferenced(key string, deleteBlob func(key string) error) (deleted bool, err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var attachments, variants int64
+	if err = tx.Table("ATTACHMENTS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("storage_key = ?", key).Count(&attachments).Error; err != nil {
+		return false, fmt.Errorf("failed to count attachments (key=%s): %w", key, err)
+	}
+	if err = tx.Table("ATTACHMENT_VARIANTS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("storage_key = ?", key).Count(&variants).Error; err != nil {
+		return false, fmt.Errorf("failed to count attachment variants (key=%s): %w", key, err)
+	}
+	if attachments+variants == 0 {
+		if err = deleteBlob(key); err != nil {
+			return false, err
+		}
+		deleted = true
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return deleted, nil
+}
+
+// 保存キーから派生画像を取得
+func (r *attachmentRepository) FindVariantByKey(key string) (*domainAttachment.Variant, error) {
+	variant := domainAttachment.Variant{}
+	if err := r.db.Table("ATTACHMENT_VARIANTS").Where("storage_key = ?", key).Order("id").First(&variant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainAttachment.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to find attachment variant (key=%s): %w", key, err)
+	}
+	return &variant, nil
+}
+
+// 添付ファイルの派生画像を取得
+func (r *attachmentRepository) FindVariantsByAttachmentIDs(ids []uint) ([]domainAttachment.Variant, error) {
+	var variants []domainAttachment.Variant
+	if len(ids) == 0 {
+		return variants, nil
+	}
+	if err := r.db.Table("ATTACHMENT_VARIANTS").
+		Where("attachment_id IN ?", ids).
+		Order("attachment_id, id").
+		Find(&variants).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachment variants: %w", err)
+	}
+	return variants, nil
+}
+
+// 派生画像の生成状況が一致する添付ファイルのIDを古い順に取得
+func (r *attachmentRepository) FindAttachmentIDsByProcessing(status string, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Table("ATTACHMENTS").
+		Where("processing_status = ?", status).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attachments (processing_status=%s): %w", status, err)
+	}
+	return ids, nil
+}
+
+// 派生画像の生成結果を保存
+// 既存の派生画像は置き換える
+func (r *attachmentRepository) SaveProcessingResult(id uint, status string, width, height int, variants []domainAttachment.Variant) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Table("ATTACHMENTS").Where("id = ?", id).Updates(map[string]interface{}{
+		"processing_status": status,
+		"width":             width,
+		"height":            height,
+	})
+	if err = result.Error; err != nil {
+		return fmt.Errorf("failed to update processing status of attachment (id=%d): %w", id, err)
+	}
+	if result.RowsAffected == 0 {
+		// 生成中に削除された
+		var count int64
+		if err = tx.Table("ATTACHMENTS").Where("id = ?", id).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to find attachment (id=%d): %w", id, err)
+		}
+		if count == 0 {
+			return domainAttachment.ErrAttachmentNotFound
+		}
+	}
+
+	if err = tx.Table("ATTACHMENT_VARIANTS").Where("attachment_id = ?", id).Delete(&domainAttachment.Variant{}).Error; err != nil {
+		return fmt.Errorf("failed to delete variants of attachment (id=%d): %w", id, err)
+	}
+	for i := range variants {
+		variants[i].ID = 0
+		variants[i].AttachmentID = id
+	}
+	if len(variants) > 0 {
+		if err = tx.Table("ATTACHMENT_VARIANTS").Create(&variants).Error; err != nil {
+			return fmt.Errorf("failed to create variants of attachment (id=%d): %w", id, err)
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 本文の変換結果をBLOG_RENDEREDテーブルにブログごとに1行保持するキャッシュ
+// バージョンと変換方式が一致する場合のみキャッシュを使用する
+type blogRenderCache struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewBlogRenderCache(manager *db.DBManager) domainBlog.RenderCache {
+	return &blogRenderCache{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// キャッシュの行
+type blogRenderedRow struct {
+	BlogID    uint      `gorm:"column:blog_id"`
+	Version   uint      `gorm:"column:version"`
+	Renderer  string    `gorm:"column:renderer"`
+	HTML      string    `gorm:"column:html"`
+	TOC       string    `gorm:"column:toc"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// 変換結果を取得
+func (r *blogRenderCache) Find(blogID, version uint, renderer string) (*domainBlog.RenderedContent, error) {
+	var row blogRenderedRow
+	err := r.db.Table("BLOG_RENDERED").
+		Where("blog_id = ? AND version = ? AND renderer = ?", blogID, version, renderer).
+		Take(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrRenderCacheMiss
+		}
+		return nil, fmt.Errorf("failed to find rendered content (blog_id=%d): %w", blogID, err)
+	}
+
+	rendered := &domainBlog.RenderedContent{HTML: row.HTML}
+	if err := json.Unmarshal([]byte(row.TOC), &rendered.TOC); err != nil {
+		return nil, fmt.Errorf("failed to decode table of contents (blog_id=%d): %w", blogID, err)
+	}
+	return rendered, nil
+}
+
+// 変換結果を保存
+// 保存済みの結果より古いバージョンでは上書きしない
+func (r *blogRenderCache) Save(blogID, version uint, renderer string, rendered *domainBlog.RenderedContent) error {
+	toc := rendered.TOC
+	if toc == nil {
+		toc = []domainBlog.TOCEntry{}
+	}
+	encoded, err := json.Marshal(toc)
+	if err != nil {
+		return fmt.Errorf("failed to encode table of contents (blog_id=%d): %w", blogID, err)
+	}
+
+	// versionは他の列の比較に使うため最後に更新する
+	if err := r.db.Exec(
+		"INSERT INTO BLOG_RENDERED (blog_id, version, renderer, html, toc, updated_at) VALUES (?, ?, ?, ?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE "+
+			"renderer = IF(VALUES(version) >= version, VALUES(renderer), renderer), "+
+			"html = IF(VALUES(version) >= version, VALUES(html), html), "+
+			"toc = IF(VALUES(version) >= version, VALUES(toc), toc), "+
+			"updated_at = IF(VALUES(version) >= version, VALUES(updated_at), updated_at), "+
+			"version = GREATEST(VALUES(version), version)",
+		blogID, version, renderer, rendered.HTML, string(encoded), time.Now(),
+	).Error; err != nil {
+		return fmt.Errorf("failed to save rendered content (blog_id=%d): %w", blogID, err)
+	}
+	return nil
+}
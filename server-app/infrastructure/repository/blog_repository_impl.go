@@ -3,11 +3,15 @@ package repository
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
 	"github.com/kazukimurahashi12/webapp/infrastructure/db"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type blogRepository struct {
@@ -28,9 +32,13 @@ func (r *blogRepository) Create(blog *domainBlog.Blog) error {
 }
 
 // ブログを取得
+// ゴミ箱内のブログは存在しないものとして扱う
 func (r *blogRepository) FindBlogByID(id uint) (*domainBlog.Blog, error) {
 	blog := domainBlog.Blog{}
-	if err := r.db.Table("BLOGS").Where("id = ?", id).First(&blog).Error; err != nil {
+	if err := r.blogs().Where("id = ?", id).First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrBlogNotFound
+		}
 		return nil, fmt.Errorf("failed to find blog (id=%d): %w", id, err)
 	}
 	return &blog, nil
@@ -39,23 +47,141 @@ func (r *blogRepository) FindBlogByID(id uint) (*domainBlog.Blog, error) {
 // 著者IDに紐づくブログを取得
 func (r *blogRepository) FindBlogsByAuthorID(authorID uint) ([]domainBlog.Blog, error) {
 	var blogs []domainBlog.Blog
-	if err := r.db.Table("BLOGS").Where("author_id = ?", authorID).Find(&blogs).Error; err != nil {
+	if err := r.blogs().Where("user_id = ?", authorID).Find(&blogs).Error; err != nil {
 		return nil, fmt.Errorf("failed to find blogs by author_id (author_id=%d): %w", authorID, err)
 	}
 	return blogs, nil
 }
 
+// 取得条件に従いブログ一覧をカーソルページングで取得
+// 並び替えキーとIDの組でキーセットページングを行う
+func (r *blogRepository) FindBlogPage(query domainBlog.ListQuery) (*domainBlog.Page, error) {
+	tx := r.blogs()
+	if query.AuthorID != 0 {
+		tx = tx.Where("user_id = ?", query.AuthorID)
+	} else {
+		tx = tx.Where("(status = ? AND visibility = ?) OR user_id = ?",
+			domainBlog.StatusPublished, domainBlog.VisibilityPublic, query.ViewerID)
+	}
+
+	// カテゴリに紐づくブログIDのサブクエリ
+	if len(query.CategoryIDs) > 0 {
+		blogIDs := r.db.Table("post_categories").Select("blog_id").Where("category_id IN ?", query.CategoryIDs)
+		tx = tx.Where("id IN (?)", blogIDs)
+	}
+	// タグに紐づくブログIDのサブクエリ
+	if query.TagID != 0 {
+		blogIDs := r.db.Table("post_tags").Select("blog_id").Where("tag_id = ?", query.TagID)
+		tx = tx.Where("id IN (?)", blogIDs)
+	}
+	if query.Status != "" {
+		tx = tx.Where("status = ?", query.Status)
+	}
+	if query.TitleContains != "" {
+		tx = tx.Where("title LIKE ?", "%"+escapeLike(query.TitleContains)+"%")
+	}
+	if query.CreatedFrom != nil {
+		tx = tx.Where("created_at >= ?", *query.CreatedFrom)
+	}
+	if query.CreatedTo != nil {
+		tx = tx.Where("created_at < ?", *query.CreatedTo)
+	}
+
+	column := sortColumn(query.SortBy)
+	backward := query.Cursor != nil && query.Cursor.Backward
+
+	// 前ページ取得時は並び順を反転して取得し、後で元に戻す
+	ascending := query.Order == domainBlog.OrderAsc
+	if backward {
+		ascending = !ascending
+	}
+	direction, operator := "DESC", "<"
+	if ascending {
+		direction, operator = "ASC", ">"
+	}
+
+	if query.Cursor != nil {
+		var value interface{} = query.Cursor.Value
+		if query.SortBy != domainBlog.SortByTitle {
+			t, err := query.Cursor.TimeValue()
+			if err != nil {
+				return nil, err
+			}
+			value = t
+		}
+		tx = tx.Where(
+			fmt.Sprintf("(%s %s ?) OR (%s = ? AND id %s ?)", column, operator, column, operator),
+			value, value, query.Cursor.ID,
+		)
+	}
+
+	// 次ページ有無の判定用に1件多く取得
+	var blogs []domainBlog.Blog
+	if err := tx.
+		Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(query.Limit + 1).
+		Find(&blogs).Error; err != nil {
+		return nil, fmt.Errorf("failed to find blog page (author_id=%d): %w", query.AuthorID, err)
+	}
+
+	hasMore := len(blogs) > query.Limit
+	if hasMore {
+		blogs = blogs[:query.Limit]
+	}
+	if backward {
+		for i, j := 0, len(blogs)-1; i < j; i, j = i+1, j-1 {
+			blogs[i], blogs[j] = blogs[j], blogs[i]
+		}
+	}
+
+	// 一覧表示用のリアクション数をまとめて取得
+	if len(blogs) > 0 {
+		ids := make([]uint, len(blogs))
+		for i := range blogs {
+			ids[i] = blogs[i].ID
+		}
+		counts, err := findReactionCounts(r.db, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i := range blogs {
+			blogs[i].Reactions = counts[blogs[i].ID]
+		}
+	}
+
+	page := &domainBlog.Page{Blogs: blogs}
+	if backward {
+		page.HasPrev = hasMore
+		page.HasNext = true
+	} else {
+		page.HasNext = hasMore
+		page.HasPrev = query.Cursor != nil
+	}
+	if len(blogs) > 0 {
+		if page.HasNext {
+			page.NextCursor = domainBlog.NewCursor(&blogs[len(blogs)-1], query.SortBy, query.Order, false).Encode()
+		}
+		if page.HasPrev {
+			page.PrevCursor = domainBlog.NewCursor(&blogs[0], query.SortBy, query.Order, true).Encode()
+		}
+	}
+	return page, nil
+}
+
 // 著者IDに対応するブログを取得
 func (r *blogRepository) FindBlogByAuthorID(authorID uint) (*domainBlog.Blog, error) {
 	blog := domainBlog.Blog{}
-	if err := r.db.Table("BLOGS").Where("author_id = ?", authorID).First(&blog).Error; err != nil {
+	if err := r.blogs().Where("user_id = ?", authorID).First(&blog).Error; err != nil {
 		return nil, fmt.Errorf("failed to find blog by author_id (author_id=%d): %w", authorID, err)
 	}
 	return &blog, nil
 }
 
 // ブログを更新
-func (r *blogRepository) Update(blog *domainBlog.Blog) (err error) {
+// 指定バージョンと一致する場合のみ更新し、バージョンを1つ進める
+// 更新前のタイトル・本文は同一トランザクションで履歴として保存する
+// スラッグを変更する場合は旧スラッグを転送元として残す
+func (r *blogRepository) Update(blog *domainBlog.Blog, editorID uint) (err error) {
 	tx := r.db.Begin()
 	if tx.Error != nil {
 		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
@@ -70,34 +196,531 @@ func (r *blogRepository) Update(blog *domainBlog.Blog) (err error) {
 		}
 	}()
 
+	// 更新前の内容を履歴として保存するため行ロックを取得して読み込む
 	existingBlog := domainBlog.Blog{}
-	if err = tx.Table("BLOGS").Where("id = ?", blog.ID).First(&existingBlog).Error; err != nil {
+	if err = tx.Table("BLOGS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND deleted_at IS NULL", blog.ID).First(&existingBlog).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return domainBlog.ErrBlogNotFound
 		}
 		return fmt.Errorf("failed to find existing blog (id=%d): %w", blog.ID, err)
 	}
+	if existingBlog.Version != blog.Version {
+		return domainBlog.ErrBlogVersionConflict
+	}
+
+	// 更新前の内容を履歴として保存
+	revision := domainBlog.NewRevision(&existingBlog, editorID)
+	if err = tx.Table("BLOG_REVISIONS").Create(revision).Error; err != nil {
+		return fmt.Errorf("failed to create blog revision (id=%d, version=%d): %w", blog.ID, existingBlog.Version, err)
+	}
+
+	// スラッグの変更時は旧スラッグを転送用の履歴として保存する
+	if blog.Slug != existingBlog.Slug {
+		if err = r.saveSlugHistory(tx, &existingBlog, blog.Slug); err != nil {
+			return err
+		}
+	}
 
 	updateData := map[string]interface{}{
-		"title":   blog.Title,
-		"content": blog.Content,
+		"title":      blog.Title,
+		"slug":       blog.Slug,
+		"content":    blog.Content,
+		"visibility": blog.Visibility,
+		"version":    gorm.Expr("version + 1"),
 	}
 
-	if err = tx.Table("BLOGS").Where("id = ?", blog.ID).Updates(updateData).Error; err != nil {
+	// 読み込み後に他の更新が入っていた場合は更新対象が0件になる
+	result := tx.Table("BLOGS").Where("id = ? AND version = ?", blog.ID, blog.Version).Updates(updateData)
+	if err = result.Error; err != nil {
 		return fmt.Errorf("failed to update blog (id=%d): %w", blog.ID, err)
 	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrBlogVersionConflict
+	}
 
 	if err = tx.Commit().Error; err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	blog.Version++
 	return nil
 }
 
-// ブログを削除
+// ブログをゴミ箱へ移動
+// 削除日時を設定するのみで、完全な削除はPurgeで行う
 func (r *blogRepository) Delete(id uint) error {
-	if err := r.db.Table("BLOGS").Where("id = ?", id).Delete(&domainBlog.Blog{}).Error; err != nil {
-		return fmt.Errorf("failed to delete blog (id=%d): %w", id, err)
+	result := r.blogs().Where("id = ?", id).Update("deleted_at", time.Now())
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete blog (id=%d): %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrBlogNotFound
 	}
 	return nil
 }
+
+// 著者IDに紐づくゴミ箱内のブログを削除日時の新しい順に取得
+func (r *blogRepository) FindTrashedBlogsByAuthorID(authorID uint) ([]domainBlog.Blog, error) {
+	var blogs []domainBlog.Blog
+	if err := r.trashedBlogs().
+		Where("user_id = ?", authorID).
+		Order("deleted_at DESC, id DESC").
+		Find(&blogs).Error; err != nil {
+		return nil, fmt.Errorf("failed to find trashed blogs (author_id=%d): %w", authorID, err)
+	}
+	return blogs, nil
+}
+
+// ゴミ箱内のブログを取得
+func (r *blogRepository) FindTrashedBlogByID(id uint) (*domainBlog.Blog, error) {
+	blog := domainBlog.Blog{}
+	if err := r.trashedBlogs().Where("id = ?", id).First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrBlogNotFound
+		}
+		return nil, fmt.Errorf("failed to find trashed blog (id=%d): %w", id, err)
+	}
+	return &blog, nil
+}
+
+// 指定日時より前にゴミ箱へ移動したブログのIDを古い順に取得
+func (r *blogRepository) FindTrashedBlogIDsBefore(before time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.trashedBlogs().
+		Where("deleted_at < ?", before).
+		Order("deleted_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find expired trashed blogs: %w", err)
+	}
+	return ids, nil
+}
+
+// ゴミ箱内のブログを元に戻す
+func (r *blogRepository) Restore(id uint) error {
+	result := r.trashedBlogs().Where("id = ?", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to restore blog (id=%d): %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrBlogNotFound
+	}
+	return nil
+}
+
+// ゴミ箱内のブログを完全に削除
+// 関連するカテゴリ・コメント・検索インデックス・更新履歴も同一トランザクションで削除する
+func (r *blogRepository) Purge(id uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// ゴミ箱に無いブログは削除しない
+	result := tx.Table("BLOGS").Where("id = ? AND deleted_at IS NOT NULL", id).Delete(&domainBlog.Blog{})
+	if err = result.Error; err != nil {
+		return fmt.Errorf("failed to purge blog (id=%d): %w", id, err)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrBlogNotFound
+	}
+
+	related := []struct {
+		table  string
+		column string
+	}{
+		{"post_categories", "blog_id"},
+		{"post_tags", "blog_id"},
+		{"COMMENTS", "post_id"},
+		{"BLOG_SEARCH", "blog_id"},
+		{"BLOG_REVISIONS", "blog_id"},
+		{"BLOG_RENDERED", "blog_id"},
+		{"BLOG_SLUG_HISTORY", "blog_id"},
+		{"IMPORT_SOURCES", "blog_id"},
+		{"REACTIONS", "blog_id"},
+		{"BLOG_REACTION_COUNTS", "blog_id"},
+		{"BLOG_DAILY_VIEWS", "blog_id"},
+		{"SERIES_ENTRIES", "blog_id"},
+		{"BLOG_COLLABORATORS", "blog_id"},
+	}
+	for _, rel := range related {
+		if err = tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", rel.table, rel.column), id).Error; err != nil {
+			return fmt.Errorf("failed to purge %s of blog (id=%d): %w", rel.table, id, err)
+		}
+	}
+
+	// 添付ファイルはユーザーに属するためブログとの紐付けのみ解除する
+	if err = tx.Exec("UPDATE ATTACHMENTS SET blog_id = NULL WHERE blog_id = ?", id).Error; err != nil {
+		return fmt.Errorf("failed to unlink attachments of blog (id=%d): %w", id, err)
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// 公開ステータスを変更
+// 現在のステータスが遷移元に含まれる場合のみ更新する
+// バージョンは本文の更新履歴と対応するため、本文を変更しないステータスの変更では更新しない
+func (r *blogRepository) ChangeStatus(id uint, from []string, change domainBlog.StatusChange) error {
+	updateData := map[string]interface{}{
+		"status":       change.Status,
+		"publish_at":   change.PublishAt,
+		"published_at": change.PublishedAt,
+	}
+
+	result := r.blogs().Where("id = ? AND status IN ?", id, from).Updates(updateData)
+	if result.Error != nil {
+		return fmt.Errorf("failed to change blog status (id=%d, status=%s): %w", id, change.Status, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		// 対象が存在しないのか、遷移できないステータスなのかを判別
+		if _, err := r.FindBlogByID(id); err != nil {
+			return err
+		}
+		return fmt.Errorf("%w: status cannot be changed to %s (id=%d)", domainBlog.ErrBlogPublishFailed, change.Status, id)
+	}
+	return nil
+}
+
+// 公開日時を過ぎた予約投稿を公開
+// 複数のサーバーから同時に実行されても、ステータスを条件にした更新により
+// 各ブログは1度だけ公開される。この呼び出しで公開したブログのみ返す
+// ChangeStatusと同様にバージョンは更新しない
+func (r *blogRepository) PublishDueBlogs(now time.Time, limit int) ([]domainBlog.Blog, error) {
+	var candidates []domainBlog.Blog
+	if err := r.blogs().
+		Where("status = ? AND publish_at <= ?", domainBlog.StatusScheduled, now).
+		Order("publish_at, id").
+		Limit(limit).
+		Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("failed to find scheduled blogs: %w", err)
+	}
+
+	published := make([]domainBlog.Blog, 0, len(candidates))
+	for _, blog := range candidates {
+		result := r.blogs().
+			Where("id = ? AND status = ? AND publish_at <= ?", blog.ID, domainBlog.StatusScheduled, now).
+			Updates(map[string]interface{}{
+				"status":       domainBlog.StatusPublished,
+				"published_at": blog.PublishAt,
+			})
+		if result.Error != nil {
+			return published, fmt.Errorf("failed to publish scheduled blog (id=%d): %w", blog.ID, result.Error)
+		}
+		// 他のサーバーが先に公開した場合は対象外
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		blog.Status = domainBlog.StatusPublished
+		blog.PublishedAt = blog.PublishAt
+		published = append(published, blog)
+	}
+	return published, nil
+}
+
+// 著者名とスラッグからブログを取得
+func (r *blogRepository) FindBlogBySlug(username, slug string) (*domainBlog.Blog, error) {
+	blog := domainBlog.Blog{}
+	if err := r.blogs().
+		Where("user_id IN (?) AND slug = ?", r.authorIDs(username), slug).
+		First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrBlogNotFound
+		}
+		return nil, fmt.Errorf("failed to find blog by slug (username=%s, slug=%s): %w", username, slug, err)
+	}
+	return &blog, nil
+}
+
+// 著者名と変更前のスラッグから現在のブログを取得
+func (r *blogRepository) FindBlogBySlugHistory(username, slug string) (*domainBlog.Blog, error) {
+	blogIDs := r.db.Table("BLOG_SLUG_HISTORY").Select("blog_id").
+		Where("user_id IN (?) AND slug = ?", r.authorIDs(username), slug)
+
+	blog := domainBlog.Blog{}
+	if err := r.blogs().Where("id IN (?)", blogIDs).First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrBlogNotFound
+		}
+		return nil, fmt.Errorf("failed to find blog by slug history (username=%s, slug=%s): %w", username, slug, err)
+	}
+	return &blog, nil
+}
+
+// 著者の他のブログが現在または過去にスラッグを使用していないかを判定
+// ゴミ箱内のブログも復元時に重複しないよう対象とする
+func (r *blogRepository) IsSlugAvailable(authorID uint, slug string, exceptBlogID uint) (bool, error) {
+	var count int64
+	if err := r.db.Table("BLOGS").
+		Where("user_id = ? AND slug = ? AND id <> ?", authorID, slug, exceptBlogID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check slug (author_id=%d, slug=%s): %w", authorID, slug, err)
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	if err := r.db.Table("BLOG_SLUG_HISTORY").
+		Where("user_id = ? AND slug = ? AND blog_id <> ?", authorID, slug, exceptBlogID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check slug history (author_id=%d, slug=%s): %w", authorID, slug, err)
+	}
+	return count == 0, nil
+}
+
+// 旧スラッグを履歴に保存
+// 以前使用していたスラッグに戻す場合はその履歴を削除する
+func (r *blogRepository) saveSlugHistory(tx *gorm.DB, existing *domainBlog.Blog, newSlug string) error {
+	if err := tx.Exec(
+		"DELETE FROM BLOG_SLUG_HISTORY WHERE user_id = ? AND slug = ? AND blog_id = ?",
+		existing.AuthorID, newSlug, existing.ID,
+	).Error; err != nil {
+		return fmt.Errorf("failed to delete slug history (id=%d, slug=%s): %w", existing.ID, newSlug, err)
+	}
+	if existing.Slug == "" {
+		return nil
+	}
+	if err := tx.Exec(
+		"INSERT INTO BLOG_SLUG_HISTORY (user_id, slug, blog_id, created_at) VALUES (?, ?, ?, ?)",
+		existing.AuthorID, existing.Slug, existing.ID, time.Now(),
+	).Error; err != nil {
+		return fmt.Errorf("failed to save slug history (id=%d, slug=%s): %w", existing.ID, existing.Slug, err)
+	}
+	return nil
+}
+
+// フィードに掲載する公開済みのブログを公開日時の新しい順に取得
+// 著者名を表示するため著者のユーザー情報を合わせて取得する
+func (r *blogRepository) FindFeedBlogs(query domainBlog.FeedQuery) ([]domainBlog.Blog, error) {
+	tx := r.blogs().Where("status = ? AND visibility = ?", domainBlog.StatusPublished, domainBlog.VisibilityPublic)
+	if query.AuthorName != "" {
+		var count int64
+		if err := r.authorIDs(query.AuthorName).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to find author (username=%s): %w", query.AuthorName, err)
+		}
+		if count == 0 {
+			return nil, domainUser.ErrUserNotFound
+		}
+		tx = tx.Where("user_id IN (?)", r.authorIDs(query.AuthorName))
+	}
+	if len(query.CategoryIDs) > 0 {
+		blogIDs := r.db.Table("post_categories").Select("blog_id").Where("category_id IN ?", query.CategoryIDs)
+		tx = tx.Where("id IN (?)", blogIDs)
+	}
+
+	var blogs []domainBlog.Blog
+	if err := tx.Order("published_at DESC, id DESC").Limit(query.Limit).Find(&blogs).Error; err != nil {
+		return nil, fmt.Errorf("failed to find feed blogs: %w", err)
+	}
+	if len(blogs) == 0 {
+		return blogs, nil
+	}
+
+	authorIDs := make([]uint, 0, len(blogs))
+	for _, blog := range blogs {
+		authorIDs = append(authorIDs, blog.AuthorID)
+	}
+	var authors []domainUser.User
+	if err := r.db.Table("USERS").Where("id IN ?", authorIDs).Find(&authors).Error; err != nil {
+		return nil, fmt.Errorf("failed to find feed authors: %w", err)
+	}
+	byID := make(map[uint]domainUser.User, len(authors))
+	for _, author := range authors {
+		byID[author.ID] = author
+	}
+	for i := range blogs {
+		blogs[i].Author = byID[blogs[i].AuthorID]
+	}
+	return blogs, nil
+}
+
+// 共有URLのトークンからブログを取得
+func (r *blogRepository) FindBlogByShareToken(token string) (*domainBlog.Blog, error) {
+	blog := domainBlog.Blog{}
+	if err := r.blogs().Where("share_token = ?", token).First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrBlogNotFound
+		}
+		return nil, fmt.Errorf("failed to find blog by share token: %w", err)
+	}
+	return &blog, nil
+}
+
+// 共有URLのトークンを更新
+// 本文の更新ではないためバージョンは進めない
+func (r *blogRepository) UpdateShareToken(id uint, token string) error {
+	result := r.blogs().Where("id = ?", id).Update("share_token", token)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update share token (id=%d): %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrBlogNotFound
+	}
+	return nil
+}
+
+// ブログを一括操作
+// 対象のブログの行ロックを取得し、authorizeで許可されたブログのみ同一トランザクションで変更する
+// 許可されない・操作できないブログは結果に理由を記録して残りの操作を続ける
+// DryRunの場合は結果のみを返し、変更はロールバックする
+func (r *blogRepository) ApplyBulk(op *domainBlog.BulkOperation, authorize func(blog *domainBlog.Blog) error) (report *domainBlog.BulkReport, err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// デッドロックを避けるためID順に行ロックを取得する
+	// 復元のためゴミ箱内のブログも対象とする
+	var locked []domainBlog.Blog
+	if err = tx.Table("BLOGS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", op.BlogIDs).Order("id").Find(&locked).Error; err != nil {
+		return nil, fmt.Errorf("failed to lock blogs for bulk %s: %w", op.Action, err)
+	}
+	blogs := make(map[uint]*domainBlog.Blog, len(locked))
+	for i := range locked {
+		blogs[locked[i].ID] = &locked[i]
+	}
+
+	report = &domainBlog.BulkReport{Action: op.Action, DryRun: op.DryRun}
+	for _, id := range op.BlogIDs {
+		blog, ok := blogs[id]
+		if !ok {
+			report.Add(domainBlog.BulkItemResult{BlogID: id, Result: domainBlog.BulkResultFailed, Reason: domainBlog.BulkReasonNotFound})
+			continue
+		}
+		if err = authorize(blog); err != nil {
+			if !errors.Is(err, domainBlog.ErrBlogUnauthorized) {
+				return nil, err
+			}
+			err = nil
+			report.Add(domainBlog.BulkItemResult{BlogID: id, Result: domainBlog.BulkResultFailed, Reason: domainBlog.BulkReasonUnauthorized})
+			continue
+		}
+
+		var item domainBlog.BulkItemResult
+		if item, err = applyBulkItem(tx, op, blog); err != nil {
+			return nil, err
+		}
+		report.Add(item)
+	}
+
+	if op.DryRun {
+		if err = tx.Rollback().Error; err != nil {
+			return nil, fmt.Errorf("failed to rollback dry run: %w", err)
+		}
+		return report, nil
+	}
+	if err = tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return report, nil
+}
+
+// 一括操作を1件のブログに適用
+func applyBulkItem(tx *gorm.DB, op *domainBlog.BulkOperation, blog *domainBlog.Blog) (domainBlog.BulkItemResult, error) {
+	item := domainBlog.BulkItemResult{BlogID: blog.ID, Result: domainBlog.BulkResultApplied}
+	failed := func(reason string) (domainBlog.BulkItemResult, error) {
+		item.Result, item.Reason = domainBlog.BulkResultFailed, reason
+		return item, nil
+	}
+
+	// 削除・復元以外はゴミ箱内のブログを変更しない
+	switch op.Action {
+	case domainBlog.BulkActionDelete:
+		if blog.IsTrashed() {
+			item.Result = domainBlog.BulkResultUnchanged
+			return item, nil
+		}
+	case domainBlog.BulkActionRestore:
+		if !blog.IsTrashed() {
+			return failed(domainBlog.BulkReasonNotTrashed)
+		}
+	default:
+		if blog.IsTrashed() {
+			return failed(domainBlog.BulkReasonTrashed)
+		}
+	}
+
+	var result *gorm.DB
+	switch op.Action {
+	case domainBlog.BulkActionDelete:
+		result = tx.Table("BLOGS").Where("id = ?", blog.ID).Update("deleted_at", time.Now())
+	case domainBlog.BulkActionRestore:
+		result = tx.Table("BLOGS").Where("id = ?", blog.ID).Update("deleted_at", nil)
+	case domainBlog.BulkActionVisibility:
+		if blog.Visibility == op.Visibility {
+			item.Result = domainBlog.BulkResultUnchanged
+			return item, nil
+		}
+		// 本文の更新ではないためバージョンは進めない
+		result = tx.Table("BLOGS").Where("id = ?", blog.ID).Update("visibility", op.Visibility)
+	case domainBlog.BulkActionAddCategory:
+		result = tx.Exec("INSERT IGNORE INTO post_categories (category_id, blog_id) VALUES (?, ?)", op.CategoryID, blog.ID)
+	case domainBlog.BulkActionRemoveCategory:
+		result = tx.Exec("DELETE FROM post_categories WHERE category_id = ? AND blog_id = ?", op.CategoryID, blog.ID)
+	default:
+		return item, fmt.Errorf("%w: unknown action %q", domainBlog.ErrBulkInvalid, op.Action)
+	}
+	if result.Error != nil {
+		return item, fmt.Errorf("failed to apply bulk %s (id=%d): %w", op.Action, blog.ID, result.Error)
+	}
+	// 既に紐付いている・紐付いていないカテゴリは変更なし
+	if result.RowsAffected == 0 {
+		item.Result = domainBlog.BulkResultUnchanged
+	}
+	return item, nil
+}
+
+// 著者名に対応するユーザーIDのサブクエリ
+func (r *blogRepository) authorIDs(username string) *gorm.DB {
+	return r.db.Table("USERS").Select("id").Where("user_id = ?", username)
+}
+
+// ゴミ箱内のブログを除外したクエリ
+func (r *blogRepository) blogs() *gorm.DB {
+	return r.db.Table("BLOGS").Where("deleted_at IS NULL")
+}
+
+// ゴミ箱内のブログのみを対象とするクエリ
+func (r *blogRepository) trashedBlogs() *gorm.DB {
+	return r.db.Table("BLOGS").Where("deleted_at IS NOT NULL")
+}
+
+// 並び替えキーに対応するカラム名を取得
+func sortColumn(sortBy string) string {
+	switch sortBy {
+	case domainBlog.SortByUpdated:
+		return "updated_at"
+	case domainBlog.SortByTitle:
+		return "title"
+	default:
+		return "created_at"
+	}
+}
+
+// LIKE検索のワイルドカード文字をエスケープ
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
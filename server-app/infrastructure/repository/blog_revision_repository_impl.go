@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type blogRevisionRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewBlogRevisionRepository(manager *db.DBManager) domainBlog.RevisionRepository {
+	return &blogRevisionRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// ブログの更新履歴を新しい順に取得
+func (r *blogRevisionRepository) FindRevisionsByBlogID(blogID uint) ([]domainBlog.Revision, error) {
+	var revisions []domainBlog.Revision
+	if err := r.db.Table("BLOG_REVISIONS").
+		Where("blog_id = ?", blogID).
+		Order("version DESC").
+		Find(&revisions).Error; err != nil {
+		return nil, fmt.Errorf("failed to find blog revisions (blog_id=%d): %w", blogID, err)
+	}
+	return revisions, nil
+}
+
+// 指定バージョンの更新履歴を取得
+func (r *blogRevisionRepository) FindRevision(blogID, version uint) (*domainBlog.Revision, error) {
+	revision := domainBlog.Revision{}
+	if err := r.db.Table("BLOG_REVISIONS").
+		Where("blog_id = ? AND version = ?", blogID, version).
+		First(&revision).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to find blog revision (blog_id=%d, version=%d): %w", blogID, version, err)
+	}
+	return &revision, nil
+}
+
+// 保持期間を過ぎた更新履歴を削除
+// 直近Keep件にもMaxAge以内にも該当しない履歴のみ削除する
+func (r *blogRevisionRepository) Prune(blogID uint, retention domainBlog.RevisionRetention, now time.Time) error {
+	if retention.IsUnlimited() {
+		return nil
+	}
+
+	tx := r.db.Table("BLOG_REVISIONS").Where("blog_id = ?", blogID)
+	if retention.Keep > 0 {
+		// 直近Keep件のうち最も古いバージョンを取得
+		var versions []uint
+		if err := r.db.Table("BLOG_REVISIONS").
+			Where("blog_id = ?", blogID).
+			Order("version DESC").
+			Offset(retention.Keep-1).
+			Limit(1).
+			Pluck("version", &versions).Error; err != nil {
+			return fmt.Errorf("failed to find blog revisions to keep (blog_id=%d): %w", blogID, err)
+		}
+		if len(versions) == 0 {
+			return nil
+		}
+		tx = tx.Where("version < ?", versions[0])
+	}
+	if retention.MaxAge > 0 {
+		tx = tx.Where("created_at < ?", now.Add(-retention.MaxAge))
+	}
+
+	if err := tx.Delete(&domainBlog.Revision{}).Error; err != nil {
+		return fmt.Errorf("failed to prune blog revisions (blog_id=%d): %w", blogID, err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MySQLのFULLTEXTインデックス（ngramパーサ）による全文検索
+// BLOG_SEARCHテーブルに正規化済みのタイトル・本文を保持する
+type blogSearchIndex struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewBlogSearchIndex(manager *db.DBManager) domainBlog.SearchIndex {
+	return &blogSearchIndex{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 検索結果の行
+type blogSearchRow struct {
+	domainBlog.Blog
+	Score float64 `gorm:"column:score"`
+}
+
+// ブログを検索インデックスに登録・更新
+func (r *blogSearchIndex) Index(blog *domainBlog.Blog) error {
+	if err := r.db.Exec(
+		"INSERT INTO BLOG_SEARCH (blog_id, title, content) VALUES (?, ?, ?) "+
+			"ON DUPLICATE KEY UPDATE title = VALUES(title), content = VALUES(content)",
+		blog.ID, domainBlog.NormalizeSearchText(blog.Title), domainBlog.NormalizeSearchText(blog.Content),
+	).Error; err != nil {
+		return fmt.Errorf("failed to index blog (id=%d): %w", blog.ID, err)
+	}
+	return nil
+}
+
+// ブログを検索インデックスから削除
+func (r *blogSearchIndex) Remove(id uint) error {
+	if err := r.db.Exec("DELETE FROM BLOG_SEARCH WHERE blog_id = ?", id).Error; err != nil {
+		return fmt.Errorf("failed to remove blog from search index (id=%d): %w", id, err)
+	}
+	return nil
+}
+
+// 検索語に一致するブログを関連度順に取得
+// タイトルに一致した場合は本文のみの一致より高く評価する
+func (r *blogSearchIndex) Search(query domainBlog.SearchQuery) (*domainBlog.SearchResult, error) {
+	against := booleanModeQuery(query.Terms)
+
+	tx := r.db.Table("BLOG_SEARCH").
+		Joins("JOIN BLOGS ON BLOGS.id = BLOG_SEARCH.blog_id").
+		Where("MATCH(BLOG_SEARCH.title, BLOG_SEARCH.content) AGAINST (? IN BOOLEAN MODE)", against).
+		Where("BLOGS.deleted_at IS NULL").
+		Where("(BLOGS.status = ? AND BLOGS.visibility = ?) OR BLOGS.user_id = ?",
+			domainBlog.StatusPublished, domainBlog.VisibilityPublic, query.ViewerID)
+	if query.AuthorID != 0 {
+		tx = tx.Where("BLOGS.user_id = ?", query.AuthorID)
+	}
+	if query.CreatedFrom != nil {
+		tx = tx.Where("BLOGS.created_at >= ?", *query.CreatedFrom)
+	}
+	if query.CreatedTo != nil {
+		tx = tx.Where("BLOGS.created_at < ?", *query.CreatedTo)
+	}
+	// 件数取得と一覧取得で検索条件を共有する
+	tx = tx.Session(&gorm.Session{})
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count search results (keyword=%s): %w", query.Keyword, err)
+	}
+
+	var rows []blogSearchRow
+	if err := tx.
+		Select("BLOGS.*, "+
+			"(MATCH(BLOG_SEARCH.title) AGAINST (? IN BOOLEAN MODE) * 2 + "+
+			"MATCH(BLOG_SEARCH.title, BLOG_SEARCH.content) AGAINST (? IN BOOLEAN MODE)) AS score",
+			against, against).
+		Order("score DESC, BLOGS.id DESC").
+		Limit(query.Limit).
+		Offset(query.Offset).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to search blogs (keyword=%s): %w", query.Keyword, err)
+	}
+
+	result := &domainBlog.SearchResult{
+		Hits:  make([]domainBlog.SearchHit, len(rows)),
+		Total: total,
+	}
+	for i, row := range rows {
+		result.Hits[i] = domainBlog.SearchHit{
+			Blog:           row.Blog,
+			Score:          row.Score,
+			TitleHighlight: domainBlog.BuildSnippet(row.Blog.Title, query.Terms),
+			Snippet:        domainBlog.BuildSnippet(row.Blog.Content, query.Terms),
+		}
+	}
+	return result, nil
+}
+
+// 検索語をBOOLEAN MODEの検索式に変換
+// 各検索語をフレーズとして扱い、全ての検索語を含むものに限定する
+func booleanModeQuery(terms []string) string {
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, `+"`+term+`"`)
+	}
+	return strings.Join(phrases, " ")
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 1回のINSERTで登録する索引語の件数
+const termInsertBatchSize = 500
+
+// 関連記事用の索引語インデックス
+// BLOG_TERMSにブログごとの索引語の出現回数、TERM_DFに索引語ごとの文書頻度を保持する
+type blogTermIndex struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewBlogTermIndex(manager *db.DBManager) domainRecommend.TermIndex {
+	return &blogTermIndex{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// ブログの索引語を登録・更新
+// 変更前の索引語の文書頻度を減らしてから変更後の索引語を登録する
+func (r *blogTermIndex) Index(blog *domainBlog.Blog) (err error) {
+	tf := domainRecommend.ExtractTerms(blog.Title, blog.Content)
+
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 同じブログの同時更新で文書頻度がずれないようブログの行をロックする
+	if err = tx.Exec("SELECT id FROM BLOGS WHERE id = ? FOR UPDATE", blog.ID).Error; err != nil {
+		return fmt.Errorf("failed to lock blog (id=%d): %w", blog.ID, err)
+	}
+	if err = removeTerms(tx, blog.ID); err != nil {
+		return err
+	}
+
+	terms := make([]string, 0, len(tf))
+	for term := range tf {
+		terms = append(terms, term)
+	}
+	// デッドロックを避けるため索引語の順序を揃えて登録する
+	sort.Strings(terms)
+	for start := 0; start < len(terms); start += termInsertBatchSize {
+		end := start + termInsertBatchSize
+		if end > len(terms) {
+			end = len(terms)
+		}
+		batch := terms[start:end]
+
+		termRows := make([]string, len(batch))
+		termArgs := make([]interface{}, 0, len(batch)*3)
+		dfRows := make([]string, len(batch))
+		dfArgs := make([]interface{}, 0, len(batch))
+		for i, term := range batch {
+			termRows[i] = "(?, ?, ?)"
+			termArgs = append(termArgs, blog.ID, term, tf[term])
+			dfRows[i] = "(?, 1)"
+			dfArgs = append(dfArgs, term)
+		}
+		if err = tx.Exec("INSERT INTO BLOG_TERMS (blog_id, term, tf) VALUES "+strings.Join(termRows, ", "),
+			termArgs...).Error; err != nil {
+			return fmt.Errorf("failed to save blog terms (id=%d): %w", blog.ID, err)
+		}
+		if err = tx.Exec("INSERT INTO TERM_DF (term, df) VALUES "+strings.Join(dfRows, ", ")+
+			" ON DUPLICATE KEY UPDATE df = df + 1", dfArgs...).Error; err != nil {
+			return fmt.Errorf("failed to increment document frequencies (id=%d): %w", blog.ID, err)
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ブログの索引語を削除
+func (r *blogTermIndex) Remove(id uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = removeTerms(tx, id); err != nil {
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ブログの索引語を削除し、文書頻度を減らす
+// 文書頻度が0になった索引語はTERM_DFから削除する
+func removeTerms(tx *gorm.DB, blogID uint) error {
+	var terms []string
+	if err := tx.Raw("SELECT term FROM BLOG_TERMS WHERE blog_id = ? ORDER BY term", blogID).
+		Scan(&terms).Error; err != nil {
+		return fmt.Errorf("failed to find blog terms (id=%d): %w", blogID, err)
+	}
+	if len(terms) == 0 {
+		return nil
+	}
+
+	if err := tx.Exec("UPDATE TERM_DF SET df = df - 1 WHERE term IN ?", terms).Error; err != nil {
+		return fmt.Errorf("failed to decrement document frequencies (id=%d): %w", blogID, err)
+	}
+	if err := tx.Exec("DELETE FROM TERM_DF WHERE term IN ? AND df <= 0", terms).Error; err != nil {
+		return fmt.Errorf("failed to delete unused terms (id=%d): %w", blogID, err)
+	}
+	if err := tx.Exec("DELETE FROM BLOG_TERMS WHERE blog_id = ?", blogID).Error; err != nil {
+		return fmt.Errorf("failed to delete blog terms (id=%d): %w", blogID, err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type categoryRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewCategoryRepository(manager *db.DBManager) domainCategory.CategoryRepository {
+	return &categoryRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// カテゴリを作成
+func (r *categoryRepository) Create(category *domainCategory.Category) error {
+	if err := r.db.Table("CATEGORIES").Omit(clause.Associations).Create(category).Error; err != nil {
+		return fmt.Errorf("failed to create category (user_id=%d, name=%s): %w", category.UserID, category.Name, err)
+	}
+	return nil
+}
+
+// カテゴリを取得
+func (r *categoryRepository) FindCategoryByID(id uint) (*domainCategory.Category, error) {
+	category := domainCategory.Category{}
+	if err := r.db.Table("CATEGORIES").Where("id = ?", id).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainCategory.ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to find category (id=%d): %w", id, err)
+	}
+	return &category, nil
+}
+
+// ユーザーのカテゴリのうちカテゴリ名に対応するカテゴリを取得
+func (r *categoryRepository) FindCategoryByName(userID uint, name string) (*domainCategory.Category, error) {
+	category := domainCategory.Category{}
+	if err := r.db.Table("CATEGORIES").Where("user_id = ? AND name = ?", userID, name).First(&category).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainCategory.ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to find category (user_id=%d, name=%s): %w", userID, name, err)
+	}
+	return &category, nil
+}
+
+// ユーザーの全カテゴリを取得
+func (r *categoryRepository) FindCategoriesByUserID(userID uint) ([]domainCategory.Category, error) {
+	var categories []domainCategory.Category
+	if err := r.db.Table("CATEGORIES").Where("user_id = ?", userID).Order("id").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to find categories (user_id=%d): %w", userID, err)
+	}
+	return categories, nil
+}
+
+// ブログに紐づくカテゴリを取得
+func (r *categoryRepository) FindCategoriesByBlogID(blogID uint) ([]domainCategory.Category, error) {
+	var categories []domainCategory.Category
+	if err := r.db.Table("CATEGORIES").
+		Joins("JOIN post_categories ON post_categories.category_id = CATEGORIES.id").
+		Where("post_categories.blog_id = ?", blogID).
+		Order("CATEGORIES.id").
+		Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("failed to find categories by blog_id (blog_id=%d): %w", blogID, err)
+	}
+	return categories, nil
+}
+
+// 紐付け先のブログIDを含むカテゴリ
+type blogCategoryRow struct {
+	BlogID      uint
+	ID          uint
+	UserID      uint
+	Name        string
+	Description string
+	ParentID    *uint
+}
+
+// 複数のブログに紐づくカテゴリをブログIDごとに取得
+// 各ブログのカテゴリはFindCategoriesByBlogIDと同じ順に並ぶ
+func (r *categoryRepository) FindCategoriesByBlogIDs(blogIDs []uint) (map[uint][]domainCategory.Category, error) {
+	categories := make(map[uint][]domainCategory.Category, len(blogIDs))
+	if len(blogIDs) == 0 {
+		return categories, nil
+	}
+	var rows []blogCategoryRow
+	if err := r.db.Table("CATEGORIES").
+		Select("post_categories.blog_id, CATEGORIES.id, CATEGORIES.user_id, CATEGORIES.name, CATEGORIES.description, CATEGORIES.parent_id").
+		Joins("JOIN post_categories ON post_categories.category_id = CATEGORIES.id").
+		Where("post_categories.blog_id IN ?", blogIDs).
+		Order("post_categories.blog_id, CATEGORIES.id").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find categories by blog_ids (count=%d): %w", len(blogIDs), err)
+	}
+	for _, row := range rows {
+		categories[row.BlogID] = append(categories[row.BlogID], domainCategory.Category{
+			ID:          row.ID,
+			UserID:      row.UserID,
+			Name:        row.Name,
+			Description: row.Description,
+			ParentID:    row.ParentID,
+		})
+	}
+	return categories, nil
+}
+
+// カテゴリ名・説明・親カテゴリを更新
+func (r *categoryRepository) Update(category *domainCategory.Category) error {
+	updateData := map[string]interface{}{
+		"name":        category.Name,
+		"description": category.Description,
+		"parent_id":   category.ParentID,
+	}
+
+	if err := r.db.Table("CATEGORIES").Where("id = ?", category.ID).Updates(updateData).Error; err != nil {
+		return fmt.Errorf("failed to update category (id=%d): %w", category.ID, err)
+	}
+	return nil
+}
+
+// カテゴリを削除
+// 子カテゴリが存在する場合は削除せず、ブログとの紐付けは同一トランザクションで解除する
+func (r *categoryRepository) Delete(id uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var childCount int64
+	if err = tx.Table("CATEGORIES").Where("parent_id = ?", id).Count(&childCount).Error; err != nil {
+		return fmt.Errorf("failed to count child categories (id=%d): %w", id, err)
+	}
+	if childCount > 0 {
+		return domainCategory.ErrCategoryHasChildren
+	}
+
+	if err = tx.Exec("DELETE FROM post_categories WHERE category_id = ?", id).Error; err != nil {
+		return fmt.Errorf("failed to delete post_categories (category_id=%d): %w", id, err)
+	}
+
+	result := tx.Table("CATEGORIES").Where("id = ?", id).Delete(&domainCategory.Category{})
+	if err = result.Error; err != nil {
+		return fmt.Errorf("failed to delete category (id=%d): %w", id, err)
+	}
+	if result.RowsAffected == 0 {
+		return domainCategory.ErrCategoryNotFound
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ブログにカテゴリを紐付け
+// 既に紐付いている場合は何もしない
+func (r *categoryRepository) AssignBlog(categoryID, blogID uint) error {
+	if err := r.db.Exec(
+		"INSERT IGNORE INTO post_categories (category_id, blog_id) VALUES (?, ?)",
+		categoryID, blogID,
+	).Error; err != nil {
+		return fmt.Errorf("failed to assign category (category_id=%d, blog_id=%d): %w", categoryID, blogID, err)
+	}
+	return nil
+}
+
+// ブログからカテゴリの紐付けを解除
+func (r *categoryRepository) UnassignBlog(categoryID, blogID uint) error {
+	if err := r.db.Exec(
+		"DELETE FROM post_categories WHERE category_id = ? AND blog_id = ?",
+		categoryID, blogID,
+	).Error; err != nil {
+		return fmt.Errorf("failed to unassign category (category_id=%d, blog_id=%d): %w", categoryID, blogID, err)
+	}
+	return nil
+}
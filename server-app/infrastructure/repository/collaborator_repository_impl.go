@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type collaboratorRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewCollaboratorRepository(manager *db.DBManager) domainBlog.CollaboratorRepository {
+	return &collaboratorRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 共同編集者として招待
+// 辞退済みの場合は招待し直す
+func (r *collaboratorRepository) Invite(collaborator *domainBlog.Collaborator) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	existing := domainBlog.Collaborator{}
+	err = tx.Table("BLOG_COLLABORATORS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("blog_id = ? AND user_id = ?", collaborator.BlogID, collaborator.UserID).
+		First(&existing).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		collaborator.Status = domainBlog.InvitationPending
+		collaborator.CreatedAt = time.Now()
+		if err = tx.Table("BLOG_COLLABORATORS").Create(collaborator).Error; err != nil {
+			return fmt.Errorf("failed to invite collaborator (blog_id=%d, user_id=%d): %w", collaborator.BlogID, collaborator.UserID, err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to find collaborator (blog_id=%d, user_id=%d): %w", collaborator.BlogID, collaborator.UserID, err)
+	case existing.Status != domainBlog.InvitationDeclined:
+		return domainBlog.ErrCollaboratorExists
+	default:
+		collaborator.Status = domainBlog.InvitationPending
+		collaborator.CreatedAt = time.Now()
+		collaborator.RespondedAt = nil
+		if err = tx.Table("BLOG_COLLABORATORS").
+			Where("blog_id = ? AND user_id = ?", collaborator.BlogID, collaborator.UserID).
+			Updates(map[string]interface{}{
+				"role":         collaborator.Role,
+				"status":       collaborator.Status,
+				"invited_by":   collaborator.InvitedBy,
+				"created_at":   collaborator.CreatedAt,
+				"responded_at": nil,
+			}).Error; err != nil {
+			return fmt.Errorf("failed to re-invite collaborator (blog_id=%d, user_id=%d): %w", collaborator.BlogID, collaborator.UserID, err)
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ブログの共同編集者を取得
+func (r *collaboratorRepository) FindCollaborator(blogID, userID uint) (*domainBlog.Collaborator, error) {
+	collaborator := domainBlog.Collaborator{}
+	if err := r.db.Table("BLOG_COLLABORATORS").
+		Where("blog_id = ? AND user_id = ?", blogID, userID).
+		First(&collaborator).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainBlog.ErrCollaboratorNotFound
+		}
+		return nil, fmt.Errorf("failed to find collaborator (blog_id=%d, user_id=%d): %w", blogID, userID, err)
+	}
+	return &collaborator, nil
+}
+
+// ブログの共同編集者を招待の古い順に取得
+// 辞退済みの招待は含めない
+func (r *collaboratorRepository) FindCollaboratorsByBlogID(blogID uint) ([]domainBlog.Collaborator, error) {
+	var collaborators []domainBlog.Collaborator
+	if err := r.db.Table("BLOG_COLLABORATORS").
+		Select("BLOG_COLLABORATORS.*, USERS.user_id AS username").
+		Joins("JOIN USERS ON USERS.id = BLOG_COLLABORATORS.user_id").
+		Where("BLOG_COLLABORATORS.blog_id = ? AND BLOG_COLLABORATORS.status <> ?", blogID, domainBlog.InvitationDeclined).
+		Order("BLOG_COLLABORATORS.created_at, BLOG_COLLABORATORS.user_id").
+		Scan(&collaborators).Error; err != nil {
+		return nil, fmt.Errorf("failed to find collaborators (blog_id=%d): %w", blogID, err)
+	}
+	return collaborators, nil
+}
+
+// ユーザー宛ての未回答の招待を新しい順に取得
+func (r *collaboratorRepository) FindInvitationsByUserID(userID uint) ([]domainBlog.Collaborator, error) {
+	var invitations []domainBlog.Collaborator
+	if err := r.db.Table("BLOG_COLLABORATORS").
+		Select("BLOG_COLLABORATORS.*, USERS.user_id AS username, BLOGS.title AS blog_title").
+		Joins("JOIN BLOGS ON BLOGS.id = BLOG_COLLABORATORS.blog_id").
+		Joins("JOIN USERS ON USERS.id = BLOG_COLLABORATORS.user_id").
+		Where("BLOG_COLLABORATORS.user_id = ? AND BLOG_COLLABORATORS.status = ? AND BLOGS.deleted_at IS NULL",
+			userID, domainBlog.InvitationPending).
+		Order("BLOG_COLLABORATORS.created_at DESC").
+		Scan(&invitations).Error; err != nil {
+		return nil, fmt.Errorf("failed to find invitations (user_id=%d): %w", userID, err)
+	}
+	return invitations, nil
+}
+
+// 未回答の招待に回答
+func (r *collaboratorRepository) Respond(blogID, userID uint, status string) error {
+	result := r.db.Table("BLOG_COLLABORATORS").
+		Where("blog_id = ? AND user_id = ? AND status = ?", blogID, userID, domainBlog.InvitationPending).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"responded_at": time.Now(),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to respond invitation (blog_id=%d, user_id=%d): %w", blogID, userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+// 共同編集者を削除
+func (r *collaboratorRepository) Remove(blogID, userID uint) error {
+	result := r.db.Exec("DELETE FROM BLOG_COLLABORATORS WHERE blog_id = ? AND user_id = ?", blogID, userID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to remove collaborator (blog_id=%d, user_id=%d): %w", blogID, userID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domainBlog.ErrCollaboratorNotFound
+	}
+	return nil
+}
+
+// ブログの所有権を移譲
+// 移譲先の共同編集者としての権限は削除し、移譲元は共同編集者（編集者）として残す
+func (r *collaboratorRepository) TransferOwnership(blogID, fromUserID, toUserID uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	blog := domainBlog.Blog{}
+	if err = tx.Table("BLOGS").Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND deleted_at IS NULL", blogID).First(&blog).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domainBlog.ErrBlogNotFound
+		}
+		return fmt.Errorf("failed to find blog (id=%d): %w", blogID, err)
+	}
+	// 読み込み前に他の移譲が完了していた場合
+	if blog.AuthorID != fromUserID {
+		return domainBlog.ErrBlogUnauthorized
+	}
+
+	// スラッグは著者ごとに一意のため移譲先のブログと重複する場合は移譲しない
+	var count int64
+	if err = tx.Table("BLOGS").Where("user_id = ? AND slug = ? AND id <> ?", toUserID, blog.Slug, blogID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check slug of new owner (id=%d): %w", blogID, err)
+	}
+	if count > 0 {
+		return domainBlog.ErrSlugAlreadyExists
+	}
+
+	if err = tx.Exec("UPDATE BLOGS SET user_id = ? WHERE id = ?", toUserID, blogID).Error; err != nil {
+		return fmt.Errorf("failed to transfer blog (id=%d): %w", blogID, err)
+	}
+	if err = tx.Exec("DELETE FROM BLOG_COLLABORATORS WHERE blog_id = ? AND user_id = ?", blogID, toUserID).Error; err != nil {
+		return fmt.Errorf("failed to remove new owner from collaborators (id=%d): %w", blogID, err)
+	}
+	now := time.Now()
+	if err = tx.Exec("INSERT INTO BLOG_COLLABORATORS (blog_id, user_id, role, status, invited_by, created_at, responded_at) "+
+		"VALUES (?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE role = VALUES(role), status = VALUES(status), responded_at = VALUES(responded_at)",
+		blogID, fromUserID, domainBlog.RoleEditor, domainBlog.InvitationAccepted, toUserID, now, now).Error; err != nil {
+		return fmt.Errorf("failed to keep former owner as collaborator (id=%d): %w", blogID, err)
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainComment "github.com/kazukimurahashi12/webapp/domain/comment"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type commentRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewCommentRepository(manager *db.DBManager) domainComment.CommentRepository {
+	return &commentRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// コメントを作成
+func (r *commentRepository) Create(comment *domainComment.Comment) error {
+	if err := r.db.Table("COMMENTS").Omit(clause.Associations).Create(comment).Error; err != nil {
+		return fmt.Errorf("failed to create comment (post_id=%d): %w", comment.PostID, err)
+	}
+	return nil
+}
+
+// コメントを取得
+func (r *commentRepository) FindCommentByID(id uint) (*domainComment.Comment, error) {
+	comment := domainComment.Comment{}
+	if err := r.db.Table("COMMENTS").Where("id = ?", id).First(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainComment.ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("failed to find comment (id=%d): %w", id, err)
+	}
+	return &comment, nil
+}
+
+// ブログ記事に紐づく指定ステータスのコメントを投稿順に取得
+func (r *commentRepository) FindCommentsByPostIDAndStatus(postID uint, status string) ([]domainComment.Comment, error) {
+	var comments []domainComment.Comment
+	if err := r.db.Table("COMMENTS").
+		Where("post_id = ? AND status = ?", postID, status).
+		Order("created_at, id").
+		Find(&comments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find comments (post_id=%d, status=%s): %w", postID, status, err)
+	}
+	return comments, nil
+}
+
+// ユーザーが著者、または指定した権限の共同編集者である全ブログ記事に付いた指定ステータスのコメントを取得
+func (r *commentRepository) FindCommentsByModeratorIDAndStatus(userID uint, collaboratorRoles []string, status string) ([]domainComment.Comment, error) {
+	moderated := r.db.Where("BLOGS.user_id = ?", userID)
+	if len(collaboratorRoles) > 0 {
+		moderated = moderated.Or("EXISTS (?)", r.db.Table("BLOG_COLLABORATORS").
+			Select("1").
+			Where("BLOG_COLLABORATORS.blog_id = BLOGS.id AND BLOG_COLLABORATORS.user_id = ? AND BLOG_COLLABORATORS.status = ? AND BLOG_COLLABORATORS.role IN ?",
+				userID, domainBlog.InvitationAccepted, collaboratorRoles))
+	}
+
+	var comments []domainComment.Comment
+	if err := r.db.Table("COMMENTS").
+		Select("COMMENTS.*").
+		Joins("JOIN BLOGS ON BLOGS.id = COMMENTS.post_id").
+		Where(moderated).
+		Where("COMMENTS.status = ? AND BLOGS.deleted_at IS NULL", status).
+		Order("COMMENTS.created_at, COMMENTS.id").
+		Find(&comments).Error; err != nil {
+		return nil, fmt.Errorf("failed to find comments by moderator (user_id=%d, status=%s): %w", userID, status, err)
+	}
+	return comments, nil
+}
+
+// コメントのステータスを更新
+func (r *commentRepository) UpdateStatus(id uint, status string) error {
+	if err := r.db.Table("COMMENTS").Where("id = ?", id).Update("status", status).Error; err != nil {
+		return fmt.Errorf("failed to update comment status (id=%d): %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type exportJobRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewExportJobRepository(manager *db.DBManager) domainExport.JobRepository {
+	return &exportJobRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// エクスポートジョブを登録
+func (r *exportJobRepository) Create(job *domainExport.Job) error {
+	if err := r.db.Table("EXPORT_JOBS").Create(job).Error; err != nil {
+		return fmt.Errorf("failed to create export job (user_id=%d): %w", job.UserID, err)
+	}
+	return nil
+}
+
+// エクスポートジョブを取得
+func (r *exportJobRepository) FindJobByID(id uint) (*domainExport.Job, error) {
+	job := domainExport.Job{}
+	if err := r.db.Table("EXPORT_JOBS").Where("id = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainExport.ErrExportJobNotFound
+		}
+		return nil, fmt.Errorf("failed to find export job (id=%d): %w", id, err)
+	}
+	return &job, nil
+}
+
+// ダウンロードURLのトークンからエクスポートジョブを取得
+func (r *exportJobRepository) FindJobByToken(token string) (*domainExport.Job, error) {
+	job := domainExport.Job{}
+	if err := r.db.Table("EXPORT_JOBS").Where("download_token = ?", token).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainExport.ErrExportJobNotFound
+		}
+		return nil, fmt.Errorf("failed to find export job by token: %w", err)
+	}
+	return &job, nil
+}
+
+// ユーザーの作成待ち・作成中のエクスポートジョブを取得
+func (r *exportJobRepository) FindActiveJobByUserID(userID uint) (*domainExport.Job, error) {
+	job := domainExport.Job{}
+	if err := r.db.Table("EXPORT_JOBS").
+		Where("user_id = ? AND status IN ?", userID, []string{domainExport.JobPending, domainExport.JobRunning}).
+		Order("id").
+		First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainExport.ErrExportJobNotFound
+		}
+		return nil, fmt.Errorf("failed to find active export job (user_id=%d): %w", userID, err)
+	}
+	return &job, nil
+}
+
+// 状態が一致するエクスポートジョブのIDを古い順に取得
+func (r *exportJobRepository) FindJobIDsByStatus(status string, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Table("EXPORT_JOBS").
+		Where("status = ?", status).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find export jobs (status=%s): %w", status, err)
+	}
+	return ids, nil
+}
+
+// ダウンロードURLの有効期限を過ぎたエクスポートジョブのIDを取得
+func (r *exportJobRepository) FindExpiredJobIDs(now time.Time, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Table("EXPORT_JOBS").
+		Where("status = ? AND expires_at <= ?", domainExport.JobDone, now).
+		Order("expires_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find expired export jobs: %w", err)
+	}
+	return ids, nil
+}
+
+// 作成待ちのエクスポートジョブを作成中に変更
+// 他のワーカーが既に開始している場合はfalseを返す
+func (r *exportJobRepository) ClaimJob(id uint, startedAt time.Time) (bool, error) {
+	result := r.db.Table("EXPORT_JOBS").
+		Where("id = ? AND status = ?", id, domainExport.JobPending).
+		Updates(map[string]interface{}{"status": domainExport.JobRunning, "started_at": startedAt})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to claim export job (id=%d): %w", id, result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// エクスポートジョブを更新
+func (r *exportJobRepository) Update(job *domainExport.Job) error {
+	result := r.db.Table("EXPORT_JOBS").Where("id = ?", job.ID).Updates(map[string]interface{}{
+		"status":           job.Status,
+		"blog_count":       job.BlogCount,
+		"attachment_count": job.AttachmentCount,
+		"size":             job.Size,
+		"error":            job.Error,
+		"download_token":   job.DownloadToken,
+		"started_at":       job.StartedAt,
+		"completed_at":     job.CompletedAt,
+		"expires_at":       job.ExpiresAt,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update export job (id=%d): %w", job.ID, result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type importSourceRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewImportSourceRepository(manager *db.DBManager) domainImporter.SourceLinkRepository {
+	return &importSourceRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 取り込み元の記事に対応するブログを取得
+func (r *importSourceRepository) FindLink(userID uint, source, sourceID string) (*domainImporter.SourceLink, error) {
+	link := domainImporter.SourceLink{}
+	if err := r.db.Table("IMPORT_SOURCES").
+		Where("user_id = ? AND source = ? AND source_id = ?", userID, source, sourceID).
+		First(&link).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainImporter.ErrSourceLinkNotFound
+		}
+		return nil, fmt.Errorf("failed to find import source (user_id=%d, source=%s): %w", userID, source, err)
+	}
+	return &link, nil
+}
+
+// 取り込み元の記事とブログの対応を登録
+func (r *importSourceRepository) Save(link *domainImporter.SourceLink) error {
+	if err := r.db.Table("IMPORT_SOURCES").Create(link).Error; err != nil {
+		return fmt.Errorf("failed to save import source (user_id=%d, blog_id=%d): %w", link.UserID, link.BlogID, err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type reactionRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewReactionRepository(manager *db.DBManager) domainReaction.ReactionRepository {
+	return &reactionRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// リアクションを切り替える
+// リアクション済みの場合は取り消し、未リアクションの場合は登録する
+// 一覧表示用の絵文字ごとの件数は同じトランザクションで更新する
+func (r *reactionRepository) Toggle(blogID, userID uint, emoji string) (added bool, err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	result := tx.Exec("DELETE FROM REACTIONS WHERE blog_id = ? AND user_id = ? AND emoji = ?", blogID, userID, emoji)
+	if err = result.Error; err != nil {
+		return false, fmt.Errorf("failed to delete reaction (blog_id=%d, user_id=%d): %w", blogID, userID, err)
+	}
+	if result.RowsAffected > 0 {
+		if err = tx.Exec("UPDATE BLOG_REACTION_COUNTS SET count = count - 1 WHERE blog_id = ? AND emoji = ? AND count > 0",
+			blogID, emoji).Error; err != nil {
+			return false, fmt.Errorf("failed to decrement reaction count (blog_id=%d): %w", blogID, err)
+		}
+	} else {
+		// 同時に登録された場合は一意制約により1件のみ登録される
+		result = tx.Exec("INSERT IGNORE INTO REACTIONS (blog_id, user_id, emoji, created_at) VALUES (?, ?, ?, ?)",
+			blogID, userID, emoji, time.Now())
+		if err = result.Error; err != nil {
+			return false, fmt.Errorf("failed to create reaction (blog_id=%d, user_id=%d): %w", blogID, userID, err)
+		}
+		if result.RowsAffected > 0 {
+			added = true
+			if err = tx.Exec("INSERT INTO BLOG_REACTION_COUNTS (blog_id, emoji, count) VALUES (?, ?, 1) ON DUPLICATE KEY UPDATE count = count + 1",
+				blogID, emoji).Error; err != nil {
+				return false, fmt.Errorf("failed to increment reaction count (blog_id=%d): %w", blogID, err)
+			}
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return false, fmt.Errorf("failed to commit reaction toggle (blog_id=%d): %w", blogID, err)
+	}
+	return added, nil
+}
+
+// ブログの絵文字ごとのリアクション数を取得
+func (r *reactionRepository) FindCountsByBlogID(blogID uint) (map[string]int, error) {
+	counts, err := findReactionCounts(r.db, []uint{blogID})
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := counts[blogID]; ok {
+		return c, nil
+	}
+	return map[string]int{}, nil
+}
+
+// ユーザーがリアクション済みの絵文字を取得
+func (r *reactionRepository) FindEmojisByUser(blogID, userID uint) ([]string, error) {
+	var emojis []string
+	if err := r.db.Table("REACTIONS").
+		Where("blog_id = ? AND user_id = ?", blogID, userID).
+		Order("id").
+		Pluck("emoji", &emojis).Error; err != nil {
+		return nil, fmt.Errorf("failed to find reactions of user (blog_id=%d, user_id=%d): %w", blogID, userID, err)
+	}
+	return emojis, nil
+}
+
+// リアクションしたユーザーを新しい順に取得
+// 絵文字が空の場合は全ての絵文字を対象とする
+func (r *reactionRepository) FindReactors(blogID uint, emoji string, limit int) ([]domainReaction.Reactor, error) {
+	tx := r.db.Table("REACTIONS").
+		Select("REACTIONS.user_id, USERS.user_id AS username, REACTIONS.emoji, REACTIONS.created_at").
+		Joins("JOIN USERS ON USERS.id = REACTIONS.user_id").
+		Where("REACTIONS.blog_id = ?", blogID)
+	if emoji != "" {
+		tx = tx.Where("REACTIONS.emoji = ?", emoji)
+	}
+
+	var reactors []domainReaction.Reactor
+	if err := tx.
+		Order("REACTIONS.created_at DESC, REACTIONS.id DESC").
+		Limit(limit).
+		Scan(&reactors).Error; err != nil {
+		return nil, fmt.Errorf("failed to find reactors (blog_id=%d): %w", blogID, err)
+	}
+	return reactors, nil
+}
+
+// 複数ブログの絵文字ごとのリアクション数をまとめて取得
+func findReactionCounts(db *gorm.DB, blogIDs []uint) (map[uint]map[string]int, error) {
+	var rows []struct {
+		BlogID uint
+		Emoji  string
+		Count  int
+	}
+	if err := db.Table("BLOG_REACTION_COUNTS").
+		Where("blog_id IN ? AND count > 0", blogIDs).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find reaction counts: %w", err)
+	}
+
+	counts := make(map[uint]map[string]int)
+	for _, row := range rows {
+		if counts[row.BlogID] == nil {
+			counts[row.BlogID] = map[string]int{}
+		}
+		counts[row.BlogID][row.Emoji] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// 1回のクエリで文書頻度を取得する索引語の件数
+const termQueryBatchSize = 1000
+
+type relatedRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewRelatedRepository(manager *db.DBManager) domainRecommend.RelatedRepository {
+	return &relatedRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 索引語の行
+type blogTermRow struct {
+	BlogID uint   `gorm:"column:blog_id"`
+	Term   string `gorm:"column:term"`
+	TF     int    `gorm:"column:tf"`
+}
+
+// ブログごとの索引語の出現回数を取得
+func (r *relatedRepository) FindTerms(blogIDs []uint) (map[uint]map[string]int, error) {
+	terms := make(map[uint]map[string]int, len(blogIDs))
+	if len(blogIDs) == 0 {
+		return terms, nil
+	}
+
+	var rows []blogTermRow
+	if err := r.db.Table("BLOG_TERMS").
+		Select("blog_id, term, tf").
+		Where("blog_id IN ?", blogIDs).
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find blog terms: %w", err)
+	}
+	for _, row := range rows {
+		if terms[row.BlogID] == nil {
+			terms[row.BlogID] = make(map[string]int)
+		}
+		terms[row.BlogID][row.Term] = row.TF
+	}
+	return terms, nil
+}
+
+// 索引語ごとの文書頻度と索引済みのブログ数を取得
+func (r *relatedRepository) FindDocumentFrequencies(terms []string) (map[string]int, int, error) {
+	df := make(map[string]int, len(terms))
+	for start := 0; start < len(terms); start += termQueryBatchSize {
+		end := start + termQueryBatchSize
+		if end > len(terms) {
+			end = len(terms)
+		}
+
+		var rows []struct {
+			Term string
+			DF   int `gorm:"column:df"`
+		}
+		if err := r.db.Table("TERM_DF").
+			Select("term, df").
+			Where("term IN ?", terms[start:end]).
+			Find(&rows).Error; err != nil {
+			return nil, 0, fmt.Errorf("failed to find document frequencies: %w", err)
+		}
+		for _, row := range rows {
+			df[row.Term] = row.DF
+		}
+	}
+
+	var total int64
+	if err := r.db.Raw("SELECT COUNT(DISTINCT blog_id) FROM BLOG_TERMS").Scan(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count indexed blogs: %w", err)
+	}
+	return df, int(total), nil
+}
+
+// 関連記事の候補を取得
+// 索引語を多く共有するブログと、カテゴリ・タグを共有するブログを全体公開の公開済みブログから取得する
+func (r *relatedRepository) FindCandidates(blogID uint, terms []string, limit int) ([]domainRecommend.Candidate, error) {
+	ids := make([]uint, 0, limit*3)
+	seen := make(map[uint]bool)
+	add := func(candidateIDs []uint) {
+		for _, id := range candidateIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	if len(terms) > 0 {
+		var termIDs []uint
+		if err := r.publishedBlogs(r.db.Table("BLOG_TERMS"), "BLOG_TERMS.blog_id").
+			Select("BLOG_TERMS.blog_id").
+			Where("BLOG_TERMS.term IN ? AND BLOG_TERMS.blog_id <> ?", terms, blogID).
+			Group("BLOG_TERMS.blog_id").
+			Order("COUNT(*) DESC, BLOG_TERMS.blog_id DESC").
+			Limit(limit).
+			Pluck("BLOG_TERMS.blog_id", &termIDs).Error; err != nil {
+			return nil, fmt.Errorf("failed to find related candidates by terms (id=%d): %w", blogID, err)
+		}
+		add(termIDs)
+	}
+
+	sharedCategories, err := r.countSharedLabels("post_categories", "category_id", blogID, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+	sharedTags, err := r.countSharedLabels("post_tags", "tag_id", blogID, nil, limit)
+	if err != nil {
+		return nil, err
+	}
+	add(sortedBlogIDs(sharedCategories))
+	add(sortedBlogIDs(sharedTags))
+	if len(ids) == 0 {
+		return []domainRecommend.Candidate{}, nil
+	}
+
+	// 索引語で絞り込んだ候補にもカテゴリ・タグの共有数を反映する
+	if sharedCategories, err = r.countSharedLabels("post_categories", "category_id", blogID, ids, 0); err != nil {
+		return nil, err
+	}
+	if sharedTags, err = r.countSharedLabels("post_tags", "tag_id", blogID, ids, 0); err != nil {
+		return nil, err
+	}
+
+	candidates := make([]domainRecommend.Candidate, len(ids))
+	for i, id := range ids {
+		candidates[i] = domainRecommend.Candidate{
+			BlogID:           id,
+			SharedCategories: sharedCategories[id],
+			SharedTags:       sharedTags[id],
+		}
+	}
+	return candidates, nil
+}
+
+// 指定したIDの全体公開の公開済みブログを取得
+func (r *relatedRepository) FindPublishedBlogsByIDs(ids []uint) ([]domainBlog.Blog, error) {
+	var blogs []domainBlog.Blog
+	if len(ids) == 0 {
+		return blogs, nil
+	}
+	if err := r.db.Table("BLOGS").
+		Where("id IN ? AND deleted_at IS NULL AND status = ? AND visibility = ?",
+			ids, domainBlog.StatusPublished, domainBlog.VisibilityPublic).
+		Find(&blogs).Error; err != nil {
+		return nil, fmt.Errorf("failed to find related blogs: %w", err)
+	}
+	return blogs, nil
+}
+
+// 索引語が未登録のブログのIDをafterIDより大きいものから順に取得
+// 索引語の導入前に作成されたブログの一括登録に用いる
+func (r *relatedRepository) FindUnindexedBlogIDs(afterID uint, limit int) ([]uint, error) {
+	var ids []uint
+	if err := r.db.Table("BLOGS").
+		Where("deleted_at IS NULL AND id > ?", afterID).
+		Where("NOT EXISTS (SELECT 1 FROM BLOG_TERMS WHERE BLOG_TERMS.blog_id = BLOGS.id)").
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to find unindexed blogs: %w", err)
+	}
+	return ids, nil
+}
+
+// ブログとカテゴリ・タグを共有するブログごとの共有数を取得
+// idsを指定した場合は指定したブログのみを対象とする
+func (r *relatedRepository) countSharedLabels(table, column string, blogID uint, ids []uint, limit int) (map[uint]int, error) {
+	tx := r.db.Table(table + " AS target").
+		Joins("JOIN " + table + " AS shared ON shared." + column + " = target." + column + " AND shared.blog_id <> target.blog_id")
+	tx = r.publishedBlogs(tx, "shared.blog_id").
+		Select("shared.blog_id AS blog_id, COUNT(*) AS shared_count").
+		Where("target.blog_id = ?", blogID).
+		Group("shared.blog_id").
+		Order("shared_count DESC, shared.blog_id DESC")
+	if ids != nil {
+		tx = tx.Where("shared.blog_id IN ?", ids)
+	}
+	if limit > 0 {
+		tx = tx.Limit(limit)
+	}
+
+	var rows []struct {
+		BlogID      uint
+		SharedCount int
+	}
+	if err := tx.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count shared %s (id=%d): %w", table, blogID, err)
+	}
+	counts := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		counts[row.BlogID] = row.SharedCount
+	}
+	return counts, nil
+}
+
+// 全体公開の公開済みブログに限定する
+func (r *relatedRepository) publishedBlogs(tx *gorm.DB, blogIDColumn string) *gorm.DB {
+	return tx.Joins("JOIN BLOGS ON BLOGS.id = "+blogIDColumn).
+		Where("BLOGS.deleted_at IS NULL AND BLOGS.status = ? AND BLOGS.visibility = ?",
+			domainBlog.StatusPublished, domainBlog.VisibilityPublic)
+}
+
+// 共有数の多い順にブログのIDを取得
+func sortedBlogIDs(counts map[uint]int) []uint {
+	ids := make([]uint, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if counts[ids[i]] != counts[ids[j]] {
+			return counts[ids[i]] > counts[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	return ids
+}
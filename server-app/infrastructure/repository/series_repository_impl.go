@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainSeries "github.com/kazukimurahashi12/webapp/domain/series"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type seriesRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewSeriesRepository(manager *db.DBManager) domainSeries.SeriesRepository {
+	return &seriesRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// シリーズ内のブログの行
+type seriesEntryRow struct {
+	domainBlog.Blog
+	Position int `gorm:"column:position"`
+}
+
+// シリーズを作成
+func (r *seriesRepository) Create(series *domainSeries.Series) error {
+	if err := r.db.Table("SERIES").Omit(clause.Associations).Create(series).Error; err != nil {
+		return fmt.Errorf("failed to create series (title=%s): %w", series.Title, err)
+	}
+	return nil
+}
+
+// シリーズを目次と合わせて取得
+// ゴミ箱内のブログは目次に含めない
+func (r *seriesRepository) FindByID(id uint) (*domainSeries.Series, error) {
+	series := domainSeries.Series{}
+	if err := r.db.Table("SERIES").Where("id = ?", id).First(&series).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainSeries.ErrSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to find series (id=%d): %w", id, err)
+	}
+
+	var rows []seriesEntryRow
+	if err := r.db.Table("SERIES_ENTRIES").
+		Select("BLOGS.*, SERIES_ENTRIES.position").
+		Joins("JOIN BLOGS ON BLOGS.id = SERIES_ENTRIES.blog_id").
+		Where("SERIES_ENTRIES.series_id = ? AND BLOGS.deleted_at IS NULL", id).
+		Order("SERIES_ENTRIES.position").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find series entries (id=%d): %w", id, err)
+	}
+	series.Entries = make([]domainSeries.Entry, len(rows))
+	for i, row := range rows {
+		// 削除されたブログで欠番となった表示順は詰めて扱う
+		series.Entries[i] = domainSeries.Entry{Position: i + 1, Blog: row.Blog}
+	}
+	return &series, nil
+}
+
+// ブログが属するシリーズのIDを取得
+func (r *seriesRepository) FindSeriesIDByBlogID(blogID uint) (uint, error) {
+	var ids []uint
+	if err := r.db.Table("SERIES_ENTRIES").
+		Where("blog_id = ?", blogID).
+		Limit(1).
+		Pluck("series_id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to find series of blog (blog_id=%d): %w", blogID, err)
+	}
+	if len(ids) == 0 {
+		return 0, domainSeries.ErrSeriesNotFound
+	}
+	return ids[0], nil
+}
+
+// シリーズの末尾にブログを追加
+// ブログは1つのシリーズにのみ属する
+func (r *seriesRepository) AddEntry(seriesID, blogID uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = lockSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	var count int64
+	if err = tx.Table("SERIES_ENTRIES").Where("blog_id = ?", blogID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check series of blog (blog_id=%d): %w", blogID, err)
+	}
+	if count > 0 {
+		return domainSeries.ErrSeriesBlogConflict
+	}
+
+	var stat struct {
+		Entries int
+		Last    int
+	}
+	if err = tx.Raw("SELECT COUNT(*) AS entries, COALESCE(MAX(position), 0) AS last FROM SERIES_ENTRIES WHERE series_id = ?",
+		seriesID).Scan(&stat).Error; err != nil {
+		return fmt.Errorf("failed to find series positions (id=%d): %w", seriesID, err)
+	}
+	if stat.Entries >= domainSeries.MaxEntries {
+		return domainSeries.ErrSeriesFull
+	}
+
+	if err = tx.Exec("INSERT INTO SERIES_ENTRIES (series_id, blog_id, position) VALUES (?, ?, ?)",
+		seriesID, blogID, stat.Last+1).Error; err != nil {
+		return fmt.Errorf("failed to add blog to series (id=%d, blog_id=%d): %w", seriesID, blogID, err)
+	}
+	if err = touchSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// シリーズからブログを外し、後続のブログの表示順を詰める
+func (r *seriesRepository) RemoveEntry(seriesID, blogID uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = lockSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	var positions []int
+	if err = tx.Table("SERIES_ENTRIES").
+		Where("series_id = ? AND blog_id = ?", seriesID, blogID).
+		Pluck("position", &positions).Error; err != nil {
+		return fmt.Errorf("failed to find series entry (id=%d, blog_id=%d): %w", seriesID, blogID, err)
+	}
+	if len(positions) == 0 {
+		return domainSeries.ErrSeriesEntryNotFound
+	}
+
+	if err = tx.Exec("DELETE FROM SERIES_ENTRIES WHERE series_id = ? AND blog_id = ?", seriesID, blogID).Error; err != nil {
+		return fmt.Errorf("failed to remove blog from series (id=%d, blog_id=%d): %w", seriesID, blogID, err)
+	}
+	// 一意制約に反しないよう前から順に詰める
+	if err = tx.Exec("UPDATE SERIES_ENTRIES SET position = position - 1 WHERE series_id = ? AND position > ? ORDER BY position",
+		seriesID, positions[0]).Error; err != nil {
+		return fmt.Errorf("failed to shift series positions (id=%d): %w", seriesID, err)
+	}
+	if err = touchSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// シリーズ内のブログを指定した順序に並べ替える
+// 指定されたブログIDがゴミ箱内のブログを除く現在のブログの並べ替えでない場合は変更しない
+// ゴミ箱内のブログは現在の順序のまま末尾に配置する
+func (r *seriesRepository) Reorder(seriesID uint, blogIDs []uint) (err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = lockSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	var rows []struct {
+		BlogID  uint
+		Trashed bool
+	}
+	if err = tx.Table("SERIES_ENTRIES").
+		Select("SERIES_ENTRIES.blog_id, BLOGS.deleted_at IS NOT NULL AS trashed").
+		Joins("JOIN BLOGS ON BLOGS.id = SERIES_ENTRIES.blog_id").
+		Where("SERIES_ENTRIES.series_id = ?", seriesID).
+		Order("SERIES_ENTRIES.position").
+		Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to find series entries (id=%d): %w", seriesID, err)
+	}
+	current := make([]uint, 0, len(rows))
+	var trashed []uint
+	for _, row := range rows {
+		if row.Trashed {
+			trashed = append(trashed, row.BlogID)
+		} else {
+			current = append(current, row.BlogID)
+		}
+	}
+	if err = domainSeries.ValidateOrder(current, blogIDs); err != nil {
+		return err
+	}
+
+	// 一意制約に反しないよう一旦負の値へ退避してから表示順を振り直す
+	if err = tx.Exec("UPDATE SERIES_ENTRIES SET position = -position WHERE series_id = ?", seriesID).Error; err != nil {
+		return fmt.Errorf("failed to reset series positions (id=%d): %w", seriesID, err)
+	}
+	for i, blogID := range append(append([]uint{}, blogIDs...), trashed...) {
+		if err = tx.Exec("UPDATE SERIES_ENTRIES SET position = ? WHERE series_id = ? AND blog_id = ?",
+			i+1, seriesID, blogID).Error; err != nil {
+			return fmt.Errorf("failed to update series position (id=%d, blog_id=%d): %w", seriesID, blogID, err)
+		}
+	}
+	if err = touchSeries(tx, seriesID); err != nil {
+		return err
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// 同じシリーズへの同時変更を直列化するためシリーズの行をロック
+func lockSeries(tx *gorm.DB, seriesID uint) error {
+	var ids []uint
+	if err := tx.Raw("SELECT id FROM SERIES WHERE id = ? FOR UPDATE", seriesID).Scan(&ids).Error; err != nil {
+		return fmt.Errorf("failed to lock series (id=%d): %w", seriesID, err)
+	}
+	if len(ids) == 0 {
+		return domainSeries.ErrSeriesNotFound
+	}
+	return nil
+}
+
+// シリーズの更新日時を更新
+func touchSeries(tx *gorm.DB, seriesID uint) error {
+	if err := tx.Exec("UPDATE SERIES SET updated_at = ? WHERE id = ?", time.Now(), seriesID).Error; err != nil {
+		return fmt.Errorf("failed to touch series (id=%d): %w", seriesID, err)
+	}
+	return nil
+}
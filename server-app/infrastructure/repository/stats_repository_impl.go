@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type statsRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewStatsRepository(manager *db.DBManager) domainStats.StatsRepository {
+	return &statsRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// 日別の閲覧数を保存
+// Redisの値は日ごとの累計のため、既存の値より小さい場合（Redisの再起動等）は更新しない
+func (r *statsRepository) SaveDailyViews(rows []domainStats.DailyViews) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	if err := r.db.Table("BLOG_DAILY_VIEWS").Clauses(clause.OnConflict{
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "views"}, Value: gorm.Expr("GREATEST(views, VALUES(views))")},
+			{Column: clause.Column{Name: "unique_visitors"}, Value: gorm.Expr("GREATEST(unique_visitors, VALUES(unique_visitors))")},
+		},
+	}).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to save daily views (rows=%d): %w", len(rows), err)
+	}
+	return nil
+}
+
+// ブログの日別の閲覧数を取得
+func (r *statsRepository) FindBlogDailyViews(blogID uint, from, to time.Time) ([]domainStats.DailyViews, error) {
+	var rows []domainStats.DailyViews
+	if err := r.db.Table("BLOG_DAILY_VIEWS").
+		Where("blog_id = ? AND day BETWEEN ? AND ?", blogID, from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Order("day").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find daily views (blog_id=%d): %w", blogID, err)
+	}
+	return rows, nil
+}
+
+// 著者の全てのブログの日別の閲覧数の合計を取得
+// ゴミ箱内のブログは含めない
+func (r *statsRepository) FindAuthorDailyViews(authorID uint, from, to time.Time) ([]domainStats.DailyViews, error) {
+	var rows []domainStats.DailyViews
+	if err := r.db.Table("BLOG_DAILY_VIEWS").
+		Select("BLOG_DAILY_VIEWS.day, SUM(BLOG_DAILY_VIEWS.views) AS views, SUM(BLOG_DAILY_VIEWS.unique_visitors) AS unique_visitors").
+		Joins("JOIN BLOGS ON BLOGS.id = BLOG_DAILY_VIEWS.blog_id").
+		Where("BLOGS.user_id = ? AND BLOGS.deleted_at IS NULL", authorID).
+		Where("BLOG_DAILY_VIEWS.day BETWEEN ? AND ?", from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Group("BLOG_DAILY_VIEWS.day").
+		Order("BLOG_DAILY_VIEWS.day").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find daily views (author_id=%d): %w", authorID, err)
+	}
+	return rows, nil
+}
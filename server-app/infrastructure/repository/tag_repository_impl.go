@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	"github.com/kazukimurahashi12/webapp/infrastructure/db"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type tagRepository struct {
+	db     *gorm.DB
+	logger *zap.Logger
+}
+
+func NewTagRepository(manager *db.DBManager) domainTag.TagRepository {
+	return &tagRepository{
+		db:     manager.DB,
+		logger: manager.Logger,
+	}
+}
+
+// タグと使用件数の集計結果の行
+type tagCountRow struct {
+	ID    uint
+	Name  string
+	Count int64
+}
+
+// タグ名に対応するタグを取得
+func (r *tagRepository) FindTagByName(name string) (*domainTag.Tag, error) {
+	tag := domainTag.Tag{}
+	if err := r.db.Table("TAGS").Where("name = ?", name).First(&tag).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domainTag.ErrTagNotFound
+		}
+		return nil, fmt.Errorf("failed to find tag (name=%s): %w", name, err)
+	}
+	return &tag, nil
+}
+
+// ブログに紐づくタグを取得
+func (r *tagRepository) FindTagsByBlogID(blogID uint) ([]domainTag.Tag, error) {
+	var tags []domainTag.Tag
+	if err := r.db.Table("TAGS").
+		Joins("JOIN post_tags ON post_tags.tag_id = TAGS.id").
+		Where("post_tags.blog_id = ?", blogID).
+		Order("TAGS.name").
+		Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to find tags by blog_id (blog_id=%d): %w", blogID, err)
+	}
+	return tags, nil
+}
+
+// 紐付け先のブログIDを含むタグ
+type blogTagRow struct {
+	BlogID uint
+	ID     uint
+	Name   string
+}
+
+// 複数のブログに紐づくタグをブログIDごとに取得
+// 各ブログのタグはFindTagsByBlogIDと同じ順に並ぶ
+func (r *tagRepository) FindTagsByBlogIDs(blogIDs []uint) (map[uint][]domainTag.Tag, error) {
+	tags := make(map[uint][]domainTag.Tag, len(blogIDs))
+	if len(blogIDs) == 0 {
+		return tags, nil
+	}
+	var rows []blogTagRow
+	if err := r.db.Table("TAGS").
+		Select("post_tags.blog_id, TAGS.id, TAGS.name").
+		Joins("JOIN post_tags ON post_tags.tag_id = TAGS.id").
+		Where("post_tags.blog_id IN ?", blogIDs).
+		Order("post_tags.blog_id, TAGS.name").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find tags by blog_ids (count=%d): %w", len(blogIDs), err)
+	}
+	for _, row := range rows {
+		tags[row.BlogID] = append(tags[row.BlogID], domainTag.Tag{ID: row.ID, Name: row.Name})
+	}
+	return tags, nil
+}
+
+// 前方一致するタグを使用件数の多い順に取得
+// 公開済みブログで未使用のタグも候補に含める
+func (r *tagRepository) FindTagsByPrefix(prefix string, limit int) ([]domainTag.TagCount, error) {
+	var rows []tagCountRow
+	if err := r.db.Table("TAGS").
+		Select("TAGS.id, TAGS.name, COUNT(BLOGS.id) AS count").
+		Joins("LEFT JOIN post_tags ON post_tags.tag_id = TAGS.id").
+		Joins("LEFT JOIN BLOGS ON BLOGS.id = post_tags.blog_id AND BLOGS.status = ? AND BLOGS.visibility = ? AND BLOGS.deleted_at IS NULL",
+			domainBlog.StatusPublished, domainBlog.VisibilityPublic).
+		Where("TAGS.name LIKE ?", escapeLike(prefix)+"%").
+		Group("TAGS.id, TAGS.name").
+		Order("count DESC, TAGS.name").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find tags by prefix (prefix=%s): %w", prefix, err)
+	}
+	return toTagCounts(rows), nil
+}
+
+// 公開済みブログでのタグの使用件数を多い順に集計
+func (r *tagRepository) CountTags(limit int) ([]domainTag.TagCount, error) {
+	var rows []tagCountRow
+	if err := r.db.Table("TAGS").
+		Select("TAGS.id, TAGS.name, COUNT(BLOGS.id) AS count").
+		Joins("JOIN post_tags ON post_tags.tag_id = TAGS.id").
+		Joins("JOIN BLOGS ON BLOGS.id = post_tags.blog_id").
+		Where("BLOGS.status = ? AND BLOGS.visibility = ? AND BLOGS.deleted_at IS NULL",
+			domainBlog.StatusPublished, domainBlog.VisibilityPublic).
+		Group("TAGS.id, TAGS.name").
+		Order("count DESC, TAGS.name").
+		Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count tags: %w", err)
+	}
+	return toTagCounts(rows), nil
+}
+
+// ブログのタグを指定したタグ名で置き換え
+// 存在しないタグは作成し、紐付けの置き換えは同一トランザクションで行う
+func (r *tagRepository) SetBlogTags(blogID uint, names []string) (tags []domainTag.Tag, err error) {
+	tx := r.db.Begin()
+	if tx.Error != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	if err = tx.Exec("DELETE FROM post_tags WHERE blog_id = ?", blogID).Error; err != nil {
+		return nil, fmt.Errorf("failed to delete post_tags (blog_id=%d): %w", blogID, err)
+	}
+
+	if len(names) > 0 {
+		// 同名のタグが既に存在する場合は作成しない
+		for _, name := range names {
+			if err = tx.Exec("INSERT IGNORE INTO TAGS (name) VALUES (?)", name).Error; err != nil {
+				return nil, fmt.Errorf("failed to create tag (name=%s): %w", name, err)
+			}
+		}
+		if err = tx.Table("TAGS").Where("name IN ?", names).Order("name").Find(&tags).Error; err != nil {
+			return nil, fmt.Errorf("failed to find tags (blog_id=%d): %w", blogID, err)
+		}
+		for _, tag := range tags {
+			if err = tx.Exec(
+				"INSERT INTO post_tags (tag_id, blog_id) VALUES (?, ?)",
+				tag.ID, blogID,
+			).Error; err != nil {
+				return nil, fmt.Errorf("failed to assign tag (tag_id=%d, blog_id=%d): %w", tag.ID, blogID, err)
+			}
+		}
+	}
+
+	if err = tx.Commit().Error; err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return tags, nil
+}
+
+func toTagCounts(rows []tagCountRow) []domainTag.TagCount {
+	counts := make([]domainTag.TagCount, len(rows))
+	for i, row := range rows {
+		counts[i] = domainTag.TagCount{
+			Tag:   domainTag.Tag{ID: row.ID, Name: row.Name},
+			Count: row.Count,
+		}
+	}
+	return counts
+}
@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	usecaseExport "github.com/kazukimurahashi12/webapp/usecase/export"
+	"go.uber.org/zap"
+)
+
+const (
+	// 作成待ちキューの長さ
+	ExportQueueSize = 64
+	// 作成待ちのジョブの再投入・期限切れのアーカイブの削除間隔のデフォルト値
+	defaultExportSweepInterval = 5 * time.Minute
+)
+
+// エクスポートのアーカイブを作成するワーカー
+// 作成は1件ずつ順に行い、期限切れのアーカイブを定期的に削除する
+type ExportWorker struct {
+	exportUseCase usecaseExport.UseCase
+	queue         *JobQueue
+	interval      time.Duration
+	logger        *zap.Logger
+}
+
+func NewExportWorker(exportUseCase usecaseExport.UseCase, queue *JobQueue, logger *zap.Logger) *ExportWorker {
+	// EXPORT_SWEEP_INTERVAL環境変数で間隔を変更可能（例: 10m）
+	interval := defaultExportSweepInterval
+	if v := os.Getenv("EXPORT_SWEEP_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			logger.Warn("Invalid EXPORT_SWEEP_INTERVAL, using default",
+				zap.String("value", v),
+				zap.Duration("default", defaultExportSweepInterval))
+		}
+	}
+
+	return &ExportWorker{
+		exportUseCase: exportUseCase,
+		queue:         queue,
+		interval:      interval,
+		logger:        logger,
+	}
+}
+
+// コンテキストがキャンセルされるまでアーカイブを作成する
+func (w *ExportWorker) Run(ctx context.Context) {
+	w.logger.Info("Export worker started", zap.Duration("interval", w.interval))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case id := <-w.queue.ch:
+				w.process(id)
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.sweep()
+		select {
+		case <-ctx.Done():
+			<-done
+			w.logger.Info("Export worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *ExportWorker) process(id uint) {
+	defer w.queue.done(id)
+	start := time.Now()
+	if err := w.exportUseCase.RunJob(id); err != nil {
+		if errors.Is(err, domainExport.ErrExportJobNotFound) {
+			return
+		}
+		w.logger.Error("Failed to create export archive", zap.Uint("jobID", id), zap.Error(err))
+		return
+	}
+	w.logger.Info("Processed export job",
+		zap.Uint("jobID", id),
+		zap.Duration("elapsed", time.Since(start)))
+}
+
+// 作成待ちのジョブの再投入と期限切れのアーカイブの削除
+func (w *ExportWorker) sweep() {
+	ids, err := w.exportUseCase.ExpireJobs(time.Now())
+	for _, id := range ids {
+		w.logger.Info("Expired export archive", zap.Uint("jobID", id))
+	}
+	if err != nil {
+		w.logger.Error("Failed to expire export archives", zap.Error(err))
+	}
+
+	pending, err := w.exportUseCase.PendingJobIDs(ExportQueueSize)
+	if err != nil {
+		w.logger.Error("Failed to find pending export jobs", zap.Error(err))
+		return
+	}
+	for _, id := range pending {
+		if !w.queue.Enqueue(id) {
+			return
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	usecaseAttachment "github.com/kazukimurahashi12/webapp/usecase/attachment"
+	"go.uber.org/zap"
+)
+
+const (
+	// 派生画像を並行して生成する数のデフォルト値
+	defaultImageWorkers = 2
+	// 生成待ちキューの長さ
+	ImageQueueSize = 256
+	// 生成待ちの画像の再投入間隔のデフォルト値
+	defaultImageSweepInterval = time.Minute
+)
+
+// 派生画像を生成するワーカー
+// 同時に生成する数を制限し、アップロードの応答を待たせずに生成する
+// サーバーの再起動等でキューから失われた生成待ちの画像は定期的に再投入する
+type ImageWorkerPool struct {
+	attachmentUseCase usecaseAttachment.UseCase
+	queue             *JobQueue
+	workers           int
+	interval          time.Duration
+	logger            *zap.Logger
+}
+
+func NewImageWorkerPool(attachmentUseCase usecaseAttachment.UseCase, queue *JobQueue, logger *zap.Logger) *ImageWorkerPool {
+	// IMAGE_WORKERS環境変数で並行数を変更可能
+	workers := defaultImageWorkers
+	if v := os.Getenv("IMAGE_WORKERS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			workers = n
+		} else {
+			logger.Warn("Invalid IMAGE_WORKERS, using default",
+				zap.String("value", v),
+				zap.Int("default", defaultImageWorkers))
+		}
+	}
+
+	// IMAGE_SWEEP_INTERVAL環境変数で再投入の間隔を変更可能（例: 5m）
+	interval := defaultImageSweepInterval
+	if v := os.Getenv("IMAGE_SWEEP_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			logger.Warn("Invalid IMAGE_SWEEP_INTERVAL, using default",
+				zap.String("value", v),
+				zap.Duration("default", defaultImageSweepInterval))
+		}
+	}
+
+	return &ImageWorkerPool{
+		attachmentUseCase: attachmentUseCase,
+		queue:             queue,
+		workers:           workers,
+		interval:          interval,
+		logger:            logger,
+	}
+}
+
+// コンテキストがキャンセルされるまで派生画像を生成する
+func (p *ImageWorkerPool) Run(ctx context.Context) {
+	p.logger.Info("Image worker pool started",
+		zap.Int("workers", p.workers),
+		zap.Duration("interval", p.interval))
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.work(ctx)
+		}()
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.enqueuePending()
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			p.logger.Info("Image worker pool stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *ImageWorkerPool) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case id := <-p.queue.ch:
+			p.process(id)
+		}
+	}
+}
+
+func (p *ImageWorkerPool) process(id uint) {
+	defer p.queue.done(id)
+	start := time.Now()
+	err := p.attachmentUseCase.ProcessImage(id)
+	switch {
+	case err == nil:
+		p.logger.Info("Processed image variants",
+			zap.Uint("attachmentID", id),
+			zap.Duration("elapsed", time.Since(start)))
+	case errors.Is(err, domainAttachment.ErrAttachmentNotFound):
+		// 生成前に削除された
+	case errors.Is(err, domainAttachment.ErrImageUnsupported), errors.Is(err, domainAttachment.ErrImageTooLarge):
+		p.logger.Warn("Image cannot be processed", zap.Uint("attachmentID", id), zap.Error(err))
+	default:
+		p.logger.Error("Failed to process image variants", zap.Uint("attachmentID", id), zap.Error(err))
+	}
+}
+
+// 生成待ちの画像をキューに再投入
+func (p *ImageWorkerPool) enqueuePending() {
+	ids, err := p.attachmentUseCase.PendingImageIDs(ImageQueueSize)
+	if err != nil {
+		p.logger.Error("Failed to find pending images", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		if !p.queue.Enqueue(id) {
+			return
+		}
+	}
+}
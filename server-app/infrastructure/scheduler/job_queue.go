@@ -0,0 +1,42 @@
+package scheduler
+
+import "sync"
+
+// バックグラウンドで処理する対象のIDのキュー
+// 同じIDが処理中・待機中の場合は重複して登録しない
+type JobQueue struct {
+	ch      chan uint
+	mu      sync.Mutex
+	pending map[uint]bool
+}
+
+func NewJobQueue(size int) *JobQueue {
+	return &JobQueue{
+		ch:      make(chan uint, size),
+		pending: map[uint]bool{},
+	}
+}
+
+// キューに登録
+// キューが満杯の場合は登録せず、定期的な再投入に任せる
+func (q *JobQueue) Enqueue(id uint) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.pending[id] {
+		return true
+	}
+	select {
+	case q.ch <- id:
+		q.pending[id] = true
+		return true
+	default:
+		return false
+	}
+}
+
+// 処理の完了を記録し、再び登録できるようにする
+func (q *JobQueue) done(id uint) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.pending, id)
+}
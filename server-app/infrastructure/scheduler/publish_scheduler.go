@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+// 予約投稿の公開チェック間隔のデフォルト値
+const defaultPublishInterval = time.Minute
+
+// 予約投稿を定期的に公開するスケジューラ
+// 公開処理はステータスを条件にした更新で行うため、複数のサーバーで同時に起動してもよい
+type PublishScheduler struct {
+	blogUseCase usecaseBlog.UseCase
+	interval    time.Duration
+	logger      *zap.Logger
+}
+
+func NewPublishScheduler(blogUseCase usecaseBlog.UseCase, logger *zap.Logger) *PublishScheduler {
+	// PUBLISH_SCHEDULER_INTERVAL環境変数でチェック間隔を変更可能（例: 30s）
+	interval := defaultPublishInterval
+	if v := os.Getenv("PUBLISH_SCHEDULER_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			logger.Warn("Invalid PUBLISH_SCHEDULER_INTERVAL, using default",
+				zap.String("value", v),
+				zap.Duration("default", defaultPublishInterval))
+		}
+	}
+
+	return &PublishScheduler{
+		blogUseCase: blogUseCase,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// コンテキストがキャンセルされるまで予約投稿の公開を繰り返す
+func (s *PublishScheduler) Run(ctx context.Context) {
+	s.logger.Info("Publish scheduler started", zap.Duration("interval", s.interval))
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.publishDue()
+		select {
+		case <-ctx.Done():
+			s.logger.Info("Publish scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 公開日時を過ぎた予約投稿を公開
+func (s *PublishScheduler) publishDue() {
+	blogs, err := s.blogUseCase.PublishDueBlogs(time.Now())
+	for _, blog := range blogs {
+		s.logger.Info("Published scheduled blog",
+			zap.Uint("blogID", blog.ID),
+			zap.Uint("authorID", blog.AuthorID))
+	}
+	if err != nil {
+		s.logger.Error("Failed to publish scheduled blogs", zap.Error(err))
+	}
+}
@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+// ゴミ箱の完全削除チェック間隔のデフォルト値
+const defaultTrashPurgeInterval = time.Hour
+
+// 保持期間を過ぎたゴミ箱内のブログを定期的に完全削除するジョブ
+// 削除はゴミ箱内にあることを条件に行うため、複数のサーバーで同時に起動してもよい
+type TrashPurger struct {
+	blogUseCase usecaseBlog.UseCase
+	interval    time.Duration
+	logger      *zap.Logger
+}
+
+func NewTrashPurger(blogUseCase usecaseBlog.UseCase, logger *zap.Logger) *TrashPurger {
+	// TRASH_PURGE_INTERVAL環境変数でチェック間隔を変更可能（例: 30m）
+	interval := defaultTrashPurgeInterval
+	if v := os.Getenv("TRASH_PURGE_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			logger.Warn("Invalid TRASH_PURGE_INTERVAL, using default",
+				zap.String("value", v),
+				zap.Duration("default", defaultTrashPurgeInterval))
+		}
+	}
+
+	return &TrashPurger{
+		blogUseCase: blogUseCase,
+		interval:    interval,
+		logger:      logger,
+	}
+}
+
+// コンテキストがキャンセルされるまでゴミ箱の完全削除を繰り返す
+func (p *TrashPurger) Run(ctx context.Context) {
+	p.logger.Info("Trash purger started", zap.Duration("interval", p.interval))
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purgeExpired()
+		select {
+		case <-ctx.Done():
+			p.logger.Info("Trash purger stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 保持期間を過ぎたゴミ箱内のブログを完全削除
+func (p *TrashPurger) purgeExpired() {
+	ids, err := p.blogUseCase.PurgeExpiredBlogs(time.Now())
+	for _, id := range ids {
+		p.logger.Info("Purged trashed blog", zap.Uint("blogID", id))
+	}
+	if err != nil {
+		p.logger.Error("Failed to purge trashed blogs", zap.Error(err))
+	}
+}
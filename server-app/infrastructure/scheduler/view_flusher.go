@@ -0,0 +1,71 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	usecaseStats "github.com/kazukimurahashi12/webapp/usecase/stats"
+	"go.uber.org/zap"
+)
+
+// 閲覧数の書き込み間隔のデフォルト値
+const defaultViewFlushInterval = time.Minute
+
+// Redisで集計中の閲覧数を定期的にDBへ書き込むジョブ
+// 書き込み待ちの識別子はRedisから取り出して処理するため、複数のサーバーで同時に起動してもよい
+type ViewFlusher struct {
+	statsUseCase usecaseStats.UseCase
+	interval     time.Duration
+	logger       *zap.Logger
+}
+
+func NewViewFlusher(statsUseCase usecaseStats.UseCase, logger *zap.Logger) *ViewFlusher {
+	// VIEW_FLUSH_INTERVAL環境変数で書き込み間隔を変更可能（例: 5m）
+	interval := defaultViewFlushInterval
+	if v := os.Getenv("VIEW_FLUSH_INTERVAL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			interval = d
+		} else {
+			logger.Warn("Invalid VIEW_FLUSH_INTERVAL, using default",
+				zap.String("value", v),
+				zap.Duration("default", defaultViewFlushInterval))
+		}
+	}
+
+	return &ViewFlusher{
+		statsUseCase: statsUseCase,
+		interval:     interval,
+		logger:       logger,
+	}
+}
+
+// コンテキストがキャンセルされるまで閲覧数の書き込みを繰り返す
+// 停止時にも書き込みを行い、集計中の値を可能な限り反映する
+func (f *ViewFlusher) Run(ctx context.Context) {
+	f.logger.Info("View flusher started", zap.Duration("interval", f.interval))
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.flush()
+			f.logger.Info("View flusher stopped")
+			return
+		case <-ticker.C:
+			f.flush()
+		}
+	}
+}
+
+// 集計中の閲覧数をDBへ書き込む
+func (f *ViewFlusher) flush() {
+	n, err := f.statsUseCase.FlushViews()
+	if n > 0 {
+		f.logger.Debug("Flushed daily views", zap.Int("rows", n))
+	}
+	if err != nil {
+		f.logger.Error("Failed to flush daily views", zap.Error(err))
+	}
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+)
+
+// ローカルファイルシステムにエクスポートのアーカイブを保存するストレージ
+type localArchiveStorage struct {
+	root string
+}
+
+func NewLocalArchiveStorage(root string) (domainExport.ArchiveStorage, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create archive directory (root=%s): %w", root, err)
+	}
+	return &localArchiveStorage{root: root}, nil
+}
+
+// 一時ファイルへ書き込み、Close時にリネームする
+func (s *localArchiveStorage) Create(name string) (io.WriteCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(s.root, ".export-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary archive (name=%s): %w", name, err)
+	}
+	return &archiveWriter{File: tmp, path: path}, nil
+}
+
+func (s *localArchiveStorage) Open(name string) (io.ReadCloser, error) {
+	path, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domainExport.ErrArchiveNotFound
+		}
+		return nil, fmt.Errorf("failed to open archive (name=%s): %w", name, err)
+	}
+	return f, nil
+}
+
+func (s *localArchiveStorage) Delete(name string) error {
+	path, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete archive (name=%s): %w", name, err)
+	}
+	return nil
+}
+
+// 保存名に対応するファイルパス
+func (s *localArchiveStorage) path(name string) (string, error) {
+	if err := domainExport.ValidateArchiveName(name); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, name), nil
+}
+
+// 書き込み中のアーカイブ
+type archiveWriter struct {
+	*os.File
+	path string
+}
+
+func (w *archiveWriter) Close() error {
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	if err := os.Rename(w.File.Name(), w.path); err != nil {
+		os.Remove(w.File.Name())
+		return fmt.Errorf("failed to store archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+)
+
+// ローカルファイルシステムにファイルの実体を保存するストレージ
+type localStorage struct {
+	root string
+}
+
+func NewLocalStorage(root string) (domainAttachment.BlobStorage, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory (root=%s): %w", root, err)
+	}
+	return &localStorage{root: root}, nil
+}
+
+// 一時ファイルへ書き込んだ後にリネームし、書き込み途中の実体を読み出させない
+func (s *localStorage) Put(key string, content io.Reader) (err error) {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create blob directory (key=%s): %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary blob (key=%s): %w", key, err)
+	}
+	defer func() {
+		if err != nil {
+			tmp.Close()
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = io.Copy(tmp, content); err != nil {
+		return fmt.Errorf("failed to write blob (key=%s): %w", key, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close blob (key=%s): %w", key, err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store blob (key=%s): %w", key, err)
+	}
+	return nil
+}
+
+func (s *localStorage) Open(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domainAttachment.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob (key=%s): %w", key, err)
+	}
+	return f, nil
+}
+
+func (s *localStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob (key=%s): %w", key, err)
+	}
+	return nil
+}
+
+func (s *localStorage) Exists(key string) (bool, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to stat blob (key=%s): %w", key, err)
+	}
+	return true, nil
+}
+
+// 保存キーに対応するファイルパス
+func (s *localStorage) path(key string) (string, error) {
+	if err := domainAttachment.ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
@@ -0,0 +1,288 @@
+package attachment
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseAttachment "github.com/kazukimurahashi12/webapp/usecase/attachment"
+	"go.uber.org/zap"
+)
+
+// multipartの区切り・ヘッダー等のファイル以外に許容するリクエストサイズ
+const multipartOverhead = 1 << 20
+
+type AttachmentController struct {
+	attachmentUseCase usecaseAttachment.UseCase
+	sessionManager    session.SessionManager
+	logger            *zap.Logger
+	maxSize           int64
+}
+
+func NewAttachmentController(attachmentUseCase usecaseAttachment.UseCase, sessionManager session.SessionManager, logger *zap.Logger, maxSize int64) *AttachmentController {
+	return &AttachmentController{
+		attachmentUseCase: attachmentUseCase,
+		sessionManager:    sessionManager,
+		logger:            logger,
+		maxSize:           maxSize,
+	}
+}
+
+// ファイルのアップロード
+// multipart/form-dataのfileにファイル、blogIdに紐づけるブログID（任意）を指定する
+func (a *AttachmentController) Upload(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+
+	// 上限を超えるリクエストは読み込み途中で打ち切る
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, a.maxSize+multipartOverhead)
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			a.respondError(c, requestID, domainAttachment.ErrAttachmentTooLarge, "", "")
+			return
+		}
+		a.logger.Error("Failed to read multipart file",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "アップロードするファイルを指定してください",
+			"code":       "INVALID_UPLOAD_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+	defer file.Close()
+
+	upload := domainAttachment.Upload{
+		OwnerID:  userID,
+		Filename: header.Filename,
+	}
+	if v := c.Request.FormValue("blogId"); v != "" {
+		blogID, err := strconv.ParseUint(v, 10, 64)
+		if err != nil || blogID == 0 {
+			a.logger.Error("Invalid blog ID format",
+				zap.String("requestID", requestID),
+				zap.String("blogId", v))
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "ブログIDの形式が不正です",
+				"code":       "INVALID_BLOG_ID",
+				"request_id": requestID,
+			})
+			return
+		}
+		id := uint(blogID)
+		upload.BlogID = &id
+	}
+
+	// 上限を1バイト超えて読み込み、超過をUseCaseで判定する
+	upload.Content, err = io.ReadAll(io.LimitReader(file, a.maxSize+1))
+	if err != nil {
+		a.respondError(c, requestID, err, "ファイルの読み込みに失敗しました", "UPLOAD_READ_FAILED")
+		return
+	}
+
+	// アップロードUseCase
+	attachment, err := a.attachmentUseCase.Upload(upload)
+	if err != nil {
+		a.respondError(c, requestID, err, "ファイルのアップロードに失敗しました", "UPLOAD_FAILED")
+		return
+	}
+
+	a.logger.Info("Successfully uploaded attachment",
+		zap.String("requestID", requestID),
+		zap.Uint("attachmentID", attachment.ID),
+		zap.String("contentType", attachment.ContentType),
+		zap.Int64("size", attachment.Size))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ファイルをアップロードしました",
+		"code":       "ATTACHMENT_UPLOADED",
+		"request_id": requestID,
+		"attachment": mapper.ToAttachmentResponse(attachment),
+	})
+}
+
+// ログインユーザーの添付ファイル一覧取得
+func (a *AttachmentController) ListAttachments(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+
+	// 添付ファイル一覧取得UseCase
+	attachments, err := a.attachmentUseCase.ListAttachments(userID)
+	if err != nil {
+		a.respondError(c, requestID, err, "添付ファイル一覧の取得に失敗しました", "ATTACHMENT_LIST_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "添付ファイル一覧を取得しました",
+		"code":        "ATTACHMENTS_FETCHED",
+		"request_id":  requestID,
+		"attachments": mapper.ToAttachmentsResponse(attachments),
+	})
+}
+
+// ブログに紐づく添付ファイル一覧取得
+func (a *AttachmentController) ListBlogAttachments(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+	blogID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		a.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", c.Param("id")))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// ブログの添付ファイル一覧取得UseCase
+	attachments, err := a.attachmentUseCase.ListBlogAttachments(userID, uint(blogID))
+	if err != nil {
+		a.respondError(c, requestID, err, "添付ファイル一覧の取得に失敗しました", "ATTACHMENT_LIST_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "添付ファイル一覧を取得しました",
+		"code":        "ATTACHMENTS_FETCHED",
+		"request_id":  requestID,
+		"attachments": mapper.ToAttachmentsResponse(attachments),
+	})
+}
+
+// 添付ファイルの削除
+func (a *AttachmentController) DeleteAttachment(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		a.logger.Error("Invalid attachment ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", c.Param("id")))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "添付ファイルIDの形式が不正です",
+			"code":       "INVALID_ATTACHMENT_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 添付ファイル削除UseCase
+	if err := a.attachmentUseCase.DeleteAttachment(userID, uint(id)); err != nil {
+		a.respondError(c, requestID, err, "添付ファイルの削除に失敗しました", "ATTACHMENT_DELETE_FAILED")
+		return
+	}
+
+	a.logger.Info("Successfully deleted attachment",
+		zap.String("requestID", requestID),
+		zap.Uint64("attachmentID", id))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "添付ファイルを削除しました",
+		"code":       "ATTACHMENT_DELETED",
+		"request_id": requestID,
+	})
+}
+
+// ファイルの配信
+// ログインを必須とせず、閲覧できるブログの添付ファイルのみ返す
+// 保存キーは内容のハッシュ値で内容が変わらないため、長期間のキャッシュを許可する
+// 未ログインの閲覧者に公開していないファイルは共有キャッシュに保存させない
+func (a *AttachmentController) GetFile(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	key := c.Param("shard") + "/" + c.Param("name")
+	attachment, content, public, err := a.attachmentUseCase.OpenFile(common.OptionalLoginUserID(c, a.sessionManager), key)
+	if err != nil {
+		a.respondError(c, requestID, err, "ファイルの取得に失敗しました", "FILE_FETCH_FAILED")
+		return
+	}
+	defer content.Close()
+
+	// 画像以外はブラウザで開かずダウンロードさせる
+	disposition := "attachment"
+	if attachment.IsImage() {
+		disposition = "inline"
+	}
+	cacheControl := "private, max-age=31536000, immutable"
+	if public {
+		cacheControl = "public, max-age=31536000, immutable"
+	}
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename}),
+		"Cache-Control":          cacheControl,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (a *AttachmentController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainAttachment.ErrAttachmentTooLarge):
+		status, message, code = http.StatusRequestEntityTooLarge, "ファイルサイズが上限を超えています", "ATTACHMENT_TOO_LARGE"
+	case errors.Is(err, domainAttachment.ErrAttachmentEmpty):
+		status, message, code = http.StatusBadRequest, "空のファイルはアップロードできません", "ATTACHMENT_EMPTY"
+	case errors.Is(err, domainAttachment.ErrContentTypeNotAllowed):
+		status, message, code = http.StatusUnsupportedMediaType, "アップロードできないファイル形式です", "ATTACHMENT_TYPE_NOT_ALLOWED"
+	case errors.Is(err, domainAttachment.ErrAttachmentNotFound),
+		errors.Is(err, domainAttachment.ErrStorageKeyInvalid),
+		errors.Is(err, domainAttachment.ErrBlobNotFound):
+		status, message, code = http.StatusNotFound, "指定された添付ファイルが存在しません", "ATTACHMENT_NOT_FOUND"
+	case errors.Is(err, domainAttachment.ErrAttachmentUnauthorized):
+		status, message, code = http.StatusForbidden, "この添付ファイルを操作する権限がありません", "ATTACHMENT_ACCESS_DENIED"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を操作する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	a.logger.Error("Attachment request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,506 @@
+package attachment
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/attachment"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	attachmentMocks "github.com/kazukimurahashi12/webapp/usecase/attachment/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+// multipart/form-dataのリクエストを生成
+func newUploadRequest(t *testing.T, filename string, content []byte, fields map[string]string) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range fields {
+		assert.NoError(t, writer.WriteField(k, v))
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/attachment/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestAttachmentController_Upload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pngHeader := []byte("\x89PNG\r\n\x1a\n0000")
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "photo.png", pngHeader, map[string]string{"blogId": "10"})
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		blogID := uint(10)
+		mockAttachmentUseCase.EXPECT().
+			Upload(attachment.Upload{OwnerID: 123, BlogID: &blogID, Filename: "photo.png", Content: pngHeader}).
+			Return(&attachment.Attachment{
+				ID:          1,
+				OwnerID:     123,
+				BlogID:      &blogID,
+				StorageKey:  "ab/" + strings.Repeat("ab", 32) + ".png",
+				Filename:    "photo.png",
+				ContentType: "image/png",
+				Size:        int64(len(pngHeader)),
+				CreatedAt:   time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Attachment struct {
+				ID  uint   `json:"id"`
+				URL string `json:"url"`
+			} `json:"attachment"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(1), response.Attachment.ID)
+			assert.Equal(t, "/files/ab/"+strings.Repeat("ab", 32)+".png", response.Attachment.URL)
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		// multipartの付加分を含めても上限を超えるサイズ
+		ctx.Request = newUploadRequest(t, "large.png", bytes.Repeat([]byte("a"), 1024+multipartOverhead), nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+	})
+
+	t.Run("ContentTypeNotAllowed", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "page.html", []byte("<html><script></script></html>"), nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockAttachmentUseCase.EXPECT().
+			Upload(gomock.Any()).
+			Return(nil, attachment.ErrContentTypeNotAllowed)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+	})
+
+	t.Run("MissingFile", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/attachment/upload", strings.NewReader("{}"))
+		ctx.Request.Header.Set("Content-Type", "application/json")
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestAttachmentController_ListAttachments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("WithVariants", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/attachment/list", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		thumbKey := "aa/" + strings.Repeat("aa", 32) + ".jpg"
+		mediumKey := "bb/" + strings.Repeat("bb", 32) + ".jpg"
+		mockAttachmentUseCase.EXPECT().
+			ListAttachments(uint(123)).
+			Return([]attachment.Attachment{
+				{
+					ID:          1,
+					OwnerID:     123,
+					StorageKey:  "ab/" + strings.Repeat("ab", 32) + ".jpg",
+					Filename:    "photo.jpg",
+					ContentType: "image/jpeg",
+					Width:       1200,
+					Height:      800,
+					Processing:  attachment.ProcessingReady,
+					Variants: []attachment.Variant{
+						{AttachmentID: 1, Name: "thumbnail", Format: "jpeg", ContentType: "image/jpeg", Width: 320, Height: 320, StorageKey: thumbKey},
+						{AttachmentID: 1, Name: "medium", Format: "jpeg", ContentType: "image/jpeg", Width: 960, Height: 640, StorageKey: mediumKey},
+					},
+					CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+				},
+				{
+					ID:          2,
+					OwnerID:     123,
+					StorageKey:  "cd/" + strings.Repeat("cd", 32) + ".pdf",
+					Filename:    "doc.pdf",
+					ContentType: "application/pdf",
+					Processing:  attachment.ProcessingNone,
+					CreatedAt:   time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+				},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.ListAttachments(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Attachments []struct {
+				ID         uint   `json:"id"`
+				Width      int    `json:"width"`
+				Processing string `json:"processing"`
+				Variants   []struct {
+					Name string `json:"name"`
+					URL  string `json:"url"`
+				} `json:"variants"`
+				Srcset map[string]string `json:"srcset"`
+			} `json:"attachments"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Attachments, 2) {
+			image := response.Attachments[0]
+			assert.Equal(t, 1200, image.Width)
+			assert.Equal(t, "ready", image.Processing)
+			if assert.Len(t, image.Variants, 2) {
+				assert.Equal(t, "thumbnail", image.Variants[0].Name)
+				assert.Equal(t, "/files/"+thumbKey, image.Variants[0].URL)
+			}
+			assert.Equal(t, "/files/"+thumbKey+" 320w, /files/"+mediumKey+" 960w", image.Srcset["image/jpeg"])
+
+			assert.Equal(t, "none", response.Attachments[1].Processing)
+			assert.Empty(t, response.Attachments[1].Variants)
+			assert.Empty(t, response.Attachments[1].Srcset)
+		}
+	})
+}
+
+func TestAttachmentController_ListBlogAttachments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Collaborator", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/attachment/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		blogID := uint(10)
+		mockAttachmentUseCase.EXPECT().
+			ListBlogAttachments(uint(456), blogID).
+			Return([]attachment.Attachment{
+				{
+					ID:          1,
+					OwnerID:     123,
+					BlogID:      &blogID,
+					StorageKey:  "ab/" + strings.Repeat("ab", 32) + ".pdf",
+					Filename:    "doc.pdf",
+					ContentType: "application/pdf",
+					Processing:  attachment.ProcessingNone,
+				},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.ListBlogAttachments(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Attachments []struct {
+				ID uint `json:"id"`
+			} `json:"attachments"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Attachments, 1) {
+			assert.Equal(t, uint(1), response.Attachments[0].ID)
+		}
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/attachment/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "789")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockAttachmentUseCase.EXPECT().
+			ListBlogAttachments(uint(789), uint(10)).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.ListBlogAttachments(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestAttachmentController_DeleteAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/attachment/delete/1", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockAttachmentUseCase.EXPECT().
+			DeleteAttachment(uint(123), uint(1)).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.DeleteAttachment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/attachment/delete/1", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockAttachmentUseCase.EXPECT().
+			DeleteAttachment(uint(456), uint(1)).
+			Return(attachment.ErrAttachmentUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.DeleteAttachment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestAttachmentController_GetFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key := "ab/" + strings.Repeat("ab", 32) + ".pdf"
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/files/"+key, nil)
+		ctx.Params = gin.Params{{Key: "shard", Value: "ab"}, {Key: "name", Value: strings.Repeat("ab", 32) + ".pdf"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		content := []byte("%PDF-1.4")
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("123", nil)
+		mockAttachmentUseCase.EXPECT().
+			OpenFile(uint(123), key).
+			Return(&attachment.Attachment{
+				StorageKey:  key,
+				Filename:    "資料.pdf",
+				ContentType: "application/pdf",
+				Size:        int64(len(content)),
+			}, io.NopCloser(bytes.NewReader(content)), false, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.GetFile(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+		assert.True(t, strings.HasPrefix(recorder.Header().Get("Content-Disposition"), "attachment;"))
+		assert.Equal(t, "%PDF-1.4", recorder.Body.String())
+		// 未ログインの閲覧者に公開していないファイルは共有キャッシュに保存させない
+		assert.True(t, strings.HasPrefix(recorder.Header().Get("Cache-Control"), "private,"))
+	})
+
+	t.Run("PublicFile", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/files/"+key, nil)
+		ctx.Params = gin.Params{{Key: "shard", Value: "ab"}, {Key: "name", Value: strings.Repeat("ab", 32) + ".pdf"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		content := []byte("%PDF-1.4")
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockAttachmentUseCase.EXPECT().
+			OpenFile(uint(0), key).
+			Return(&attachment.Attachment{
+				StorageKey:  key,
+				Filename:    "資料.pdf",
+				ContentType: "application/pdf",
+				Size:        int64(len(content)),
+			}, io.NopCloser(bytes.NewReader(content)), true, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.GetFile(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "public, max-age=31536000, immutable", recorder.Header().Get("Cache-Control"))
+	})
+
+	t.Run("NotViewable", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/files/"+key, nil)
+		ctx.Params = gin.Params{{Key: "shard", Value: "ab"}, {Key: "name", Value: strings.Repeat("ab", 32) + ".pdf"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockAttachmentUseCase.EXPECT().
+			OpenFile(uint(0), key).
+			Return(nil, nil, false, attachment.ErrAttachmentNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.GetFile(ctx)
+
+		// 検証（閲覧できないファイルは存在しない場合と区別しない）
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("InvalidKey", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/files/../secret", nil)
+		ctx.Params = gin.Params{{Key: "shard", Value: ".."}, {Key: "name", Value: "secret"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockAttachmentUseCase := attachmentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockAttachmentUseCase.EXPECT().
+			OpenFile(uint(0), "../secret").
+			Return(nil, nil, false, attachment.ErrStorageKeyInvalid)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAttachmentController(mockAttachmentUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.GetFile(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
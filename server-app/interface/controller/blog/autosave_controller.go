@@ -0,0 +1,152 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+type AutosaveController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewAutosaveController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *AutosaveController {
+	return &AutosaveController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// 編集中のブログ記事の自動保存
+// ブログIDを省略した場合は新規作成中の下書きとして保存する
+func (a *AutosaveController) SaveAutosave(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.AutosaveRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("Failed to bind JSON in autosave",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "自動保存データの形式が不正です",
+			"code":       "INVALID_AUTOSAVE_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	autosave, err := domainBlog.NewAutosave(userID, req.BlogID, req.Title, req.Content, req.BaseVersion)
+	if err != nil {
+		a.respondError(c, requestID, err, "自動保存に失敗しました", "AUTOSAVE_FAILED")
+		return
+	}
+
+	// 自動保存UseCase
+	saved, err := a.blogUseCase.SaveAutosave(autosave)
+	if err != nil {
+		a.respondError(c, requestID, err, "自動保存に失敗しました", "AUTOSAVE_FAILED")
+		return
+	}
+
+	// 頻繁に呼び出されるため本文はログに出力しない
+	a.logger.Debug("Successfully autosaved blog",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", saved.BlogID),
+		zap.Uint("baseVersion", saved.BaseVersion))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "編集中の内容を自動保存しました",
+		"code":       "AUTOSAVED",
+		"request_id": requestID,
+		"autosave":   mapper.ToAutosaveResponse(saved),
+	})
+}
+
+// 自動保存した内容を保存済みのブログ記事との差分と合わせて取得
+// ブログIDに0を指定した場合は新規作成中の下書きを取得する
+func (a *AutosaveController) GetAutosave(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, a.logger)
+	if !ok {
+		return
+	}
+
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		a.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 自動保存取得UseCase
+	comparison, err := a.blogUseCase.GetAutosave(userID, uint(blogID))
+	if err != nil {
+		a.respondError(c, requestID, err, "自動保存した内容の取得に失敗しました", "AUTOSAVE_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "自動保存した内容を取得しました",
+		"code":       "AUTOSAVE_FETCHED",
+		"request_id": requestID,
+		"result":     mapper.ToAutosaveComparisonResponse(comparison),
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (a *AutosaveController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainBlog.ErrAutosaveInvalid):
+		status, message, code = http.StatusBadRequest, "自動保存する内容が不正です", "INVALID_AUTOSAVE"
+	case errors.Is(err, domainBlog.ErrAutosaveNotFound):
+		status, message, code = http.StatusNotFound, "自動保存した内容が存在しません", "AUTOSAVE_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrAutosaveUnavailable):
+		status, message, code = http.StatusServiceUnavailable, "自動保存は現在利用できません", "AUTOSAVE_UNAVAILABLE"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "編集権限がありません", "EDIT_PERMISSION_DENIED"
+	}
+
+	a.logger.Error("Autosave request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
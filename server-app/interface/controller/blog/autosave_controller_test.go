@@ -0,0 +1,281 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestAutosaveController_SaveAutosave(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newContext := func(body string) (*gin.Context, *httptest.ResponseRecorder) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/blog/autosave", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+		return ctx, recorder
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, recorder := newContext(`{"blogId":10,"title":"Draft","content":"line1\nline2"}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		// 編集元のバージョンを省略した場合はUseCaseで現在のバージョンを補う
+		mockBlogUseCase.EXPECT().
+			SaveAutosave(&blog.Autosave{UserID: 123, BlogID: 10, Title: "Draft", Content: "line1\nline2"}).
+			DoAndReturn(func(a *blog.Autosave) (*blog.Autosave, error) {
+				saved := *a
+				saved.BaseVersion = 4
+				saved.SavedAt = time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+				saved.ExpiresAt = saved.SavedAt.Add(blog.DefaultAutosaveTTL)
+				return &saved, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SaveAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code     string `json:"code"`
+			Autosave struct {
+				BlogID      uint      `json:"blogId"`
+				BaseVersion uint      `json:"baseVersion"`
+				ExpiresAt   time.Time `json:"expiresAt"`
+			} `json:"autosave"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "AUTOSAVED", response.Code)
+			assert.Equal(t, uint(10), response.Autosave.BlogID)
+			assert.Equal(t, uint(4), response.Autosave.BaseVersion)
+			assert.Equal(t, time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC), response.Autosave.ExpiresAt.UTC())
+		}
+	})
+
+	t.Run("TitleTooLong", func(t *testing.T) {
+		ctx, recorder := newContext(`{"title":"` + strings.Repeat("a", blog.MaxAutosaveTitleLength+1) + `"}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SaveAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_AUTOSAVE")
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		ctx, recorder := newContext(`{"blogId":10,"title":"Draft"}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			SaveAutosave(gomock.Any()).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SaveAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "EDIT_PERMISSION_DENIED")
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		ctx, recorder := newContext(`{"title":"Draft"}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			SaveAutosave(gomock.Any()).
+			Return(nil, blog.ErrAutosaveUnavailable)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SaveAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "AUTOSAVE_UNAVAILABLE")
+	})
+}
+
+func TestAutosaveController_GetAutosave(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newContext := func(id string) (*gin.Context, *httptest.ResponseRecorder) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/autosave/"+id, nil)
+		ctx.Params = gin.Params{{Key: "id", Value: id}}
+		ctx.Set("userID", "123")
+		return ctx, recorder
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, recorder := newContext("10")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		// 自動保存の後に別の編集者が保存したため編集元より新しい
+		saved := &blog.Blog{ID: 10, AuthorID: 123, Title: "Title", Content: "line1\nline2", Version: 5}
+		autosave := &blog.Autosave{UserID: 123, BlogID: 10, Title: "Title", Content: "line1\nchanged", BaseVersion: 4}
+		mockBlogUseCase.EXPECT().
+			GetAutosave(uint(123), uint(10)).
+			Return(blog.CompareAutosave(autosave, saved), nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code   string `json:"code"`
+			Result struct {
+				Changed  bool `json:"changed"`
+				Outdated bool `json:"outdated"`
+				Lines    []struct {
+					Op   string `json:"op"`
+					Text string `json:"text"`
+				} `json:"lines"`
+			} `json:"result"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "AUTOSAVE_FETCHED", response.Code)
+			assert.True(t, response.Result.Changed)
+			assert.True(t, response.Result.Outdated)
+			if assert.NotEmpty(t, response.Result.Lines) {
+				assert.Equal(t, blog.DiffEqual, response.Result.Lines[0].Op)
+				assert.Equal(t, "line1", response.Result.Lines[0].Text)
+			}
+		}
+	})
+
+	t.Run("NewPostDraft", func(t *testing.T) {
+		ctx, recorder := newContext("0")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		autosave := &blog.Autosave{UserID: 123, Title: "New", Content: "body"}
+		mockBlogUseCase.EXPECT().
+			GetAutosave(uint(123), uint(0)).
+			Return(blog.CompareAutosave(autosave, nil), nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), `"blog":`)
+		assert.Contains(t, recorder.Body.String(), `"outdated":false`)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		ctx, recorder := newContext("10")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetAutosave(uint(123), uint(10)).
+			Return(nil, blog.ErrAutosaveNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "AUTOSAVE_NOT_FOUND")
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		ctx, recorder := newContext("10")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetAutosave(uint(123), uint(10)).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "EDIT_PERMISSION_DENIED")
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		ctx, recorder := newContext("abc")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewAutosaveController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAutosave(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_BLOG_ID")
+	})
+}
@@ -1,30 +1,36 @@
 package blog
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
 	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
 	"github.com/kazukimurahashi12/webapp/interface/dto"
 	"github.com/kazukimurahashi12/webapp/interface/mapper"
 	"github.com/kazukimurahashi12/webapp/interface/session"
 	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	usecaseSeries "github.com/kazukimurahashi12/webapp/usecase/series"
 
 	"go.uber.org/zap"
 )
 
 type BlogController struct {
 	blogUseCase    usecaseBlog.UseCase
+	seriesUseCase  usecaseSeries.UseCase
 	sessionManager session.SessionManager
 	logger         *zap.Logger
 }
 
-func NewBlogController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *BlogController {
+func NewBlogController(blogUseCase usecaseBlog.UseCase, seriesUseCase usecaseSeries.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *BlogController {
 	return &BlogController{
 		blogUseCase:    blogUseCase,
+		seriesUseCase:  seriesUseCase,
 		sessionManager: sessionManager,
 		logger:         logger,
 	}
@@ -36,27 +42,8 @@ func (b *BlogController) PostBlog(c *gin.Context) {
 	ctx := c.Request.Context()
 	requestID := middleware.GetRequestID(ctx)
 
-	// セッションによるログイン認証はroutes.go_isAuthenticated共通実施しコンテクストから取得
-	userID, exists := c.Get("userID")
-	if !exists {
-		b.logger.Error("userID not found in context",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが取得できませんでした",
-			"code":       "USER_ID_NOT_FOUND",
-			"request_id": requestID,
-		})
-		return
-	}
-	userIDStr, ok := userID.(string)
+	authorID, ok := common.GetLoginUserID(c, b.logger)
 	if !ok {
-		b.logger.Error("userID is not a string",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが正しい型ではありません",
-			"code":       "USER_ID_TYPE_ERROR",
-			"request_id": requestID,
-		})
 		return
 	}
 
@@ -70,27 +57,11 @@ func (b *BlogController) PostBlog(c *gin.Context) {
 		})
 		return
 	}
-	// 文字列のuserIDをuintに変換
-	authorID, err := strconv.ParseUint(userIDStr, 10, 64)
-	if err != nil {
-		b.logger.Error("Failed to parse userID",
-			zap.String("userID", userIDStr),
-			zap.Error(err),
-			zap.String("requestID", requestID),
-		)
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":      "ユーザーIDの形式が不正です",
-			"code":       "INVALID_USER_ID_FORMAT",
-			"request_id": requestID,
-		})
-		return
-	}
-
 	// 著者IDからブログを取得
-	blog, err := b.blogUseCase.FindBlogByAuthorID(uint(authorID))
+	blog, err := b.blogUseCase.FindBlogByAuthorID(authorID)
 	if err != nil {
 		b.logger.Error("Failed to find blog by authorID",
-			zap.Uint("authorID", uint(authorID)),
+			zap.Uint("authorID", authorID),
 			zap.Error(err),
 			zap.String("requestID", requestID),
 		)
@@ -113,17 +84,44 @@ func (b *BlogController) PostBlog(c *gin.Context) {
 		return
 	}
 
+	if req.Status != "" {
+		entityBlog.Status = req.Status
+	}
+	entityBlog.Slug = req.Slug
+	entityBlog.Visibility = req.Visibility
+
 	// ブログ記事登録処理UseCase
 	createdBlog, err := b.blogUseCase.NewCreateBlog(entityBlog)
 	if err != nil {
 		b.logger.Error("Failed to create blog", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "ブログ記事の登録に失敗しました",
-			"code":  "BLOG_CREATION_FAILED",
-		})
+		switch {
+		case errors.Is(err, domainBlog.ErrSlugInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "スラッグの形式が不正です",
+				"code":  "INVALID_BLOG_SLUG",
+			})
+		case errors.Is(err, domainBlog.ErrSlugAlreadyExists):
+			c.JSON(http.StatusConflict, gin.H{
+				"error": "スラッグは既に使用されています",
+				"code":  "BLOG_SLUG_CONFLICT",
+			})
+		case errors.Is(err, domainBlog.ErrVisibilityInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "公開範囲の指定が不正です",
+				"code":  "INVALID_BLOG_VISIBILITY",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "ブログ記事の登録に失敗しました",
+				"code":  "BLOG_CREATION_FAILED",
+			})
+		}
 		return
 	}
 
+	// 登録が確定したため新規作成中の下書きの自動保存を破棄
+	b.clearAutosave(requestID, authorID, 0)
+
 	// DTOに変換してレスポンス
 	response := mapper.ToBlogCreatedResponse(createdBlog)
 
@@ -141,27 +139,8 @@ func (b *BlogController) GetBlogView(c *gin.Context) {
 	ctx := c.Request.Context()
 	requestID := middleware.GetRequestID(ctx)
 
-	// セッションによるログイン認証はroutes.go_isAuthenticated共通実施しコンテクストから取得
-	userID, exists := c.Get("userID")
-	if !exists {
-		b.logger.Error("userID not found in context",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが取得できませんでした",
-			"code":       "USER_ID_NOT_FOUND",
-			"request_id": requestID,
-		})
-		return
-	}
-	userIDStr, ok := userID.(string)
+	userID, ok := common.GetLoginUserID(c, b.logger)
 	if !ok {
-		b.logger.Error("userID is not a string",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが正しい型ではありません",
-			"code":       "USER_ID_TYPE_ERROR",
-			"request_id": requestID,
-		})
 		return
 	}
 
@@ -182,35 +161,77 @@ func (b *BlogController) GetBlogView(c *gin.Context) {
 	}
 
 	// IDからブログ記事詳細を取得
-	blog, err := b.blogUseCase.FindBlogByID(id)
+	// 著者本人に加えて共同編集者も閲覧できる
+	blog, role, err := b.blogUseCase.FindBlogForUser(userID, id)
 	if err != nil {
-		b.logger.Error("Failed to get blog by ID",
-			zap.String("requestID", requestID),
-			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "ブログ記事の取得に失敗しました",
-			"code":       "BLOG_FETCH_FAILED",
-			"request_id": requestID,
-		})
+		switch {
+		case errors.Is(err, domainBlog.ErrBlogNotFound):
+			b.logger.Error("Failed to get blog by ID",
+				zap.String("requestID", requestID),
+				zap.Error(err))
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":      "指定されたブログ記事が存在しません",
+				"code":       "BLOG_NOT_FOUND",
+				"request_id": requestID,
+			})
+		case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+			b.logger.Warn("Unauthorized blog access attempt",
+				zap.String("requestID", requestID),
+				zap.Uint("blogID", id),
+				zap.Uint("userID", userID))
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":      "このブログ記事を閲覧する権限がありません",
+				"code":       "BLOG_ACCESS_DENIED",
+				"request_id": requestID,
+			})
+		default:
+			b.logger.Error("Failed to get blog by ID",
+				zap.String("requestID", requestID),
+				zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":      "ブログ記事の取得に失敗しました",
+				"code":       "BLOG_FETCH_FAILED",
+				"request_id": requestID,
+			})
+		}
 		return
 	}
 
-	// 閲覧権限チェック
-	if blog.Author.Username != userIDStr {
-		b.logger.Warn("Unauthorized blog access attempt",
+	// 本文をHTMLと目次に変換
+	rendered, err := b.blogUseCase.RenderBlogContent(blog)
+	if err != nil {
+		b.logger.Error("Failed to render blog content",
 			zap.String("requestID", requestID),
 			zap.Uint("blogID", id),
-			zap.String("userID", userIDStr))
-		c.JSON(http.StatusForbidden, gin.H{
-			"error":      "このブログ記事を閲覧する権限がありません",
-			"code":       "BLOG_ACCESS_DENIED",
+			zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":      "ブログ記事本文の変換に失敗しました",
+			"code":       "BLOG_RENDER_FAILED",
 			"request_id": requestID,
 		})
 		return
 	}
 
 	// DTOに変換してレスポンス
-	response := mapper.ToBlogCreatedResponse(blog)
+	response := mapper.ToBlogViewResponse(blog, rendered)
+	response.Role = role
+	// 著者本人の確認用のため限定公開の共有URLのトークンを含める
+	// 共同編集者には共有URLを渡さない
+	if role == domainBlog.RoleOwner {
+		response.ShareToken = blog.ShareToken
+	}
+	// 属するシリーズと前後のブログを含める
+	// 取得に失敗してもブログ記事の返却は継続する
+	nav, err := b.seriesUseCase.FindNavigation(userID, blog.ID)
+	if err != nil {
+		b.logger.Warn("Failed to get series navigation",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", id),
+			zap.Error(err))
+	}
+	response.Series = mapper.ToSeriesNavigationResponse(nav)
+	// 編集時のIf-Matchヘッダーに指定するバージョンをETagとして返却
+	c.Header("ETag", versionETag(blog.Version))
 	// 成功時のレスポンス
 	b.logger.Info("Successfully fetched blog",
 		zap.String("requestID", requestID),
@@ -229,27 +250,8 @@ func (b *BlogController) EditBlog(c *gin.Context) {
 	ctx := c.Request.Context()
 	requestID := middleware.GetRequestID(ctx)
 
-	// セッションuserIDの取得（ミドルウェアで認証済み）
-	userID, exists := c.Get("userID")
-	if !exists {
-		b.logger.Error("userID not found in context",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが取得できませんでした",
-			"code":       "USER_ID_NOT_FOUND",
-			"request_id": requestID,
-		})
-		return
-	}
-	userIDStr, ok := userID.(string)
+	authorID, ok := common.GetLoginUserID(c, b.logger)
 	if !ok {
-		b.logger.Error("userID is not a string",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが正しい型ではありません",
-			"code":       "USER_ID_TYPE_ERROR",
-			"request_id": requestID,
-		})
 		return
 	}
 
@@ -267,24 +269,51 @@ func (b *BlogController) EditBlog(c *gin.Context) {
 		return
 	}
 
-	// 文字列のuserIDをuintに変換
-	authorID, err := strconv.ParseUint(userIDStr, 10, 64)
+	// 編集対象のブログIDを取得
+	var id uint
+	if _, err := fmt.Sscanf(req.ID, "%d", &id); err != nil {
+		b.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", req.ID))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 更新元バージョンをIf-Matchヘッダーまたはリクエストボディから取得
+	version, err := parseIfMatch(c.GetHeader("If-Match"))
 	if err != nil {
-		b.logger.Error("Failed to parse userID",
-			zap.String("userID", userIDStr),
-			zap.Error(err),
+		b.logger.Error("Invalid If-Match header",
 			zap.String("requestID", requestID),
-		)
+			zap.String("ifMatch", c.GetHeader("If-Match")),
+			zap.Error(err))
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error":      "ユーザーIDの形式が不正です",
-			"code":       "INVALID_USER_ID_FORMAT",
+			"error":      "If-Matchヘッダーの形式が不正です",
+			"code":       "INVALID_IF_MATCH",
+			"request_id": requestID,
+		})
+		return
+	}
+	if version == 0 {
+		version = req.Version
+	}
+	if version == 0 {
+		b.logger.Warn("Blog version not specified in edit",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", id))
+		c.JSON(http.StatusPreconditionRequired, gin.H{
+			"error":      "更新元のバージョンを指定してください",
+			"code":       "BLOG_VERSION_REQUIRED",
 			"request_id": requestID,
 		})
 		return
 	}
 
 	// DTO→Entity変換
-	entityBlog, err := domainBlog.NewBlog(uint(authorID), req.Title, req.Content)
+	entityBlog, err := domainBlog.NewBlog(authorID, req.Title, req.Content)
 	if err != nil {
 		b.logger.Error("Domain validation failed in blog edit",
 			zap.String("requestID", requestID),
@@ -296,23 +325,28 @@ func (b *BlogController) EditBlog(c *gin.Context) {
 		})
 		return
 	}
+	entityBlog.ID = id
+	entityBlog.Version = version
+	entityBlog.Slug = req.Slug
+	entityBlog.Visibility = req.Visibility
 
 	// ブログ更新UseCase
-	updatedBlog, err := b.blogUseCase.UpdateBlog(entityBlog)
+	updatedBlog, err := b.blogUseCase.UpdateBlog(authorID, entityBlog)
 	if err != nil {
 		b.logger.Error("Failed to update blog",
 			zap.String("requestID", requestID),
+			zap.Uint("blogID", id),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "ブログ記事の更新に失敗しました",
-			"code":       "BLOG_UPDATE_FAILED",
-			"request_id": requestID,
-		})
+		b.respondUpdateError(c, requestID, err)
 		return
 	}
 
+	// 更新が確定したため編集中の内容の自動保存を破棄
+	b.clearAutosave(requestID, authorID, id)
+
 	// DTOに変換してレスポンス
-	response := mapper.ToBlogCreatedResponse(updatedBlog)
+	response := mapper.ToBlogDetailResponse(updatedBlog)
+	c.Header("ETag", versionETag(updatedBlog.Version))
 	b.logger.Info("Successfully updated blog",
 		zap.String("requestID", requestID),
 		zap.Any("blog", response))
@@ -324,21 +358,67 @@ func (b *BlogController) EditBlog(c *gin.Context) {
 	})
 }
 
+// ブログ記事更新失敗時のエラーレスポンスを返却
+// 更新競合時はサーバー上の最新のブログと反映されなかったブログを返す
+func (b *BlogController) respondUpdateError(c *gin.Context, requestID string, err error) {
+	conflict := &domainBlog.VersionConflictError{}
+	switch {
+	case errors.As(err, &conflict):
+		c.Header("ETag", versionETag(conflict.Current.Version))
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "ブログ記事が他の編集により更新されています",
+			"code":       "BLOG_VERSION_CONFLICT",
+			"request_id": requestID,
+			"current":    mapper.ToBlogDetailResponse(conflict.Current),
+			"rejected":   mapper.ToBlogDetailResponse(conflict.Rejected),
+		})
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":      "指定されたブログ記事が存在しません",
+			"code":       "BLOG_NOT_FOUND",
+			"request_id": requestID,
+		})
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":      "編集権限がありません",
+			"code":       "EDIT_PERMISSION_DENIED",
+			"request_id": requestID,
+		})
+	case errors.Is(err, domainBlog.ErrSlugInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "スラッグの形式が不正です",
+			"code":       "INVALID_BLOG_SLUG",
+			"request_id": requestID,
+		})
+	case errors.Is(err, domainBlog.ErrSlugAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{
+			"error":      "スラッグは既に使用されています",
+			"code":       "BLOG_SLUG_CONFLICT",
+			"request_id": requestID,
+		})
+	case errors.Is(err, domainBlog.ErrVisibilityInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "公開範囲の指定が不正です",
+			"code":       "INVALID_BLOG_VISIBILITY",
+			"request_id": requestID,
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":      "ブログ記事の更新に失敗しました",
+			"code":       "BLOG_UPDATE_FAILED",
+			"request_id": requestID,
+		})
+	}
+}
+
 // ブログ記事削除
 func (b *BlogController) DeleteBlog(c *gin.Context) {
 	// コンテクストからリクエストIDを取得
 	ctx := c.Request.Context()
 	requestID := middleware.GetRequestID(ctx)
 
-	userID, exists := c.Get("userID")
-	if !exists {
-		b.logger.Error("userID not found in context",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが取得できませんでした",
-			"code":       "USER_ID_NOT_FOUND",
-			"request_id": requestID,
-		})
+	userID, ok := common.GetLoginUserID(c, b.logger)
+	if !ok {
 		return
 	}
 
@@ -357,18 +437,33 @@ func (b *BlogController) DeleteBlog(c *gin.Context) {
 		return
 	}
 
-	// UseCaseで削除（ユーザーIDによる所有者チェックなども想定）
-	err := b.blogUseCase.DeleteBlog(id)
+	// UseCaseで削除権限を確認してゴミ箱へ移動
+	err := b.blogUseCase.DeleteBlog(userID, id)
 	if err != nil {
 		b.logger.Error("Failed to delete blog",
 			zap.String("requestID", requestID),
 			zap.String("id", fmt.Sprintf("%d", id)),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "ブログ記事の削除に失敗しました",
-			"code":       "BLOG_DELETION_FAILED",
-			"request_id": requestID,
-		})
+		switch {
+		case errors.Is(err, domainBlog.ErrBlogNotFound):
+			c.JSON(http.StatusNotFound, gin.H{
+				"error":      "指定されたブログ記事が存在しません",
+				"code":       "BLOG_NOT_FOUND",
+				"request_id": requestID,
+			})
+		case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+			c.JSON(http.StatusForbidden, gin.H{
+				"error":      "このブログ記事を削除する権限がありません",
+				"code":       "DELETE_PERMISSION_DENIED",
+				"request_id": requestID,
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error":      "ブログ記事の削除に失敗しました",
+				"code":       "BLOG_DELETION_FAILED",
+				"request_id": requestID,
+			})
+		}
 		return
 	}
 
@@ -376,10 +471,41 @@ func (b *BlogController) DeleteBlog(c *gin.Context) {
 		zap.String("requestID", requestID),
 		zap.String("id", fmt.Sprintf("%d", id)))
 	c.JSON(http.StatusOK, gin.H{
-		"message":     "ブログ記事を削除しました",
+		"message":     "ブログ記事をゴミ箱に移動しました",
 		"code":        "BLOG_DELETED",
 		"request_id":  requestID,
 		"blog_id":     id,
 		"blog_userID": userID,
 	})
 }
+
+// 自動保存した内容を破棄
+// 破棄に失敗しても保持期間を過ぎると削除されるため、ブログ記事の登録・更新の応答は継続する
+func (b *BlogController) clearAutosave(requestID string, userID, blogID uint) {
+	if err := b.blogUseCase.ClearAutosave(userID, blogID); err != nil {
+		b.logger.Warn("Failed to clear autosave",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", blogID),
+			zap.Error(err))
+	}
+}
+
+// バージョンをETag形式に変換
+func versionETag(version uint) string {
+	return fmt.Sprintf(`"%d"`, version)
+}
+
+// If-Matchヘッダーからバージョンを取得
+// ヘッダー未指定の場合は0を返す
+func parseIfMatch(header string) (uint, error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0, nil
+	}
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.ParseUint(tag, 10, 64)
+	if err != nil || version == 0 {
+		return 0, fmt.Errorf("invalid entity tag %q", header)
+	}
+	return uint(version), nil
+}
@@ -12,9 +12,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/kazukimurahashi12/webapp/domain/blog"
-	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	"github.com/kazukimurahashi12/webapp/domain/series"
 	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
 	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	seriesMocks "github.com/kazukimurahashi12/webapp/usecase/series/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 )
@@ -35,6 +36,7 @@ func TestBlogController_PostBlog(t *testing.T) {
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 
 		// モック設定
 		expectedBlog, _ := blog.NewBlog(123, "test title", "test content")
@@ -47,7 +49,7 @@ func TestBlogController_PostBlog(t *testing.T) {
 			Return(expectedBlog, nil)
 
 		logger := zaptest.NewLogger(t)
-		controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 		controller.PostBlog(ctx)
 
@@ -71,9 +73,10 @@ func TestBlogController_PostBlog(t *testing.T) {
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 
 		logger := zaptest.NewLogger(t)
-		controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 		// 実行
 		controller.PostBlog(ctx)
@@ -100,8 +103,9 @@ func TestBlogController_GetBlogView(t *testing.T) {
 
 	mockSession := sessionMocks.NewMockSessionManager(ctrl)
 	mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+	mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 	logger := zaptest.NewLogger(t)
-	controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+	controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 	t.Run("Success", func(t *testing.T) {
 		recorder := httptest.NewRecorder()
@@ -125,14 +129,114 @@ func TestBlogController_GetBlogView(t *testing.T) {
 		expectedBlog := &blog.Blog{
 			ID:       123,
 			AuthorID: uint(123),
+			Version:  3,
 		}
 
 		mockBlogUseCase.EXPECT().
-			FindBlogByID(uint(123)).
-			Return(expectedBlog, nil)
+			FindBlogForUser(uint(123), uint(123)).
+			Return(expectedBlog, blog.RoleOwner, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(expectedBlog).
+			Return(&blog.RenderedContent{
+				HTML: "<h2 id=\"概要\">概要</h2>\n",
+				TOC:  []blog.TOCEntry{{Level: 2, Text: "概要", Anchor: "概要"}},
+			}, nil)
+		mockSeriesUseCase.EXPECT().
+			FindNavigation(uint(123), uint(123)).
+			Return(&series.Navigation{
+				SeriesID: 5,
+				Title:    "Go入門",
+				Position: 2,
+				Total:    3,
+				Prev:     &series.Entry{Position: 1, Blog: blog.Blog{ID: 122, Title: "第1回"}},
+				Next:     &series.Entry{Position: 3, Blog: blog.Blog{ID: 124, Title: "第3回"}},
+			}, nil)
 
 		controller.GetBlogView(c)
 		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, `"3"`, recorder.Header().Get("ETag"))
+		var response struct {
+			Blog struct {
+				ContentHTML string `json:"contentHtml"`
+				TOC         []struct {
+					Level  int    `json:"level"`
+					Anchor string `json:"anchor"`
+				} `json:"toc"`
+				Series struct {
+					SeriesID uint `json:"seriesId"`
+					Position int  `json:"position"`
+					Total    int  `json:"total"`
+					Prev     struct {
+						BlogID uint `json:"blogId"`
+					} `json:"prev"`
+					Next struct {
+						BlogID uint `json:"blogId"`
+					} `json:"next"`
+				} `json:"series"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "<h2 id=\"概要\">概要</h2>\n", response.Blog.ContentHTML)
+			if assert.Len(t, response.Blog.TOC, 1) {
+				assert.Equal(t, 2, response.Blog.TOC[0].Level)
+				assert.Equal(t, "概要", response.Blog.TOC[0].Anchor)
+			}
+			assert.Equal(t, uint(5), response.Blog.Series.SeriesID)
+			assert.Equal(t, 2, response.Blog.Series.Position)
+			assert.Equal(t, 3, response.Blog.Series.Total)
+			assert.Equal(t, uint(122), response.Blog.Series.Prev.BlogID)
+			assert.Equal(t, uint(124), response.Blog.Series.Next.BlogID)
+		}
+	})
+
+	t.Run("NotInSeries", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/123", nil)
+		ctx.Set("userID", "123")
+		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+		expectedBlog := &blog.Blog{
+			ID:       123,
+			AuthorID: uint(123),
+		}
+
+		mockBlogUseCase.EXPECT().
+			FindBlogForUser(uint(123), uint(123)).
+			Return(expectedBlog, blog.RoleOwner, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(expectedBlog).
+			Return(&blog.RenderedContent{}, nil)
+		mockSeriesUseCase.EXPECT().
+			FindNavigation(uint(123), uint(123)).
+			Return(nil, nil)
+
+		controller.GetBlogView(ctx)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.NotContains(t, recorder.Body.String(), `"series"`)
+	})
+
+	t.Run("RenderBlogContent returns error", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/123", nil)
+		ctx.Set("userID", "123")
+		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+		expectedBlog := &blog.Blog{
+			ID:       123,
+			AuthorID: uint(123),
+		}
+
+		mockBlogUseCase.EXPECT().
+			FindBlogForUser(uint(123), uint(123)).
+			Return(expectedBlog, blog.RoleOwner, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(expectedBlog).
+			Return(nil, errors.New("render failed"))
+
+		controller.GetBlogView(ctx)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
 	})
 
 	t.Run("userID not in context", func(t *testing.T) {
@@ -167,16 +271,16 @@ func TestBlogController_GetBlogView(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, recorder.Code)
 	})
 
-	t.Run("FindBlogByID returns error", func(t *testing.T) {
+	t.Run("FindBlogForUser returns error", func(t *testing.T) {
 		recorder := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(recorder)
 		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/123", nil)
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
 
 		mockBlogUseCase.EXPECT().
-			FindBlogByID(uint(123)).
-			Return(nil, errors.New("not found"))
+			FindBlogForUser(uint(123), uint(123)).
+			Return(nil, "", errors.New("not found"))
 
 		controller.GetBlogView(ctx)
 		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
@@ -186,20 +290,44 @@ func TestBlogController_GetBlogView(t *testing.T) {
 		recorder := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(recorder)
 		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/123", nil)
-		ctx.Set("userID", "user999")
+		ctx.Set("userID", "999")
+		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+		mockBlogUseCase.EXPECT().
+			FindBlogForUser(uint(999), uint(123)).
+			Return(nil, "", blog.ErrBlogUnauthorized)
+
+		controller.GetBlogView(ctx)
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Collaborator access", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/123", nil)
+		ctx.Set("userID", "456")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
 
 		expectedBlog := &blog.Blog{
-			ID:       123,
-			AuthorID: uint(123),
+			ID:         123,
+			AuthorID:   uint(123),
+			ShareToken: "secret-token",
 		}
 
 		mockBlogUseCase.EXPECT().
-			FindBlogByID(uint(123)).
-			Return(expectedBlog, nil)
+			FindBlogForUser(uint(456), uint(123)).
+			Return(expectedBlog, blog.RoleEditor, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(expectedBlog).
+			Return(&blog.RenderedContent{}, nil)
+		mockSeriesUseCase.EXPECT().
+			FindNavigation(uint(456), uint(123)).
+			Return(nil, nil)
 
 		controller.GetBlogView(ctx)
-		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"role":"editor"`)
+		assert.NotContains(t, recorder.Body.String(), "secret-token")
 	})
 }
 
@@ -211,30 +339,70 @@ func TestBlogController_EditBlog(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		recorder := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(recorder)
-		reqBody := `{"id":"test id","userID":"user123","title":"test title","content":"test content"}`
-		req := httptest.NewRequest(http.MethodPut, "/blog/123", strings.NewReader(reqBody))
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/edit", strings.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"2"`)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 
 		// モック設定
-		mockUser := &domainUser.User{
-			ID:       123,
-			Username: "user123",
-		}
 		mockBlogUseCase.EXPECT().
-			FindBlogByAuthorID("user123").
-			Return(mockUser, nil).Times(1)
-		expectedBlog, _ := blog.NewBlog(123, "updated title", "updated content")
+			UpdateBlog(uint(123), gomock.Any()).
+			DoAndReturn(func(userID uint, b *blog.Blog) (*blog.Blog, error) {
+				assert.Equal(t, uint(10), b.ID)
+				assert.Equal(t, uint(123), userID)
+				assert.Equal(t, uint(2), b.Version)
+				updated := *b
+				updated.Version = 3
+				return &updated, nil
+			})
 		mockBlogUseCase.EXPECT().
-			UpdateBlog(gomock.Any()).
-			Return(expectedBlog, nil)
+			ClearAutosave(uint(123), uint(10)).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.EditBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
+		assert.Equal(t, `"3"`, recorder.Header().Get("ETag"))
+	})
+
+	t.Run("VersionInBody", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content","version":5}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/edit", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			UpdateBlog(uint(123), gomock.Any()).
+			DoAndReturn(func(userID uint, b *blog.Blog) (*blog.Blog, error) {
+				assert.Equal(t, uint(5), b.Version)
+				return b, nil
+			})
+		// 自動保存の破棄に失敗しても更新結果を返す
+		mockBlogUseCase.EXPECT().
+			ClearAutosave(uint(123), uint(10)).
+			Return(blog.ErrAutosaveUnavailable)
 
 		logger := zaptest.NewLogger(t)
-		controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 		// 実行
 		controller.EditBlog(ctx)
@@ -242,6 +410,107 @@ func TestBlogController_EditBlog(t *testing.T) {
 		// 検証
 		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
 	})
+
+	t.Run("VersionRequired", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/edit", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.EditBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusPreconditionRequired, ctx.Writer.Status())
+	})
+
+	t.Run("VersionConflict", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":"10","userId":"123","title":"stale title","content":"stale content"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/edit", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `W/"2"`)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			UpdateBlog(uint(123), gomock.Any()).
+			DoAndReturn(func(userID uint, b *blog.Blog) (*blog.Blog, error) {
+				return nil, &blog.VersionConflictError{
+					Current:  &blog.Blog{ID: 10, AuthorID: 123, Title: "latest title", Content: "latest content", Version: 4},
+					Rejected: b,
+				}
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.EditBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, ctx.Writer.Status())
+		assert.Equal(t, `"4"`, recorder.Header().Get("ETag"))
+
+		var response struct {
+			Code    string `json:"code"`
+			Current struct {
+				Title   string `json:"title"`
+				Version uint   `json:"version"`
+			} `json:"current"`
+			Rejected struct {
+				Title   string `json:"title"`
+				Version uint   `json:"version"`
+			} `json:"rejected"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "BLOG_VERSION_CONFLICT", response.Code)
+			assert.Equal(t, "latest title", response.Current.Title)
+			assert.Equal(t, uint(4), response.Current.Version)
+			assert.Equal(t, "stale title", response.Rejected.Title)
+			assert.Equal(t, uint(2), response.Rejected.Version)
+		}
+	})
+
+	t.Run("InvalidIfMatch", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/edit", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", `"abc"`)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.EditBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, ctx.Writer.Status())
+	})
 }
 
 func TestBlogController_DeleteBlog(t *testing.T) {
@@ -254,18 +523,19 @@ func TestBlogController_DeleteBlog(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodDelete, "/blog/123", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 
 		mockBlogUseCase.EXPECT().
-			DeleteBlog(uint(123)).
+			DeleteBlog(uint(123), uint(123)).
 			Return(nil)
 
 		logger := zaptest.NewLogger(t)
-		controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 		controller.DeleteBlog(ctx)
 
@@ -277,19 +547,20 @@ func TestBlogController_DeleteBlog(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodDelete, "/blog/123", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
 
 		// モック設定
 		mockBlogUseCase.EXPECT().
-			DeleteBlog(uint(123)).
+			DeleteBlog(uint(123), uint(123)).
 			Return(errors.New("delete failed"))
 
 		logger := zaptest.NewLogger(t)
-		controller := NewBlogController(mockBlogUseCase, mockSession, logger)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
 
 		// 実行
 		controller.DeleteBlog(ctx)
@@ -297,4 +568,31 @@ func TestBlogController_DeleteBlog(t *testing.T) {
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, ctx.Writer.Status())
 	})
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodDelete, "/blog/123", nil)
+		ctx.Request = req
+		ctx.Set("userID", "456")
+		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			DeleteBlog(uint(456), uint(123)).
+			Return(blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBlogController(mockBlogUseCase, mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.DeleteBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "DELETE_PERMISSION_DENIED")
+	})
 }
@@ -0,0 +1,112 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+type BulkController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewBulkController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *BulkController {
+	return &BulkController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ブログ記事の一括操作
+// 権限のないブログ記事があっても残りの操作を続け、ブログ記事ごとの結果を返す
+func (bc *BulkController) BulkOperate(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, bc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.BlogBulkRequest{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		bc.logger.Error("Failed to bind JSON in blog bulk operation",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "一括操作データの形式が不正です",
+			"code":       "INVALID_BULK_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	op, err := domainBlog.NewBulkOperation(req.Action, req.BlogIDs, req.Visibility, req.CategoryID, req.DryRun)
+	if err != nil {
+		bc.respondError(c, requestID, err)
+		return
+	}
+
+	// 一括操作UseCase
+	report, err := bc.blogUseCase.BulkOperate(userID, op)
+	if err != nil {
+		bc.respondError(c, requestID, err)
+		return
+	}
+
+	message, code := "ブログ記事を一括操作しました", "BLOG_BULK_APPLIED"
+	if report.DryRun {
+		message, code = "一括操作の結果を確認しました（変更は確定していません）", "BLOG_BULK_DRY_RUN"
+	}
+	bc.logger.Info("Successfully processed blog bulk operation",
+		zap.String("requestID", requestID),
+		zap.String("action", report.Action),
+		zap.Bool("dryRun", report.DryRun),
+		zap.Int("applied", report.Applied),
+		zap.Int("unchanged", report.Unchanged),
+		zap.Int("failed", report.Failed))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    message,
+		"code":       code,
+		"request_id": requestID,
+		"report":     mapper.ToBulkReportResponse(report),
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (bc *BulkController) respondError(c *gin.Context, requestID string, err error) {
+	status, message, code := http.StatusInternalServerError, "ブログ記事の一括操作に失敗しました", "BLOG_BULK_FAILED"
+	switch {
+	case errors.Is(err, domainBlog.ErrBulkInvalid):
+		status, message, code = http.StatusBadRequest, "一括操作の指定が不正です", "INVALID_BULK_OPERATION"
+	case errors.Is(err, domainBlog.ErrVisibilityInvalid):
+		status, message, code = http.StatusBadRequest, "公開範囲の指定が不正です", "INVALID_BLOG_VISIBILITY"
+	case errors.Is(err, domainCategory.ErrCategoryNotFound):
+		status, message, code = http.StatusNotFound, "指定されたカテゴリが存在しません", "CATEGORY_NOT_FOUND"
+	}
+
+	bc.logger.Error("Blog bulk operation failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,178 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/domain/category"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestBulkController_BulkOperate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newContext := func(body string) (*gin.Context, *httptest.ResponseRecorder) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/blog/bulk", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+		return ctx, recorder
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctx, recorder := newContext(`{"action":"delete","blogIds":[10,11,12,10]}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		// 重複するブログIDは除外される
+		mockBlogUseCase.EXPECT().
+			BulkOperate(uint(123), &blog.BulkOperation{Action: blog.BulkActionDelete, BlogIDs: []uint{10, 11, 12}}).
+			DoAndReturn(func(userID uint, op *blog.BulkOperation) (*blog.BulkReport, error) {
+				report := &blog.BulkReport{Action: op.Action}
+				report.Add(blog.BulkItemResult{BlogID: 10, Result: blog.BulkResultApplied})
+				report.Add(blog.BulkItemResult{BlogID: 11, Result: blog.BulkResultFailed, Reason: blog.BulkReasonUnauthorized})
+				report.Add(blog.BulkItemResult{BlogID: 12, Result: blog.BulkResultFailed, Reason: blog.BulkReasonNotFound})
+				return report, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBulkController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.BulkOperate(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code   string `json:"code"`
+			Report struct {
+				Applied int `json:"applied"`
+				Failed  int `json:"failed"`
+				Items   []struct {
+					BlogID uint   `json:"blogId"`
+					Result string `json:"result"`
+					Reason string `json:"reason"`
+				} `json:"items"`
+			} `json:"report"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "BLOG_BULK_APPLIED", response.Code)
+			assert.Equal(t, 1, response.Report.Applied)
+			assert.Equal(t, 2, response.Report.Failed)
+			if assert.Len(t, response.Report.Items, 3) {
+				assert.Equal(t, "applied", response.Report.Items[0].Result)
+				assert.Equal(t, "unauthorized", response.Report.Items[1].Reason)
+				assert.Equal(t, "not_found", response.Report.Items[2].Reason)
+			}
+		}
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		ctx, recorder := newContext(`{"action":"visibility","blogIds":[10],"visibility":"private","dryRun":true}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			BulkOperate(uint(123), &blog.BulkOperation{
+				Action:     blog.BulkActionVisibility,
+				BlogIDs:    []uint{10},
+				Visibility: blog.VisibilityPrivate,
+				DryRun:     true,
+			}).
+			Return(&blog.BulkReport{
+				Action:  blog.BulkActionVisibility,
+				DryRun:  true,
+				Items:   []blog.BulkItemResult{{BlogID: 10, Result: blog.BulkResultApplied}},
+				Applied: 1,
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBulkController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.BulkOperate(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "BLOG_BULK_DRY_RUN")
+		assert.Contains(t, recorder.Body.String(), `"dryRun":true`)
+	})
+
+	t.Run("InvalidVisibility", func(t *testing.T) {
+		ctx, recorder := newContext(`{"action":"visibility","blogIds":[10],"visibility":"secret"}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBulkController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.BulkOperate(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_BLOG_VISIBILITY")
+	})
+
+	t.Run("TooManyBlogs", func(t *testing.T) {
+		ids := make([]string, blog.MaxBulkItems+1)
+		for i := range ids {
+			ids[i] = strconv.Itoa(i + 1)
+		}
+		ctx, recorder := newContext(`{"action":"restore","blogIds":[` + strings.Join(ids, ",") + `]}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBulkController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.BulkOperate(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_BULK_OPERATION")
+	})
+
+	t.Run("CategoryNotFound", func(t *testing.T) {
+		ctx, recorder := newContext(`{"action":"add_category","blogIds":[10],"categoryId":99}`)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			BulkOperate(uint(123), &blog.BulkOperation{Action: blog.BulkActionAddCategory, BlogIDs: []uint{10}, CategoryID: 99}).
+			Return(nil, category.ErrCategoryNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewBulkController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.BulkOperate(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "CATEGORY_NOT_FOUND")
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
 	"github.com/kazukimurahashi12/webapp/interface/session"
 	"github.com/kazukimurahashi12/webapp/usecase/blog"
 	"go.uber.org/zap"
@@ -31,16 +32,8 @@ func (d *DeleteController) DeleteBlog(c *gin.Context) {
 	ctx := c.Request.Context()
 	requestID := middleware.GetRequestID(ctx)
 
-	// セッションによるログイン認証はroutes.go_isAuthenticated共通実施しコンテクストから取得
-	_, exists := c.Get("userID")
-	if !exists {
-		d.logger.Error("userID not found in context",
-			zap.String("requestID", requestID))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "userIDが取得できませんでした",
-			"code":       "USER_ID_NOT_FOUND",
-			"request_id": requestID,
-		})
+	userID, ok := common.GetLoginUserID(c, d.logger)
+	if !ok {
 		return
 	}
 
@@ -60,7 +53,7 @@ func (d *DeleteController) DeleteBlog(c *gin.Context) {
 		return
 	}
 	// ブログ記事削除処理UseCase
-	err = d.blogUseCase.DeleteBlog(uint(id))
+	err = d.blogUseCase.DeleteBlog(userID, uint(id))
 	if err != nil {
 		d.logger.Error("Failed to delete blog post",
 			zap.String("requestID", requestID),
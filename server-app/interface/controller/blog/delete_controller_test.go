@@ -24,7 +24,7 @@ func TestDeleteController_DeleteBlog(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodDelete, "/blog/123", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "123"}}
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
@@ -32,7 +32,7 @@ func TestDeleteController_DeleteBlog(t *testing.T) {
 
 		// モック設定
 		mockBlogUseCase.EXPECT().
-			DeleteBlog("123").
+			DeleteBlog(uint(123), uint(123)).
 			Return(nil)
 
 		logger := zaptest.NewLogger(t)
@@ -50,7 +50,7 @@ func TestDeleteController_DeleteBlog(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodDelete, "/blog/999", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 		ctx.Params = gin.Params{gin.Param{Key: "id", Value: "999"}}
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
@@ -58,7 +58,7 @@ func TestDeleteController_DeleteBlog(t *testing.T) {
 
 		// モック設定
 		mockBlogUseCase.EXPECT().
-			DeleteBlog("999").
+			DeleteBlog(uint(123), uint(999)).
 			Return(errors.New("not found"))
 
 		logger := zaptest.NewLogger(t)
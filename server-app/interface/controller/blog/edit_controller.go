@@ -88,6 +88,18 @@ func (e *EditController) EditBlog(c *gin.Context) {
 	}
 
 	// uint型に変換
+	var actorID uint
+	if _, err := fmt.Sscanf(userIDStr, "%d", &actorID); err != nil {
+		e.logger.Error("Invalid user ID format",
+			zap.String("requestID", requestID),
+			zap.String("userID", userIDStr))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ユーザーIDの形式が不正です",
+			"code":       "INVALID_USER_ID_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
 	var id uint
 	if _, err := fmt.Sscanf(req.ID, "%d", &id); err != nil {
 		e.logger.Error("Invalid blog ID format",
@@ -101,7 +113,7 @@ func (e *EditController) EditBlog(c *gin.Context) {
 		return
 	}
 	// DTO、Entity変換
-	entityBlog, err := blog.NewBlog(id, req.Title, req.Content)
+	entityBlog, err := blog.NewBlog(actorID, req.Title, req.Content)
 	if err != nil {
 		e.logger.Error("Domain validation failed in blog creation",
 			zap.String("requestID", requestID),
@@ -114,8 +126,10 @@ func (e *EditController) EditBlog(c *gin.Context) {
 		return
 	}
 
+	entityBlog.ID = id
+
 	// ブログ記事更新処理UseCase
-	updatedBlog, err := e.blogUseCase.UpdateBlog(entityBlog)
+	updatedBlog, err := e.blogUseCase.UpdateBlog(actorID, entityBlog)
 	if err != nil {
 		e.logger.Error("Failed to update blog post",
 			zap.String("requestID", requestID),
@@ -3,7 +3,6 @@ package blog
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -26,24 +25,20 @@ func TestEditController_EditBlog(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		recorder := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(recorder)
-		reqBody := `{"UserID":"user123","title":"updated title","content":"updated content"}`
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content"}`
 		req := httptest.NewRequest(http.MethodPut, "/blog/123", strings.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
 
 		// モック設定
-		// uint型に変換
-		var testID uint
-		if _, err := fmt.Sscanf("user123", "%d", &testID); err != nil {
-			t.Logf("Error converting string to uint: %v", err)
-		}
-		expectedBlog, _ := blog.NewBlog(testID, "updated title", "updated content")
+		expectedBlog, _ := blog.NewBlog(123, "updated title", "updated content")
+		expectedBlog.ID = 10
 		mockBlogUseCase.EXPECT().
-			UpdateBlog(expectedBlog).
+			UpdateBlog(uint(123), expectedBlog).
 			Return(expectedBlog, nil)
 
 		logger := zaptest.NewLogger(t)
@@ -88,24 +83,20 @@ func TestEditController_EditBlog(t *testing.T) {
 	t.Run("UpdateFailed", func(t *testing.T) {
 		recorder := httptest.NewRecorder()
 		ctx, _ := gin.CreateTestContext(recorder)
-		reqBody := `{"UserID":"user123","title":"updated title","content":"updated content"}`
+		reqBody := `{"id":"10","userId":"123","title":"updated title","content":"updated content"}`
 		req := httptest.NewRequest(http.MethodPut, "/blog/123", strings.NewReader(reqBody))
 		req.Header.Set("Content-Type", "application/json")
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
 
 		// モック設定
-		// uint型に変換
-		var testID uint
-		if _, err := fmt.Sscanf("user123", "%d", &testID); err != nil {
-			t.Logf("Error converting string to uint: %v", err)
-		}
-		expectedBlog, _ := blog.NewBlog(testID, "updated title", "updated content")
+		expectedBlog, _ := blog.NewBlog(123, "updated title", "updated content")
+		expectedBlog.ID = 10
 		mockBlogUseCase.EXPECT().
-			UpdateBlog(expectedBlog).
+			UpdateBlog(uint(123), expectedBlog).
 			Return(nil, errors.New("update failed"))
 
 		logger := zaptest.NewLogger(t)
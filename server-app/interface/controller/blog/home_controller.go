@@ -1,11 +1,18 @@
 package blog
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
 	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
 	"github.com/kazukimurahashi12/webapp/interface/mapper"
 	"github.com/kazukimurahashi12/webapp/interface/session"
 	"github.com/kazukimurahashi12/webapp/usecase/blog"
@@ -74,18 +81,20 @@ func (h *HomeController) GetTop(c *gin.Context) {
 		return
 	}
 
+	// クエリパラメータからページング・並び替え・絞り込み条件を取得
+	query, ok := h.bindListQuery(c, requestID, uint(userIDUint))
+	if !ok {
+		return
+	}
+
 	// ブログ記事取得ORM
-	blogs, err := h.blogUseCase.FindBlogsByAuthorID(uint(userIDUint))
+	page, err := h.blogUseCase.ListBlogs(query)
 	if err != nil {
 		h.logger.Error("Failed to get blogs",
 			zap.String("requestID", requestID),
 			zap.String("userID", userIDStr),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "ブログ記事の取得に失敗しました",
-			"code":       "BLOG_FETCH_FAILED",
-			"request_id": requestID,
-		})
+		h.respondListError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
 		return
 	}
 
@@ -96,10 +105,8 @@ func (h *HomeController) GetTop(c *gin.Context) {
 		"message":    "ブログ記事を取得しました",
 		"code":       "BLOG_FETCHED",
 		"request_id": requestID,
-		"blogs":      blogs,
-		"meta": gin.H{
-			"count": len(blogs),
-		},
+		"blogs":      page.Blogs,
+		"meta":       mapper.ToPageMeta(page, query),
 	})
 	logrus.Info("@COMPLETE :GetTop",
 		"requestID", requestID)
@@ -152,23 +159,25 @@ func (h *HomeController) GetMypage(c *gin.Context) {
 		return
 	}
 
+	// クエリパラメータからページング・並び替え・絞り込み条件を取得
+	query, ok := h.bindListQuery(c, requestID, uint(userIDUint))
+	if !ok {
+		return
+	}
+
 	// ユーザー情報取得ORM
-	blog, err := h.blogUseCase.FindBlogsByAuthorID(uint(userIDUint))
+	page, err := h.blogUseCase.ListBlogs(query)
 	if err != nil {
 		h.logger.Error("Failed to get userID",
 			zap.String("requestID", requestID),
 			zap.String("userID", userIDStr),
 			zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "ユーザー情報の取得に失敗しました",
-			"code":       "USER_FETCH_FAILED",
-			"request_id": requestID,
-		})
+		h.respondListError(c, requestID, err, "ユーザー情報の取得に失敗しました", "USER_FETCH_FAILED")
 		return
 	}
 
 	// DTOに変換してレスポンス
-	response := mapper.ToBlogsResponse(blog)
+	response := mapper.ToBlogsResponse(page.Blogs)
 	h.logger.Info("Successfully changed blogs",
 		zap.String("requestID", requestID),
 		zap.Any("blogs", response))
@@ -177,7 +186,153 @@ func (h *HomeController) GetMypage(c *gin.Context) {
 		"code":       "USER_FETCHED",
 		"request_id": requestID,
 		"blogs":      response,
+		"meta":       mapper.ToPageMeta(page, query),
 	})
 	logrus.Info("@COMPLETE :GetMypage",
 		"requestID", requestID)
 }
+
+// ブログ記事の全文検索
+func (h *HomeController) SearchBlogs(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, h.logger)
+	if !ok {
+		return
+	}
+
+	// クエリパラメータから検索条件を取得
+	req := dto.BlogSearchQuery{}
+	err := c.ShouldBindQuery(&req)
+
+	query := domainBlog.SearchQuery{
+		Keyword:  req.Keyword,
+		AuthorID: req.Author,
+		ViewerID: userID,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	}
+	if err == nil && req.From != "" {
+		query.CreatedFrom, err = parseDateParam(req.From, false)
+	}
+	if err == nil && req.To != "" {
+		query.CreatedTo, err = parseDateParam(req.To, true)
+	}
+	if err != nil {
+		h.logger.Error("Invalid blog search query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "検索条件の形式が不正です",
+			"code":       "INVALID_BLOG_SEARCH_QUERY",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 全文検索UseCase
+	result, err := h.blogUseCase.SearchBlogs(query)
+	if err != nil {
+		h.logger.Error("Failed to search blogs",
+			zap.String("requestID", requestID),
+			zap.String("keyword", req.Keyword),
+			zap.Error(err))
+		h.respondListError(c, requestID, err, "ブログ記事の検索に失敗しました", "BLOG_SEARCH_FAILED")
+		return
+	}
+
+	h.logger.Debug("Successfully searched blogs",
+		zap.String("requestID", requestID),
+		zap.String("keyword", req.Keyword),
+		zap.Int64("total", result.Total))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事を検索しました",
+		"code":       "BLOG_SEARCHED",
+		"request_id": requestID,
+		"results":    mapper.ToBlogSearchHitsResponse(result.Hits),
+		"meta": gin.H{
+			"count":  len(result.Hits),
+			"total":  result.Total,
+			"limit":  query.Limit,
+			"offset": query.Offset,
+		},
+	})
+}
+
+// クエリパラメータからブログ一覧の取得条件を生成
+// 不正な条件の場合はエラーレスポンスを返却しfalseを返す
+func (h *HomeController) bindListQuery(c *gin.Context, requestID string, authorID uint) (domainBlog.ListQuery, bool) {
+	req := dto.BlogListQuery{}
+	err := c.ShouldBindQuery(&req)
+
+	query := domainBlog.ListQuery{
+		AuthorID:      authorID,
+		CategoryID:    req.Category,
+		Limit:         req.Limit,
+		SortBy:        req.Sort,
+		Order:         req.Order,
+		TitleContains: req.Title,
+		Status:        req.Status,
+	}
+	if err == nil && req.Cursor != "" {
+		query.Cursor, err = domainBlog.DecodeCursor(req.Cursor)
+	}
+	if err == nil && req.From != "" {
+		query.CreatedFrom, err = parseDateParam(req.From, false)
+	}
+	if err == nil && req.To != "" {
+		query.CreatedTo, err = parseDateParam(req.To, true)
+	}
+	if err == nil {
+		err = query.Normalize()
+	}
+
+	if err != nil {
+		h.logger.Error("Invalid blog list query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "一覧取得条件の形式が不正です",
+			"code":       "INVALID_BLOG_QUERY",
+			"request_id": requestID,
+		})
+		return query, false
+	}
+	return query, true
+}
+
+// 一覧取得失敗時のエラーレスポンスを返却
+func (h *HomeController) respondListError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainBlog.ErrBlogInvalidQuery):
+		status, message, code = http.StatusBadRequest, "一覧取得条件の形式が不正です", "INVALID_BLOG_QUERY"
+	case errors.Is(err, domainCategory.ErrCategoryNotFound):
+		status, message, code = http.StatusNotFound, "指定されたカテゴリが存在しません", "CATEGORY_NOT_FOUND"
+	}
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
+
+// 日付クエリパラメータを解析
+// 日付のみ指定された終了日はその日の終わりまでを含める
+func parseDateParam(s string, endOfDay bool) (*time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return &t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", s, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid date %q", domainBlog.ErrBlogInvalidQuery, s)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
@@ -26,7 +26,7 @@ func TestHomeController_GetTop(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodGet, "/blog/top", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
@@ -37,8 +37,13 @@ func TestHomeController_GetTop(t *testing.T) {
 			{ID: 2, Title: "Test Blog 2"},
 		}
 		mockBlogUseCase.EXPECT().
-			FindBlogsByAuthorID(uint(123)).
-			Return(expectedBlogs, nil)
+			ListBlogs(blog.ListQuery{
+				AuthorID: 123,
+				Limit:    blog.DefaultPageLimit,
+				SortBy:   blog.SortByCreated,
+				Order:    blog.OrderDesc,
+			}).
+			Return(&blog.Page{Blogs: expectedBlogs}, nil)
 
 		logger := zaptest.NewLogger(t)
 		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
@@ -63,14 +68,14 @@ func TestHomeController_GetTop(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodGet, "/blog/top", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
 
 		// モック設定
 		mockBlogUseCase.EXPECT().
-			FindBlogsByAuthorID(uint(123)).
+			ListBlogs(gomock.Any()).
 			Return(nil, errors.New("fetch failed"))
 
 		logger := zaptest.NewLogger(t)
@@ -102,6 +107,150 @@ func TestHomeController_GetTop(t *testing.T) {
 		// 検証
 		assert.Equal(t, http.StatusInternalServerError, ctx.Writer.Status())
 	})
+
+	t.Run("CategoryFilter", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/?category=5", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		expectedBlogs := []blog.Blog{
+			{ID: 1, Title: "Test Blog 1"},
+		}
+		mockBlogUseCase.EXPECT().
+			ListBlogs(gomock.Any()).
+			DoAndReturn(func(query blog.ListQuery) (*blog.Page, error) {
+				assert.Equal(t, uint(5), query.CategoryID)
+				return &blog.Page{Blogs: expectedBlogs}, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTop(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
+
+		var response map[string]interface{}
+		err := json.Unmarshal(recorder.Body.Bytes(), &response)
+		if assert.NoError(t, err) {
+			assert.Len(t, response["blogs"], 1)
+		}
+	})
+
+	t.Run("InvalidCategory", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/?category=abc", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTop(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, ctx.Writer.Status())
+	})
+
+	t.Run("PagingAndFilters", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		cursor := blog.NewCursor(&blog.Blog{ID: 30, Title: "Go"}, blog.SortByTitle, blog.OrderAsc, false).Encode()
+		req := httptest.NewRequest(http.MethodGet,
+			"/?limit=2&sort=title&order=asc&q=Go&from=2024-01-01&to=2024-01-31&cursor="+cursor, nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			ListBlogs(gomock.Any()).
+			DoAndReturn(func(query blog.ListQuery) (*blog.Page, error) {
+				assert.Equal(t, 2, query.Limit)
+				assert.Equal(t, blog.SortByTitle, query.SortBy)
+				assert.Equal(t, blog.OrderAsc, query.Order)
+				assert.Equal(t, "Go", query.TitleContains)
+				if assert.NotNil(t, query.Cursor) {
+					assert.Equal(t, uint(30), query.Cursor.ID)
+				}
+				if assert.NotNil(t, query.CreatedTo) {
+					// 日付のみの終了日は翌日0時未満として扱う
+					assert.Equal(t, 1, query.CreatedTo.Day())
+					assert.Equal(t, 2, int(query.CreatedTo.Month()))
+				}
+				return &blog.Page{
+					Blogs:      []blog.Blog{{ID: 31, Title: "Go 2"}, {ID: 32, Title: "Go 3"}},
+					HasNext:    true,
+					HasPrev:    true,
+					NextCursor: "next",
+					PrevCursor: "prev",
+				}, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTop(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
+
+		var response struct {
+			Meta struct {
+				Count      int    `json:"count"`
+				Limit      int    `json:"limit"`
+				HasNext    bool   `json:"hasNext"`
+				HasPrev    bool   `json:"hasPrev"`
+				NextCursor string `json:"nextCursor"`
+				PrevCursor string `json:"prevCursor"`
+			} `json:"meta"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, 2, response.Meta.Count)
+			assert.Equal(t, 2, response.Meta.Limit)
+			assert.True(t, response.Meta.HasNext)
+			assert.True(t, response.Meta.HasPrev)
+			assert.Equal(t, "next", response.Meta.NextCursor)
+			assert.Equal(t, "prev", response.Meta.PrevCursor)
+		}
+	})
+
+	t.Run("CursorSortMismatch", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		cursor := blog.NewCursor(&blog.Blog{ID: 30, Title: "Go"}, blog.SortByTitle, blog.OrderAsc, false).Encode()
+		req := httptest.NewRequest(http.MethodGet, "/?sort=created&cursor="+cursor, nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTop(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, ctx.Writer.Status())
+	})
 }
 
 func TestHomeController_GetMypage(t *testing.T) {
@@ -114,7 +263,7 @@ func TestHomeController_GetMypage(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodGet, "/blog/mypage", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
@@ -125,8 +274,8 @@ func TestHomeController_GetMypage(t *testing.T) {
 			{ID: 2, Title: "Test Blog 2"},
 		}
 		mockBlogUseCase.EXPECT().
-			FindBlogsByAuthorID(uint(123)).
-			Return(expectedBlogs, nil)
+			ListBlogs(gomock.Any()).
+			Return(&blog.Page{Blogs: expectedBlogs}, nil)
 
 		logger := zaptest.NewLogger(t)
 		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
@@ -140,9 +289,48 @@ func TestHomeController_GetMypage(t *testing.T) {
 		var response map[string]interface{}
 		err := json.Unmarshal(recorder.Body.Bytes(), &response)
 		if assert.NoError(t, err) {
-			assert.Equal(t, "ブログ記事を取得しました", response["message"])
-			assert.Equal(t, "BLOG_FETCHED", response["code"])
+			assert.Equal(t, "ユーザー情報を取得しました", response["message"])
+			assert.Equal(t, "USER_FETCHED", response["code"])
 			assert.Len(t, response["blogs"], 2)
+			assert.NotNil(t, response["meta"])
+		}
+	})
+
+	t.Run("WithReactions", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/blog/mypage", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			ListBlogs(gomock.Any()).
+			Return(&blog.Page{Blogs: []blog.Blog{
+				{ID: 1, Title: "Test Blog 1", Reactions: map[string]int{"👍": 3, "🎉": 1}},
+				{ID: 2, Title: "Test Blog 2"},
+			}}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetMypage(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
+		var response struct {
+			Blogs []struct {
+				ID        uint           `json:"id"`
+				Reactions map[string]int `json:"reactions"`
+			} `json:"blogs"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Blogs, 2) {
+			assert.Equal(t, map[string]int{"👍": 3, "🎉": 1}, response.Blogs[0].Reactions)
+			assert.Nil(t, response.Blogs[1].Reactions)
 		}
 	})
 
@@ -151,14 +339,14 @@ func TestHomeController_GetMypage(t *testing.T) {
 		ctx, _ := gin.CreateTestContext(recorder)
 		req := httptest.NewRequest(http.MethodGet, "/blog/mypage", nil)
 		ctx.Request = req
-		ctx.Set("userID", "user123")
+		ctx.Set("userID", "123")
 
 		mockSession := sessionMocks.NewMockSessionManager(ctrl)
 		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
 
 		// モック設定
 		mockBlogUseCase.EXPECT().
-			FindBlogsByAuthorID(uint(123)).
+			ListBlogs(gomock.Any()).
 			Return(nil, errors.New("fetch failed"))
 
 		logger := zaptest.NewLogger(t)
@@ -171,3 +359,107 @@ func TestHomeController_GetMypage(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, ctx.Writer.Status())
 	})
 }
+
+func TestHomeController_SearchBlogs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/blog/search?q=%E6%97%A5%E6%9C%AC%E8%AA%9E&author=7&limit=5", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			SearchBlogs(gomock.Any()).
+			DoAndReturn(func(query blog.SearchQuery) (*blog.SearchResult, error) {
+				assert.Equal(t, "日本語", query.Keyword)
+				assert.Equal(t, uint(7), query.AuthorID)
+				assert.Equal(t, 5, query.Limit)
+				return &blog.SearchResult{
+					Hits: []blog.SearchHit{
+						{Blog: blog.Blog{ID: 1, Title: "日本語の記事"}, Score: 1.5, Snippet: "<mark>日本語</mark>の本文"},
+					},
+					Total: 1,
+				}, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SearchBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, ctx.Writer.Status())
+
+		var response struct {
+			Code    string `json:"code"`
+			Results []struct {
+				ID      uint   `json:"id"`
+				Snippet string `json:"snippet"`
+			} `json:"results"`
+			Meta struct {
+				Total int64 `json:"total"`
+			} `json:"meta"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "BLOG_SEARCHED", response.Code)
+			if assert.Len(t, response.Results, 1) {
+				assert.Equal(t, "<mark>日本語</mark>の本文", response.Results[0].Snippet)
+			}
+			assert.Equal(t, int64(1), response.Meta.Total)
+		}
+	})
+
+	t.Run("KeywordRequired", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/blog/search", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SearchBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, ctx.Writer.Status())
+	})
+
+	t.Run("InvalidQuery", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodGet, "/blog/search?q=%22%22", nil)
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			SearchBlogs(gomock.Any()).
+			Return(nil, blog.ErrBlogInvalidQuery)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewHomeController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.SearchBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, ctx.Writer.Status())
+	})
+}
@@ -0,0 +1,103 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	usecaseStats "github.com/kazukimurahashi12/webapp/usecase/stats"
+	"go.uber.org/zap"
+)
+
+type PermalinkController struct {
+	blogUseCase    usecaseBlog.UseCase
+	statsUseCase   usecaseStats.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewPermalinkController(blogUseCase usecaseBlog.UseCase, statsUseCase usecaseStats.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *PermalinkController {
+	return &PermalinkController{
+		blogUseCase:    blogUseCase,
+		statsUseCase:   statsUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// 著者名とスラッグによるブログ記事詳細取得
+// 変更前のスラッグの場合は現在のスラッグのURLへ恒久的に転送する
+func (p *PermalinkController) GetBlogBySlug(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, p.logger)
+	if !ok {
+		return
+	}
+	username := c.Param("username")
+	slug := c.Param("slug")
+
+	// スラッグからブログ記事を取得UseCase
+	blog, moved, err := p.blogUseCase.FindBlogBySlug(userID, username, slug)
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
+		return
+	}
+	if moved {
+		p.logger.Info("Redirecting old blog slug",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", blog.ID),
+			zap.String("from", slug),
+			zap.String("to", blog.Slug))
+		c.Redirect(http.StatusMovedPermanently, permalinkPath(username, blog.Slug))
+		return
+	}
+
+	// 本文をHTMLと目次に変換
+	rendered, err := p.blogUseCase.RenderBlogContent(blog)
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事本文の変換に失敗しました", "BLOG_RENDER_FAILED")
+		return
+	}
+	recordView(c, p.statsUseCase, p.logger, requestID, blog, userID)
+
+	c.Header("ETag", versionETag(blog.Version))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事を取得しました",
+		"code":       "BLOG_FETCHED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogViewResponse(blog, rendered),
+	})
+}
+
+// 著者名とスラッグによるブログ記事のURLパス
+func permalinkPath(username, slug string) string {
+	return "/" + url.PathEscape(username) + "/" + slug
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (p *PermalinkController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, domainBlog.ErrBlogNotFound) {
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	}
+
+	p.logger.Error("Permalink request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,124 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	statsMocks "github.com/kazukimurahashi12/webapp/usecase/stats/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPermalinkController_GetBlogBySlug(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/alice/hello-world", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "slug", Value: "hello-world"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		found := &blog.Blog{ID: 10, AuthorID: 5, Title: "Hello World", Slug: "hello-world", Version: 2}
+		mockBlogUseCase.EXPECT().
+			FindBlogBySlug(uint(123), "alice", "hello-world").
+			Return(found, false, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(found).
+			Return(&blog.RenderedContent{HTML: "<p>Hello</p>\n"}, nil)
+		mockStatsUseCase.EXPECT().
+			RecordView(found, uint(123), gomock.Any()).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPermalinkController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogBySlug(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, `"2"`, recorder.Header().Get("ETag"))
+		var response struct {
+			Blog struct {
+				ID   uint   `json:"id"`
+				Slug string `json:"slug"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(10), response.Blog.ID)
+			assert.Equal(t, "hello-world", response.Blog.Slug)
+		}
+	})
+
+	t.Run("OldSlugRedirects", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/alice/old-title", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "slug", Value: "old-title"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			FindBlogBySlug(uint(123), "alice", "old-title").
+			Return(&blog.Blog{ID: 10, AuthorID: 5, Slug: "new-title"}, true, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPermalinkController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogBySlug(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusMovedPermanently, recorder.Code)
+		assert.Equal(t, "/alice/new-title", recorder.Header().Get("Location"))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/alice/missing", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "slug", Value: "missing"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			FindBlogBySlug(uint(123), "alice", "missing").
+			Return(nil, false, blog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPermalinkController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogBySlug(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		var response map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "BLOG_NOT_FOUND", response["code"])
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	usecaseStats "github.com/kazukimurahashi12/webapp/usecase/stats"
+	"go.uber.org/zap"
+)
+
+// 未ログインの閲覧者向けのブログ記事取得
+// 全体公開のブログ記事と、共有URLによる限定公開のブログ記事のみ返却する
+type PublicController struct {
+	blogUseCase    usecaseBlog.UseCase
+	statsUseCase   usecaseStats.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewPublicController(blogUseCase usecaseBlog.UseCase, statsUseCase usecaseStats.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *PublicController {
+	return &PublicController{
+		blogUseCase:    blogUseCase,
+		statsUseCase:   statsUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// IDによる全体公開のブログ記事詳細取得
+func (p *PublicController) GetPublicBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	id, ok := parseUintParam(c, p.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// 全体公開のブログ記事を取得UseCase
+	blog, err := p.blogUseCase.FindPublicBlog(id)
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
+		return
+	}
+	p.respondBlog(c, requestID, blog)
+}
+
+// 著者名とスラッグによる全体公開のブログ記事詳細取得
+// 変更前のスラッグの場合は現在のスラッグのURLへ恒久的に転送する
+func (p *PublicController) GetPublicBlogBySlug(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	username := c.Param("username")
+	slug := c.Param("slug")
+
+	// 未ログインの閲覧者としてスラッグからブログ記事を取得UseCase
+	blog, moved, err := p.blogUseCase.FindBlogBySlug(0, username, slug)
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
+		return
+	}
+	if moved {
+		p.logger.Info("Redirecting old public blog slug",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", blog.ID),
+			zap.String("from", slug),
+			zap.String("to", blog.Slug))
+		c.Redirect(http.StatusMovedPermanently, publicPermalinkPath(username, blog.Slug))
+		return
+	}
+	p.respondBlog(c, requestID, blog)
+}
+
+// 共有URLによるブログ記事詳細取得
+// 共有URLが検索エンジンや遷移先に漏れないよう応答ヘッダーで抑止する
+func (p *PublicController) GetSharedBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	c.Header("X-Robots-Tag", "noindex, nofollow")
+	c.Header("Referrer-Policy", "no-referrer")
+
+	// 共有URLのトークンからブログ記事を取得UseCase
+	blog, err := p.blogUseCase.FindSharedBlog(c.Param("token"))
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
+		return
+	}
+	p.respondBlog(c, requestID, blog)
+}
+
+// 本文を変換してブログ記事詳細を返却
+func (p *PublicController) respondBlog(c *gin.Context, requestID string, blog *domainBlog.Blog) {
+	// 本文をHTMLと目次に変換
+	rendered, err := p.blogUseCase.RenderBlogContent(blog)
+	if err != nil {
+		p.respondError(c, requestID, err, "ブログ記事本文の変換に失敗しました", "BLOG_RENDER_FAILED")
+		return
+	}
+	recordView(c, p.statsUseCase, p.logger, requestID, blog, common.OptionalLoginUserID(c, p.sessionManager))
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事を取得しました",
+		"code":       "BLOG_FETCHED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogViewResponse(blog, rendered),
+	})
+}
+
+// ブログ記事の閲覧を記録
+// 閲覧数の記録に失敗してもブログ記事の返却は継続する
+func recordView(c *gin.Context, statsUseCase usecaseStats.UseCase, logger *zap.Logger, requestID string, blog *domainBlog.Blog, viewerID uint) {
+	visitorID := domainStats.VisitorID(viewerID, c.ClientIP(), c.Request.UserAgent())
+	if err := statsUseCase.RecordView(blog, viewerID, visitorID); err != nil {
+		logger.Warn("Failed to record blog view",
+			zap.String("requestID", requestID),
+			zap.Uint("blogID", blog.ID),
+			zap.Error(err))
+	}
+}
+
+// 著者名とスラッグによる全体公開のブログ記事のURLパス
+func publicPermalinkPath(username, slug string) string {
+	return "/public/author/" + url.PathEscape(username) + "/" + slug
+}
+
+// 共有URLのパス
+func sharePath(token string) string {
+	return "/public/share/" + token
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+// 閲覧できないブログ記事は存在しない場合と区別しない
+func (p *PublicController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, domainBlog.ErrBlogNotFound) {
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	}
+
+	p.logger.Error("Public blog request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,281 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/domain/stats"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	statsMocks "github.com/kazukimurahashi12/webapp/usecase/stats/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPublicController_GetPublicBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/blog/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		publicBlog := &blog.Blog{
+			ID:         10,
+			AuthorID:   123,
+			Title:      "公開記事",
+			Content:    "本文",
+			Status:     blog.StatusPublished,
+			Visibility: blog.VisibilityPublic,
+			ShareToken: "SECRET",
+		}
+		mockBlogUseCase.EXPECT().
+			FindPublicBlog(uint(10)).
+			Return(publicBlog, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(publicBlog).
+			Return(&blog.RenderedContent{HTML: "<p>本文</p>\n"}, nil)
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockStatsUseCase.EXPECT().
+			RecordView(publicBlog, uint(0), gomock.Any()).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetPublicBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Blog struct {
+				ID         uint   `json:"id"`
+				Visibility string `json:"visibility"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(10), response.Blog.ID)
+			assert.Equal(t, blog.VisibilityPublic, response.Blog.Visibility)
+		}
+		// 共有URLのトークンは著者以外に返さない
+		assert.NotContains(t, recorder.Body.String(), "SECRET")
+	})
+
+	t.Run("RecordViewFailed", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/blog/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		publicBlog := &blog.Blog{ID: 10, AuthorID: 123, Status: blog.StatusPublished, Visibility: blog.VisibilityPublic}
+		mockBlogUseCase.EXPECT().
+			FindPublicBlog(uint(10)).
+			Return(publicBlog, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(publicBlog).
+			Return(&blog.RenderedContent{}, nil)
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockStatsUseCase.EXPECT().
+			RecordView(publicBlog, uint(0), gomock.Any()).
+			Return(stats.ErrViewCounterUnavailable)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetPublicBlog(ctx)
+
+		// 検証（閲覧数の記録に失敗しても記事は返却する）
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("LoggedInViewer", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/blog/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		publicBlog := &blog.Blog{ID: 10, AuthorID: 123, Status: blog.StatusPublished, Visibility: blog.VisibilityPublic}
+		mockBlogUseCase.EXPECT().
+			FindPublicBlog(uint(10)).
+			Return(publicBlog, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(publicBlog).
+			Return(&blog.RenderedContent{}, nil)
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("456", nil)
+		mockStatsUseCase.EXPECT().
+			RecordView(publicBlog, uint(456), gomock.Any()).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetPublicBlog(ctx)
+
+		// 検証（ログイン中の閲覧者はユーザーIDで閲覧を記録する）
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("NotPublic", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/blog/11", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "11"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			FindPublicBlog(uint(11)).
+			Return(nil, blog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetPublicBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestPublicController_GetPublicBlogBySlug(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("OldSlugRedirects", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/author/alice/old-title", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "slug", Value: "old-title"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定（未ログインの閲覧者として取得する）
+		mockBlogUseCase.EXPECT().
+			FindBlogBySlug(uint(0), "alice", "old-title").
+			Return(&blog.Blog{ID: 10, Slug: "new-title"}, true, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetPublicBlogBySlug(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusMovedPermanently, recorder.Code)
+		assert.Equal(t, "/public/author/alice/new-title", recorder.Header().Get("Location"))
+	})
+}
+
+func TestPublicController_GetSharedBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/share/TOKEN", nil)
+		ctx.Params = gin.Params{{Key: "token", Value: "TOKEN"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		unlistedBlog := &blog.Blog{
+			ID:         10,
+			AuthorID:   123,
+			Title:      "限定公開記事",
+			Status:     blog.StatusPublished,
+			Visibility: blog.VisibilityUnlisted,
+			ShareToken: "TOKEN",
+		}
+		mockBlogUseCase.EXPECT().
+			FindSharedBlog("TOKEN").
+			Return(unlistedBlog, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(unlistedBlog).
+			Return(&blog.RenderedContent{}, nil)
+		mockSession.EXPECT().
+			GetSession(ctx).
+			Return("", http.ErrNoCookie)
+		mockStatsUseCase.EXPECT().
+			RecordView(unlistedBlog, uint(0), gomock.Any()).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetSharedBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "noindex, nofollow", recorder.Header().Get("X-Robots-Tag"))
+		assert.Equal(t, "no-referrer", recorder.Header().Get("Referrer-Policy"))
+	})
+
+	t.Run("UnknownToken", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/public/share/WRONG", nil)
+		ctx.Params = gin.Params{{Key: "token", Value: "WRONG"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			FindSharedBlog("WRONG").
+			Return(nil, blog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublicController(mockBlogUseCase, mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetSharedBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
@@ -0,0 +1,198 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+type PublishController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewPublishController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *PublishController {
+	return &PublishController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ブログ記事の即時公開
+func (p *PublishController) PublishBlog(c *gin.Context) {
+	p.changeStatus(c, p.blogUseCase.PublishBlog, "ブログ記事を公開しました", "BLOG_PUBLISHED")
+}
+
+// ブログ記事の公開取り消し（下書きに戻す）
+func (p *PublishController) UnpublishBlog(c *gin.Context) {
+	p.changeStatus(c, p.blogUseCase.UnpublishBlog, "ブログ記事を下書きに戻しました", "BLOG_UNPUBLISHED")
+}
+
+// ブログ記事のアーカイブ
+func (p *PublishController) ArchiveBlog(c *gin.Context) {
+	p.changeStatus(c, p.blogUseCase.ArchiveBlog, "ブログ記事をアーカイブしました", "BLOG_ARCHIVED")
+}
+
+// ブログ記事の予約公開
+func (p *PublishController) ScheduleBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, p.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.BlogSchedule{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		p.logger.Error("Failed to bind JSON in blog scheduling",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "予約公開データの形式が不正です",
+			"code":       "INVALID_BLOG_SCHEDULE_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 予約公開UseCase
+	blog, err := p.blogUseCase.ScheduleBlog(userID, req.BlogID, req.PublishAt)
+	if err != nil {
+		p.respondError(c, requestID, err)
+		return
+	}
+
+	p.logger.Info("Successfully scheduled blog",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", blog.ID),
+		zap.Time("publishAt", req.PublishAt))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事の公開を予約しました",
+		"code":       "BLOG_SCHEDULED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogDetailResponse(blog),
+	})
+}
+
+// 限定公開用の共有URLを再発行
+// 以前の共有URLは無効になる
+func (p *PublishController) RegenerateShareLink(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, p.logger)
+	if !ok {
+		return
+	}
+	id, ok := parseUintParam(c, p.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// 共有URLのトークン再発行UseCase
+	blog, err := p.blogUseCase.RegenerateShareToken(userID, id)
+	if err != nil {
+		p.respondError(c, requestID, err)
+		return
+	}
+
+	response := mapper.ToBlogDetailResponse(blog)
+	response.ShareToken = blog.ShareToken
+	p.logger.Info("Successfully regenerated share link",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", blog.ID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "共有URLを再発行しました",
+		"code":       "SHARE_LINK_REGENERATED",
+		"request_id": requestID,
+		"blog":       response,
+		"share_url":  sharePath(blog.ShareToken),
+	})
+}
+
+// パスパラメータのブログ記事の公開ステータスを変更
+func (p *PublishController) changeStatus(c *gin.Context, change func(userID, id uint) (*domainBlog.Blog, error), message, code string) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, p.logger)
+	if !ok {
+		return
+	}
+
+	// ブログIDをリクエストから取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		p.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 公開ステータス変更UseCase
+	blog, err := change(userID, uint(id))
+	if err != nil {
+		p.respondError(c, requestID, err)
+		return
+	}
+
+	p.logger.Info("Successfully changed blog status",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", blog.ID),
+		zap.String("status", blog.Status))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    message,
+		"code":       code,
+		"request_id": requestID,
+		"blog":       mapper.ToBlogDetailResponse(blog),
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (p *PublishController) respondError(c *gin.Context, requestID string, err error) {
+	status, message, code := http.StatusInternalServerError, "ブログ記事の公開ステータスの変更に失敗しました", "BLOG_STATUS_CHANGE_FAILED"
+	switch {
+	case errors.Is(err, domainBlog.ErrBlogInvalidData):
+		status, message, code = http.StatusBadRequest, "公開日時には未来の日時を指定してください", "INVALID_PUBLISH_AT"
+	case errors.Is(err, domainBlog.ErrBlogPublishFailed):
+		status, message, code = http.StatusConflict, "現在の公開ステータスからは変更できません", "BLOG_STATUS_TRANSITION_INVALID"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を操作する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	p.logger.Error("Blog status request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,263 @@
+package blog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPublishController_PublishBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/publish/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		publishedAt := time.Now()
+		mockBlogUseCase.EXPECT().
+			PublishBlog(uint(123), uint(10)).
+			Return(&blog.Blog{ID: 10, AuthorID: 123, Status: blog.StatusPublished, PublishedAt: &publishedAt}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.PublishBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code string `json:"code"`
+			Blog struct {
+				Status string `json:"status"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "BLOG_PUBLISHED", response.Code)
+			assert.Equal(t, blog.StatusPublished, response.Blog.Status)
+		}
+	})
+
+	t.Run("InvalidTransition", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/publish/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			PublishBlog(uint(123), uint(10)).
+			Return(nil, fmt.Errorf("%w: already published", blog.ErrBlogPublishFailed))
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.PublishBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/unpublish/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			UnpublishBlog(uint(456), uint(10)).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.UnpublishBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestPublishController_ScheduleBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"publishAt":"2030-01-02T09:00:00+09:00"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/schedule", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		publishAt := time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)
+		mockBlogUseCase.EXPECT().
+			ScheduleBlog(uint(123), uint(10), gomock.Any()).
+			DoAndReturn(func(userID, id uint, at time.Time) (*blog.Blog, error) {
+				assert.True(t, at.Equal(publishAt))
+				return &blog.Blog{ID: 10, AuthorID: 123, Status: blog.StatusScheduled, PublishAt: &at}, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ScheduleBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("PastPublishAt", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"publishAt":"2000-01-01T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/schedule", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			ScheduleBlog(uint(123), uint(10), gomock.Any()).
+			Return(nil, fmt.Errorf("%w: publish time must be in the future", blog.ErrBlogInvalidData))
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ScheduleBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("MissingPublishAt", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/schedule", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ScheduleBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestPublishController_RegenerateShareLink(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/share/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RegenerateShareToken(uint(123), uint(10)).
+			Return(&blog.Blog{ID: 10, AuthorID: 123, Visibility: blog.VisibilityUnlisted, ShareToken: "NEWTOKEN"}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RegenerateShareLink(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			ShareURL string `json:"share_url"`
+			Blog     struct {
+				ShareToken string `json:"shareToken"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "/public/share/NEWTOKEN", response.ShareURL)
+			assert.Equal(t, "NEWTOKEN", response.Blog.ShareToken)
+		}
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/share/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RegenerateShareToken(uint(456), uint(10)).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewPublishController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RegenerateShareLink(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
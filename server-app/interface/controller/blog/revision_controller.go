@@ -0,0 +1,237 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+type RevisionController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewRevisionController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *RevisionController {
+	return &RevisionController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ブログ記事の更新履歴一覧取得
+func (r *RevisionController) ListRevisions(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, r.logger)
+	if !ok {
+		return
+	}
+	blogID, ok := parseUintParam(c, r.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// 更新履歴一覧取得UseCase
+	revisions, err := r.blogUseCase.ListRevisions(userID, blogID)
+	if err != nil {
+		r.respondError(c, requestID, err, "更新履歴の取得に失敗しました", "REVISION_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "更新履歴を取得しました",
+		"code":       "REVISION_LIST_FETCHED",
+		"request_id": requestID,
+		"revisions":  mapper.ToRevisionSummariesResponse(revisions),
+		"meta": gin.H{
+			"count":          len(revisions),
+			"currentVersion": revisions[0].Version,
+		},
+	})
+}
+
+// 指定バージョンの更新履歴取得
+func (r *RevisionController) GetRevision(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, r.logger)
+	if !ok {
+		return
+	}
+	blogID, ok := parseUintParam(c, r.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+	version, ok := parseUintParam(c, r.logger, requestID, "version", "バージョンの形式が不正です", "INVALID_REVISION_VERSION")
+	if !ok {
+		return
+	}
+
+	// 更新履歴取得UseCase
+	revision, err := r.blogUseCase.GetRevision(userID, blogID, version)
+	if err != nil {
+		r.respondError(c, requestID, err, "更新履歴の取得に失敗しました", "REVISION_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "更新履歴を取得しました",
+		"code":       "REVISION_FETCHED",
+		"request_id": requestID,
+		"revision":   mapper.ToRevisionResponse(revision),
+	})
+}
+
+// 2つのバージョン間の差分取得
+func (r *RevisionController) DiffRevisions(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, r.logger)
+	if !ok {
+		return
+	}
+	blogID, ok := parseUintParam(c, r.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// クエリパラメータから比較するバージョンを取得
+	req := dto.RevisionDiffQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		r.logger.Error("Invalid revision diff query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "比較するバージョンの指定が不正です",
+			"code":       "INVALID_REVISION_DIFF_QUERY",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 差分取得UseCase
+	diff, err := r.blogUseCase.DiffRevisions(userID, blogID, req.From, req.To)
+	if err != nil {
+		r.respondError(c, requestID, err, "更新履歴の差分取得に失敗しました", "REVISION_DIFF_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "更新履歴の差分を取得しました",
+		"code":       "REVISION_DIFF_FETCHED",
+		"request_id": requestID,
+		"diff":       mapper.ToRevisionDiffResponse(diff),
+	})
+}
+
+// 過去のバージョンの復元
+func (r *RevisionController) RestoreRevision(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, r.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.RevisionRestore{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		r.logger.Error("Failed to bind JSON in revision restore",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "復元データの形式が不正です",
+			"code":       "INVALID_REVISION_RESTORE_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 更新履歴復元UseCase
+	blog, err := r.blogUseCase.RestoreRevision(userID, req.BlogID, req.Version)
+	if err != nil {
+		r.respondError(c, requestID, err, "更新履歴の復元に失敗しました", "REVISION_RESTORE_FAILED")
+		return
+	}
+
+	r.logger.Info("Successfully restored blog revision",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", blog.ID),
+		zap.Uint("restoredVersion", req.Version),
+		zap.Uint("version", blog.Version))
+	c.Header("ETag", versionETag(blog.Version))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "更新履歴を復元しました",
+		"code":       "REVISION_RESTORED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogDetailResponse(blog),
+	})
+}
+
+// パスパラメータを数値として取得
+// 不正な形式の場合はエラーレスポンスを返却しfalseを返す
+func parseUintParam(c *gin.Context, logger *zap.Logger, requestID, name, message, code string) (uint, bool) {
+	value := c.Param(name)
+	parsed, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		logger.Error("Invalid path parameter format",
+			zap.String("requestID", requestID),
+			zap.String(name, value),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      message,
+			"code":       code,
+			"request_id": requestID,
+		})
+		return 0, false
+	}
+	return uint(parsed), true
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (r *RevisionController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainBlog.ErrRevisionNotFound):
+		status, message, code = http.StatusNotFound, "指定されたバージョンの更新履歴が存在しません", "REVISION_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogInvalidData):
+		status, message, code = http.StatusBadRequest, "現在のバージョンは復元できません", "REVISION_ALREADY_CURRENT"
+	case errors.Is(err, domainBlog.ErrBlogVersionConflict):
+		status, message, code = http.StatusConflict, "ブログ記事が他の編集により更新されています", "BLOG_VERSION_CONFLICT"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を操作する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	r.logger.Error("Revision request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,265 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRevisionController_ListRevisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/revision/list/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			ListRevisions(uint(123), uint(10)).
+			Return([]blog.Revision{
+				{BlogID: 10, Version: 3, Title: "現在のタイトル"},
+				{BlogID: 10, Version: 2, Title: "以前のタイトル"},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListRevisions(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Revisions []struct {
+				Version uint `json:"version"`
+			} `json:"revisions"`
+			Meta struct {
+				CurrentVersion uint `json:"currentVersion"`
+			} `json:"meta"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Len(t, response.Revisions, 2)
+			assert.Equal(t, uint(3), response.Meta.CurrentVersion)
+		}
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/revision/list/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			ListRevisions(uint(456), uint(10)).
+			Return(nil, blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListRevisions(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestRevisionController_GetRevision(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("NotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/revision/view/10/99", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}, {Key: "version", Value: "99"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetRevision(uint(123), uint(10), uint(99)).
+			Return(nil, blog.ErrRevisionNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetRevision(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestRevisionController_DiffRevisions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/revision/diff/10?from=1&to=2", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		from := &blog.Revision{BlogID: 10, Version: 1, Title: "Go入門", Content: "一行目\n今日は晴れです\n三行目"}
+		to := &blog.Revision{BlogID: 10, Version: 2, Title: "Go入門", Content: "一行目\n今日は雨です\n三行目\n四行目"}
+		mockBlogUseCase.EXPECT().
+			DiffRevisions(uint(123), uint(10), uint(1), uint(2)).
+			Return(&blog.RevisionDiff{
+				From:  from,
+				To:    to,
+				Title: blog.DiffWords(from.Title, to.Title),
+				Lines: blog.DiffLines(from.Content, to.Content),
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.DiffRevisions(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Diff struct {
+				Lines []struct {
+					Op       string `json:"op"`
+					Text     string `json:"text"`
+					Segments []struct {
+						Op   string `json:"op"`
+						Text string `json:"text"`
+					} `json:"segments"`
+				} `json:"lines"`
+			} `json:"diff"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			lines := response.Diff.Lines
+			if assert.Len(t, lines, 5) {
+				assert.Equal(t, "equal", lines[0].Op)
+				assert.Equal(t, "delete", lines[1].Op)
+				assert.Equal(t, "insert", lines[2].Op)
+				assert.Equal(t, "今日は雨です", lines[2].Text)
+				assert.Contains(t, lines[2].Segments, struct {
+					Op   string `json:"op"`
+					Text string `json:"text"`
+				}{Op: "insert", Text: "雨"})
+				assert.Equal(t, "equal", lines[3].Op)
+				assert.Equal(t, "insert", lines[4].Op)
+			}
+		}
+	})
+
+	t.Run("MissingVersion", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/revision/diff/10?from=1", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.DiffRevisions(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestRevisionController_RestoreRevision(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"version":1}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/revision/restore", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RestoreRevision(uint(123), uint(10), uint(1)).
+			Return(&blog.Blog{ID: 10, AuthorID: 123, Title: "復元したタイトル", Version: 4}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RestoreRevision(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, `"4"`, recorder.Header().Get("ETag"))
+	})
+
+	t.Run("CurrentVersion", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"version":4}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/revision/restore", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RestoreRevision(uint(123), uint(10), uint(4)).
+			Return(nil, blog.ErrBlogInvalidData)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRevisionController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RestoreRevision(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
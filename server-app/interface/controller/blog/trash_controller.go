@@ -0,0 +1,144 @@
+package blog
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+type TrashController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewTrashController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *TrashController {
+	return &TrashController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ゴミ箱内のブログ記事一覧取得
+func (t *TrashController) ListTrash(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, t.logger)
+	if !ok {
+		return
+	}
+
+	// ゴミ箱一覧取得UseCase
+	trashed, err := t.blogUseCase.ListTrash(userID)
+	if err != nil {
+		t.respondError(c, requestID, err, "ゴミ箱の取得に失敗しました", "TRASH_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ゴミ箱を取得しました",
+		"code":       "TRASH_FETCHED",
+		"request_id": requestID,
+		"blogs":      mapper.ToTrashedBlogsResponse(trashed),
+		"meta": gin.H{
+			"count": len(trashed),
+		},
+	})
+}
+
+// ゴミ箱内のブログ記事を元に戻す
+func (t *TrashController) RestoreBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, t.logger)
+	if !ok {
+		return
+	}
+	id, ok := parseUintParam(c, t.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// ゴミ箱から復元UseCase
+	blog, err := t.blogUseCase.RestoreBlog(userID, id)
+	if err != nil {
+		t.respondError(c, requestID, err, "ブログ記事の復元に失敗しました", "BLOG_RESTORE_FAILED")
+		return
+	}
+
+	t.logger.Info("Successfully restored blog from trash",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", blog.ID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事をゴミ箱から元に戻しました",
+		"code":       "BLOG_RESTORED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogDetailResponse(blog),
+	})
+}
+
+// ゴミ箱内のブログ記事を完全に削除
+func (t *TrashController) PurgeBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, t.logger)
+	if !ok {
+		return
+	}
+	id, ok := parseUintParam(c, t.logger, requestID, "id", "ブログIDの形式が不正です", "INVALID_BLOG_ID")
+	if !ok {
+		return
+	}
+
+	// 完全削除UseCase
+	if err := t.blogUseCase.PurgeBlog(userID, id); err != nil {
+		t.respondError(c, requestID, err, "ブログ記事の完全削除に失敗しました", "BLOG_PURGE_FAILED")
+		return
+	}
+
+	t.logger.Info("Successfully purged blog",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", id))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事を完全に削除しました",
+		"code":       "BLOG_PURGED",
+		"request_id": requestID,
+		"blog_id":    id,
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (t *TrashController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事がゴミ箱に存在しません", "TRASHED_BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を操作する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	t.logger.Error("Trash request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,198 @@
+package blog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTrashController_ListTrash(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/trash", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		deletedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+		mockBlogUseCase.EXPECT().
+			ListTrash(uint(123)).
+			Return([]blog.TrashedBlog{
+				{
+					Blog:    blog.Blog{ID: 10, AuthorID: 123, Title: "削除した記事", DeletedAt: &deletedAt},
+					PurgeAt: deletedAt.Add(blog.DefaultTrashRetention),
+				},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListTrash(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Blogs []struct {
+				ID        uint   `json:"id"`
+				DeletedAt string `json:"deleted_at"`
+				PurgeAt   string `json:"purge_at"`
+			} `json:"blogs"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Blogs, 1) {
+			assert.Equal(t, uint(10), response.Blogs[0].ID)
+			assert.Equal(t, "2024-05-01T09:00:00Z", response.Blogs[0].DeletedAt)
+			assert.Equal(t, "2024-05-31T09:00:00Z", response.Blogs[0].PurgeAt)
+		}
+	})
+}
+
+func TestTrashController_RestoreBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/trash/restore/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RestoreBlog(uint(123), uint(10)).
+			Return(&blog.Blog{ID: 10, AuthorID: 123, Title: "削除した記事", Version: 2}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RestoreBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "BLOG_RESTORED")
+	})
+
+	t.Run("NotInTrash", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/trash/restore/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			RestoreBlog(uint(123), uint(10)).
+			Return(nil, blog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.RestoreBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestTrashController_PurgeBlog(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/trash/purge/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			PurgeBlog(uint(123), uint(10)).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.PurgeBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/trash/purge/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			PurgeBlog(uint(456), uint(10)).
+			Return(blog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.PurgeBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("InvalidID", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/blog/trash/purge/abc", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "abc"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTrashController(mockBlogUseCase, mockSession, logger)
+
+		// 実行
+		controller.PurgeBlog(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -0,0 +1,407 @@
+package category
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseCategory "github.com/kazukimurahashi12/webapp/usecase/category"
+	"go.uber.org/zap"
+)
+
+type CategoryController struct {
+	categoryUseCase usecaseCategory.UseCase
+	sessionManager  session.SessionManager
+	logger          *zap.Logger
+}
+
+func NewCategoryController(categoryUseCase usecaseCategory.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *CategoryController {
+	return &CategoryController{
+		categoryUseCase: categoryUseCase,
+		sessionManager:  sessionManager,
+		logger:          logger,
+	}
+}
+
+// カテゴリ一覧をツリー構造で取得
+func (cc *CategoryController) GetCategoryTree(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	tree, err := cc.categoryUseCase.GetCategoryTree(userID)
+	if err != nil {
+		cc.logger.Error("Failed to get category tree",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":      "カテゴリ一覧の取得に失敗しました",
+			"code":       "CATEGORY_FETCH_FAILED",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCategoriesResponse(tree)
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "カテゴリ一覧を取得しました",
+		"code":       "CATEGORY_FETCHED",
+		"request_id": requestID,
+		"categories": response,
+	})
+}
+
+// カテゴリ登録
+func (cc *CategoryController) PostCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CategoryPost{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in category creation",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリ登録データの形式が不正です",
+			"code":       "INVALID_CATEGORY_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ登録処理UseCase
+	category, err := cc.categoryUseCase.CreateCategory(userID, req.Name, req.Description, req.ParentID)
+	if err != nil {
+		cc.respondError(c, requestID, err, "カテゴリの登録に失敗しました", "CATEGORY_CREATION_FAILED")
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCategoryResponse(category)
+	cc.logger.Info("Successfully created category",
+		zap.String("requestID", requestID),
+		zap.Any("category", response))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "カテゴリを登録しました",
+		"code":       "CATEGORY_CREATED",
+		"request_id": requestID,
+		"category":   response,
+	})
+}
+
+// カテゴリ名変更
+func (cc *CategoryController) RenameCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CategoryRename{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in category rename",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリ名変更データの形式が不正です",
+			"code":       "INVALID_CATEGORY_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ名変更処理UseCase
+	category, err := cc.categoryUseCase.RenameCategory(userID, req.ID, req.Name)
+	if err != nil {
+		cc.respondError(c, requestID, err, "カテゴリ名の変更に失敗しました", "CATEGORY_UPDATE_FAILED")
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCategoryResponse(category)
+	cc.logger.Info("Successfully renamed category",
+		zap.String("requestID", requestID),
+		zap.Any("category", response))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "カテゴリ名を変更しました",
+		"code":       "CATEGORY_UPDATED",
+		"request_id": requestID,
+		"category":   response,
+	})
+}
+
+// カテゴリ移動（親カテゴリ変更）
+func (cc *CategoryController) MoveCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CategoryMove{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in category move",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリ移動データの形式が不正です",
+			"code":       "INVALID_CATEGORY_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ移動処理UseCase
+	category, err := cc.categoryUseCase.MoveCategory(userID, req.ID, req.ParentID)
+	if err != nil {
+		cc.respondError(c, requestID, err, "カテゴリの移動に失敗しました", "CATEGORY_MOVE_FAILED")
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCategoryResponse(category)
+	cc.logger.Info("Successfully moved category",
+		zap.String("requestID", requestID),
+		zap.Any("category", response))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "カテゴリを移動しました",
+		"code":       "CATEGORY_MOVED",
+		"request_id": requestID,
+		"category":   response,
+	})
+}
+
+// カテゴリ削除
+func (cc *CategoryController) DeleteCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// カテゴリIDをリクエストから取得
+	idStr := c.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		cc.logger.Error("Invalid category ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリIDの形式が不正です",
+			"code":       "INVALID_CATEGORY_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ削除処理UseCase
+	if err := cc.categoryUseCase.DeleteCategory(userID, uint(id)); err != nil {
+		cc.respondError(c, requestID, err, "カテゴリの削除に失敗しました", "CATEGORY_DELETION_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully deleted category",
+		zap.String("requestID", requestID),
+		zap.Uint64("id", id))
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "カテゴリを削除しました",
+		"code":        "CATEGORY_DELETED",
+		"request_id":  requestID,
+		"category_id": id,
+	})
+}
+
+// ブログに紐づくカテゴリ一覧取得
+func (cc *CategoryController) GetBlogCategories(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// ブログIDをリクエストから取得
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		cc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	categories, err := cc.categoryUseCase.FindCategoriesByBlogID(userID, uint(blogID))
+	if err != nil {
+		cc.respondError(c, requestID, err, "カテゴリ一覧の取得に失敗しました", "CATEGORY_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "カテゴリ一覧を取得しました",
+		"code":       "CATEGORY_FETCHED",
+		"request_id": requestID,
+		"categories": mapper.ToCategoriesResponse(categories),
+	})
+}
+
+// ブログへのカテゴリ紐付け
+func (cc *CategoryController) AssignBlogCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.BlogCategoryAssign{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in category assignment",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリ紐付けデータの形式が不正です",
+			"code":       "INVALID_CATEGORY_ASSIGN_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ紐付け処理UseCase
+	if err := cc.categoryUseCase.AssignBlog(userID, req.BlogID, req.CategoryID); err != nil {
+		cc.respondError(c, requestID, err, "カテゴリの紐付けに失敗しました", "CATEGORY_ASSIGN_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully assigned category to blog",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Uint("categoryID", req.CategoryID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "カテゴリを紐付けました",
+		"code":        "CATEGORY_ASSIGNED",
+		"request_id":  requestID,
+		"blog_id":     req.BlogID,
+		"category_id": req.CategoryID,
+	})
+}
+
+// ブログからのカテゴリ紐付け解除
+func (cc *CategoryController) UnassignBlogCategory(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.BlogCategoryAssign{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in category unassignment",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリ紐付け解除データの形式が不正です",
+			"code":       "INVALID_CATEGORY_ASSIGN_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// カテゴリ紐付け解除処理UseCase
+	if err := cc.categoryUseCase.UnassignBlog(userID, req.BlogID, req.CategoryID); err != nil {
+		cc.respondError(c, requestID, err, "カテゴリの紐付け解除に失敗しました", "CATEGORY_UNASSIGN_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully unassigned category from blog",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Uint("categoryID", req.CategoryID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "カテゴリの紐付けを解除しました",
+		"code":        "CATEGORY_UNASSIGNED",
+		"request_id":  requestID,
+		"blog_id":     req.BlogID,
+		"category_id": req.CategoryID,
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (cc *CategoryController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainCategory.ErrCategoryInvalidData):
+		status, message, code = http.StatusBadRequest, err.Error(), "INVALID_CATEGORY_ENTITY"
+	case errors.Is(err, domainCategory.ErrCategoryNotFound):
+		status, message, code = http.StatusNotFound, "指定されたカテゴリが存在しません", "CATEGORY_NOT_FOUND"
+	case errors.Is(err, domainCategory.ErrCategoryAlreadyExists):
+		status, message, code = http.StatusConflict, "同じ名前のカテゴリが既に存在します", "CATEGORY_ALREADY_EXISTS"
+	case errors.Is(err, domainCategory.ErrCategoryCycle):
+		status, message, code = http.StatusConflict, "カテゴリを自身または子孫カテゴリの配下に移動することはできません", "CATEGORY_CYCLE_DETECTED"
+	case errors.Is(err, domainCategory.ErrCategoryHasChildren):
+		status, message, code = http.StatusConflict, "子カテゴリが存在するため削除できません", "CATEGORY_HAS_CHILDREN"
+	case errors.Is(err, domainCategory.ErrCategoryUnauthorized):
+		status, message, code = http.StatusForbidden, "このカテゴリを変更する権限がありません", "CATEGORY_ACCESS_DENIED"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を編集する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	cc.logger.Error("Category request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
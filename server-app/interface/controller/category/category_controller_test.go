@@ -0,0 +1,369 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	categoryMocks "github.com/kazukimurahashi12/webapp/usecase/category/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCategoryController_GetCategoryTree(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/category/tree", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		parentID := uint(1)
+		tree := []domainCategory.Category{
+			{ID: 1, Name: "Go", Children: []domainCategory.Category{
+				{ID: 2, Name: "Gin", ParentID: &parentID},
+			}},
+		}
+		mockCategoryUseCase.EXPECT().
+			GetCategoryTree(uint(123)).
+			Return(tree, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetCategoryTree(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code       string `json:"code"`
+			Categories []struct {
+				ID       uint `json:"id"`
+				Children []struct {
+					ID       uint  `json:"id"`
+					ParentID *uint `json:"parentId"`
+				} `json:"children"`
+			} `json:"categories"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "CATEGORY_FETCHED", response.Code)
+			if assert.Len(t, response.Categories, 1) && assert.Len(t, response.Categories[0].Children, 1) {
+				assert.Equal(t, uint(2), response.Categories[0].Children[0].ID)
+				assert.Equal(t, uint(1), *response.Categories[0].Children[0].ParentID)
+			}
+		}
+	})
+}
+
+func TestCategoryController_PostCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"name":"Go","description":"Go言語"}`
+		req := httptest.NewRequest(http.MethodPost, "/category/post", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			CreateCategory(uint(123), "Go", "Go言語", nil).
+			Return(&domainCategory.Category{ID: 1, Name: "Go", Description: "Go言語"}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.PostCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "CATEGORY_CREATED", response["code"])
+		}
+	})
+
+	t.Run("AlreadyExists", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"name":"Go"}`
+		req := httptest.NewRequest(http.MethodPost, "/category/post", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			CreateCategory(uint(123), "Go", "", nil).
+			Return(nil, domainCategory.ErrCategoryAlreadyExists)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.PostCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+	})
+
+	t.Run("InvalidRequest", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/category/post", strings.NewReader(`{"invalid":"data"}`))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.PostCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestCategoryController_MoveCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("CycleDetected", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":1,"parentId":2}`
+		req := httptest.NewRequest(http.MethodPost, "/category/move", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			MoveCategory(uint(123), uint(1), gomock.Any()).
+			Return(nil, domainCategory.ErrCategoryCycle)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.MoveCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+		var response map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "CATEGORY_CYCLE_DETECTED", response["code"])
+		}
+	})
+}
+
+func TestCategoryController_RenameCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":1,"name":"Golang"}`
+		req := httptest.NewRequest(http.MethodPost, "/category/rename", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			RenameCategory(uint(123), uint(1), "Golang").
+			Return(&domainCategory.Category{ID: 1, UserID: 123, Name: "Golang"}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.RenameCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"id":1,"name":"Golang"}`
+		req := httptest.NewRequest(http.MethodPost, "/category/rename", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			RenameCategory(uint(456), uint(1), "Golang").
+			Return(nil, domainCategory.ErrCategoryUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.RenameCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		var response map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "CATEGORY_ACCESS_DENIED", response["code"])
+		}
+	})
+}
+
+func TestCategoryController_DeleteCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/category/delete/1", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			DeleteCategory(uint(456), uint(1)).
+			Return(domainCategory.ErrCategoryUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.DeleteCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestCategoryController_AssignBlogCategory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"categoryId":1}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/category/assign", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			AssignBlog(uint(123), uint(10), uint(1)).
+			Return(nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.AssignBlogCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"categoryId":1}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/category/assign", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCategoryUseCase.EXPECT().
+			AssignBlog(uint(456), uint(10), uint(1)).
+			Return(domainBlog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.AssignBlogCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("UserIDNotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/blog/category/assign", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		// userIDを設定しない
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCategoryUseCase := categoryMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCategoryController(mockCategoryUseCase, mockSession, logger)
+
+		// 実行
+		controller.AssignBlogCategory(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
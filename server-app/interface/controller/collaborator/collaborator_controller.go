@@ -0,0 +1,293 @@
+package collaborator
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseCollaborator "github.com/kazukimurahashi12/webapp/usecase/collaborator"
+	"go.uber.org/zap"
+)
+
+type CollaboratorController struct {
+	collaboratorUseCase usecaseCollaborator.UseCase
+	sessionManager      session.SessionManager
+	logger              *zap.Logger
+}
+
+func NewCollaboratorController(collaboratorUseCase usecaseCollaborator.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *CollaboratorController {
+	return &CollaboratorController{
+		collaboratorUseCase: collaboratorUseCase,
+		sessionManager:      sessionManager,
+		logger:              logger,
+	}
+}
+
+// 共同編集者の招待
+func (cc *CollaboratorController) InviteCollaborator(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CollaboratorInvite{}
+	if !cc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// 共同編集者招待UseCase
+	collaborator, err := cc.collaboratorUseCase.InviteCollaborator(userID, req.BlogID, req.UserID, req.Role)
+	if err != nil {
+		cc.respondError(c, requestID, err, "共同編集者の招待に失敗しました", "COLLABORATOR_INVITE_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully invited collaborator",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Uint("inviteeID", req.UserID),
+		zap.String("role", req.Role))
+	c.JSON(http.StatusCreated, gin.H{
+		"message":      "共同編集者を招待しました",
+		"code":         "COLLABORATOR_INVITED",
+		"request_id":   requestID,
+		"collaborator": mapper.ToCollaboratorResponse(collaborator),
+	})
+}
+
+// ブログの共同編集者一覧取得
+func (cc *CollaboratorController) ListCollaborators(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		cc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// 共同編集者一覧取得UseCase
+	collaborators, err := cc.collaboratorUseCase.ListCollaborators(userID, uint(blogID))
+	if err != nil {
+		cc.respondError(c, requestID, err, "共同編集者の取得に失敗しました", "COLLABORATOR_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "共同編集者を取得しました",
+		"code":          "COLLABORATOR_FETCHED",
+		"request_id":    requestID,
+		"collaborators": mapper.ToCollaboratorsResponse(collaborators),
+	})
+}
+
+// 共同編集者の削除
+// 共同編集者自身を指定した場合は共同編集から外れる
+func (cc *CollaboratorController) RemoveCollaborator(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CollaboratorRemove{}
+	if !cc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// 共同編集者削除UseCase
+	if err := cc.collaboratorUseCase.RemoveCollaborator(userID, req.BlogID, req.UserID); err != nil {
+		cc.respondError(c, requestID, err, "共同編集者の削除に失敗しました", "COLLABORATOR_REMOVE_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully removed collaborator",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Uint("collaboratorID", req.UserID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "共同編集者を削除しました",
+		"code":       "COLLABORATOR_REMOVED",
+		"request_id": requestID,
+	})
+}
+
+// 自身宛ての招待一覧取得
+func (cc *CollaboratorController) ListInvitations(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// 招待一覧取得UseCase
+	invitations, err := cc.collaboratorUseCase.ListInvitations(userID)
+	if err != nil {
+		cc.respondError(c, requestID, err, "招待の取得に失敗しました", "INVITATION_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":     "招待を取得しました",
+		"code":        "INVITATION_FETCHED",
+		"request_id":  requestID,
+		"invitations": mapper.ToCollaboratorsResponse(invitations),
+	})
+}
+
+// 招待の承諾・辞退
+func (cc *CollaboratorController) RespondInvitation(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.InvitationAnswer{}
+	if !cc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// 招待回答UseCase
+	if err := cc.collaboratorUseCase.RespondInvitation(userID, req.BlogID, *req.Accept); err != nil {
+		cc.respondError(c, requestID, err, "招待への回答に失敗しました", "INVITATION_RESPOND_FAILED")
+		return
+	}
+
+	message, code := "招待を辞退しました", "INVITATION_DECLINED"
+	if *req.Accept {
+		message, code = "招待を承諾しました", "INVITATION_ACCEPTED"
+	}
+	cc.logger.Info("Successfully responded invitation",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Bool("accept", *req.Accept))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
+
+// ブログの所有権の移譲
+func (cc *CollaboratorController) TransferOwnership(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.OwnershipTransfer{}
+	if !cc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// 所有権移譲UseCase
+	blog, err := cc.collaboratorUseCase.TransferOwnership(userID, req.BlogID, req.NewOwnerID)
+	if err != nil {
+		cc.respondError(c, requestID, err, "所有権の移譲に失敗しました", "OWNERSHIP_TRANSFER_FAILED")
+		return
+	}
+
+	cc.logger.Info("Successfully transferred blog ownership",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Uint("from", userID),
+		zap.Uint("to", req.NewOwnerID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "所有権を移譲しました",
+		"code":       "OWNERSHIP_TRANSFERRED",
+		"request_id": requestID,
+		"blog":       mapper.ToBlogDetailResponse(blog),
+	})
+}
+
+// JSON形式のリクエストボディを構造体にバインド
+func (cc *CollaboratorController) bindJSON(c *gin.Context, requestID string, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		cc.logger.Error("Failed to bind JSON in collaborator request",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "共同編集者データの形式が不正です",
+			"code":       "INVALID_COLLABORATOR_FORMAT",
+			"request_id": requestID,
+		})
+		return false
+	}
+	return true
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (cc *CollaboratorController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainBlog.ErrCollaboratorInvalid):
+		status, message, code = http.StatusBadRequest, "共同編集者の指定が不正です", "INVALID_COLLABORATOR"
+	case errors.Is(err, domainBlog.ErrCollaboratorExists):
+		status, message, code = http.StatusConflict, "既に共同編集者として招待されています", "COLLABORATOR_EXISTS"
+	case errors.Is(err, domainBlog.ErrCollaboratorNotFound):
+		status, message, code = http.StatusNotFound, "指定された共同編集者・招待が存在しません", "COLLABORATOR_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrSlugAlreadyExists):
+		status, message, code = http.StatusConflict, "移譲先のユーザーに同じスラッグのブログ記事が存在します", "SLUG_ALREADY_EXISTS"
+	case errors.Is(err, domainUser.ErrUserNotFound):
+		status, message, code = http.StatusNotFound, "指定されたユーザーが存在しません", "USER_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事の共同編集者を管理する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	cc.logger.Error("Collaborator request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,247 @@
+package collaborator
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	collaboratorMocks "github.com/kazukimurahashi12/webapp/usecase/collaborator/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCollaboratorController_InviteCollaborator(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(m *collaboratorMocks.MockUseCase)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Success",
+			body: `{"blogId":10,"userId":456,"role":"editor"}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().
+					InviteCollaborator(uint(123), uint(10), uint(456), domainBlog.RoleEditor).
+					Return(&domainBlog.Collaborator{
+						BlogID:    10,
+						UserID:    456,
+						Username:  "hanako",
+						Role:      domainBlog.RoleEditor,
+						Status:    domainBlog.InvitationPending,
+						InvitedBy: 123,
+					}, nil)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: `"status":"pending"`,
+		},
+		{
+			name:         "InvalidRole",
+			body:         `{"blogId":10,"userId":456,"role":"owner"}`,
+			setupMock:    func(m *collaboratorMocks.MockUseCase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "INVALID_COLLABORATOR_FORMAT",
+		},
+		{
+			name: "AlreadyInvited",
+			body: `{"blogId":10,"userId":456,"role":"viewer"}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().
+					InviteCollaborator(uint(123), uint(10), uint(456), domainBlog.RoleViewer).
+					Return(nil, domainBlog.ErrCollaboratorExists)
+			},
+			expectedCode: http.StatusConflict,
+			expectedBody: "COLLABORATOR_EXISTS",
+		},
+		{
+			name: "NotOwner",
+			body: `{"blogId":10,"userId":456,"role":"viewer"}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().
+					InviteCollaborator(uint(123), uint(10), uint(456), domainBlog.RoleViewer).
+					Return(nil, domainBlog.ErrBlogUnauthorized)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedBody: "BLOG_ACCESS_DENIED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+			req := httptest.NewRequest(http.MethodPost, "/blog/collaborator/invite", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = req
+			ctx.Set("userID", "123")
+
+			mockSession := sessionMocks.NewMockSessionManager(ctrl)
+			mockCollaboratorUseCase := collaboratorMocks.NewMockUseCase(ctrl)
+			tt.setupMock(mockCollaboratorUseCase)
+
+			logger := zaptest.NewLogger(t)
+			controller := NewCollaboratorController(mockCollaboratorUseCase, mockSession, logger)
+
+			// 実行
+			controller.InviteCollaborator(ctx)
+
+			// 検証
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestCollaboratorController_RespondInvitation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name         string
+		body         string
+		setupMock    func(m *collaboratorMocks.MockUseCase)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name: "Accept",
+			body: `{"blogId":10,"accept":true}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().RespondInvitation(uint(123), uint(10), true).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "INVITATION_ACCEPTED",
+		},
+		{
+			name: "Decline",
+			body: `{"blogId":10,"accept":false}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().RespondInvitation(uint(123), uint(10), false).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "INVITATION_DECLINED",
+		},
+		{
+			name:         "MissingAccept",
+			body:         `{"blogId":10}`,
+			setupMock:    func(m *collaboratorMocks.MockUseCase) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "INVALID_COLLABORATOR_FORMAT",
+		},
+		{
+			name: "NoInvitation",
+			body: `{"blogId":11,"accept":true}`,
+			setupMock: func(m *collaboratorMocks.MockUseCase) {
+				m.EXPECT().RespondInvitation(uint(123), uint(11), true).Return(domainBlog.ErrCollaboratorNotFound)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "COLLABORATOR_NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+			req := httptest.NewRequest(http.MethodPost, "/collaborator/respond", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			ctx.Request = req
+			ctx.Set("userID", "123")
+
+			mockSession := sessionMocks.NewMockSessionManager(ctrl)
+			mockCollaboratorUseCase := collaboratorMocks.NewMockUseCase(ctrl)
+			tt.setupMock(mockCollaboratorUseCase)
+
+			logger := zaptest.NewLogger(t)
+			controller := NewCollaboratorController(mockCollaboratorUseCase, mockSession, logger)
+
+			// 実行
+			controller.RespondInvitation(ctx)
+
+			// 検証
+			assert.Equal(t, tt.expectedCode, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestCollaboratorController_TransferOwnership(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/blog/transfer", strings.NewReader(`{"blogId":10,"newOwnerId":456}`))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCollaboratorUseCase := collaboratorMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCollaboratorUseCase.EXPECT().
+			TransferOwnership(uint(123), uint(10), uint(456)).
+			Return(&domainBlog.Blog{ID: 10, AuthorID: 456, Title: "引き継ぎ記事"}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCollaboratorController(mockCollaboratorUseCase, mockSession, logger)
+
+		// 実行
+		controller.TransferOwnership(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Blog struct {
+				ID       uint `json:"id"`
+				AuthorID uint `json:"authorId"`
+			} `json:"blog"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(10), response.Blog.ID)
+			assert.Equal(t, uint(456), response.Blog.AuthorID)
+		}
+	})
+
+	t.Run("SlugConflict", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		req := httptest.NewRequest(http.MethodPost, "/blog/transfer", strings.NewReader(`{"blogId":10,"newOwnerId":456}`))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCollaboratorUseCase := collaboratorMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCollaboratorUseCase.EXPECT().
+			TransferOwnership(uint(123), uint(10), uint(456)).
+			Return(nil, domainBlog.ErrSlugAlreadyExists)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCollaboratorController(mockCollaboratorUseCase, mockSession, logger)
+
+		// 実行
+		controller.TransferOwnership(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "SLUG_ALREADY_EXISTS")
+	})
+}
@@ -0,0 +1,224 @@
+package comment
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainComment "github.com/kazukimurahashi12/webapp/domain/comment"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseComment "github.com/kazukimurahashi12/webapp/usecase/comment"
+	"go.uber.org/zap"
+)
+
+type CommentController struct {
+	commentUseCase usecaseComment.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewCommentController(commentUseCase usecaseComment.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *CommentController {
+	return &CommentController{
+		commentUseCase: commentUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// コメント・返信投稿
+func (cc *CommentController) PostComment(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CommentPost{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in comment creation",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "コメント投稿データの形式が不正です",
+			"code":       "INVALID_COMMENT_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// コメント投稿処理UseCase
+	comment, err := cc.commentUseCase.PostComment(userID, req.PostID, req.ParentID, req.Content)
+	if err != nil {
+		cc.respondError(c, requestID, err, "コメントの投稿に失敗しました", "COMMENT_CREATION_FAILED")
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCommentResponse(comment)
+	cc.logger.Info("Successfully created comment",
+		zap.String("requestID", requestID),
+		zap.Uint("commentID", comment.ID),
+		zap.Uint("postID", comment.PostID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "コメントを投稿しました",
+		"code":       "COMMENT_CREATED",
+		"request_id": requestID,
+		"comment":    response,
+	})
+}
+
+// ブログ記事の承認済みコメント一覧取得
+func (cc *CommentController) GetComments(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// ブログIDをリクエストから取得
+	idStr := c.Param("id")
+	postID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		cc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// コメント一覧取得UseCase
+	comments, err := cc.commentUseCase.GetCommentTree(userID, uint(postID))
+	if err != nil {
+		cc.respondError(c, requestID, err, "コメント一覧の取得に失敗しました", "COMMENT_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "コメント一覧を取得しました",
+		"code":       "COMMENT_FETCHED",
+		"request_id": requestID,
+		"comments":   mapper.ToCommentsResponse(comments),
+	})
+}
+
+// 自身が著者・編集者のブログ記事に付いた承認待ちコメント一覧取得
+func (cc *CommentController) GetModerationQueue(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// 承認待ちコメント取得UseCase
+	comments, err := cc.commentUseCase.GetModerationQueue(userID)
+	if err != nil {
+		cc.respondError(c, requestID, err, "承認待ちコメントの取得に失敗しました", "COMMENT_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "承認待ちコメントを取得しました",
+		"code":       "COMMENT_MODERATION_QUEUE_FETCHED",
+		"request_id": requestID,
+		"comments":   mapper.ToCommentsResponse(comments),
+		"meta": gin.H{
+			"count": len(comments),
+		},
+	})
+}
+
+// コメントの承認・却下・スパム判定
+func (cc *CommentController) ModerateComment(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, cc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.CommentModerate{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cc.logger.Error("Failed to bind JSON in comment moderation",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "コメント承認データの形式が不正です",
+			"code":       "INVALID_COMMENT_MODERATION_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// コメント承認処理UseCase
+	comment, err := cc.commentUseCase.ModerateComment(userID, req.CommentID, req.Status)
+	if err != nil {
+		cc.respondError(c, requestID, err, "コメントのステータス更新に失敗しました", "COMMENT_MODERATION_FAILED")
+		return
+	}
+
+	// DTOに変換してレスポンス
+	response := mapper.ToCommentResponse(comment)
+	cc.logger.Info("Successfully moderated comment",
+		zap.String("requestID", requestID),
+		zap.Uint("commentID", comment.ID),
+		zap.String("status", comment.Status))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "コメントのステータスを更新しました",
+		"code":       "COMMENT_MODERATED",
+		"request_id": requestID,
+		"comment":    response,
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (cc *CommentController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainComment.ErrCommentInvalidData):
+		status, message, code = http.StatusBadRequest, err.Error(), "INVALID_COMMENT_ENTITY"
+	case errors.Is(err, domainComment.ErrCommentInvalidStatus):
+		status, message, code = http.StatusBadRequest, "コメントのステータスが不正です", "INVALID_COMMENT_STATUS"
+	case errors.Is(err, domainComment.ErrCommentInvalidParent):
+		status, message, code = http.StatusBadRequest, "このコメントには返信できません", "INVALID_COMMENT_PARENT"
+	case errors.Is(err, domainComment.ErrCommentNotFound):
+		status, message, code = http.StatusNotFound, "指定されたコメントが存在しません", "COMMENT_NOT_FOUND"
+	case errors.Is(err, domainComment.ErrCommentUnauthorized):
+		status, message, code = http.StatusForbidden, "このコメントを操作する権限がありません", "COMMENT_ACCESS_DENIED"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	}
+
+	cc.logger.Error("Comment request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
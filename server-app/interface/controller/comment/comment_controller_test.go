@@ -0,0 +1,215 @@
+package comment
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainComment "github.com/kazukimurahashi12/webapp/domain/comment"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	commentMocks "github.com/kazukimurahashi12/webapp/usecase/comment/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestCommentController_PostComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"postId":10,"parentId":3,"content":"返信です"}`
+		req := httptest.NewRequest(http.MethodPost, "/comment/post", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		parentID := uint(3)
+		mockCommentUseCase.EXPECT().
+			PostComment(uint(123), uint(10), &parentID, "返信です").
+			Return(&domainComment.Comment{ID: 4, PostID: 10, ParentID: &parentID, Content: "返信です", Status: domainComment.StatusPending}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.PostComment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "COMMENT_CREATED", response["code"])
+			assert.Equal(t, "pending", response["comment"].(map[string]interface{})["status"])
+		}
+	})
+
+	t.Run("InvalidParent", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"postId":10,"parentId":99,"content":"返信です"}`
+		req := httptest.NewRequest(http.MethodPost, "/comment/post", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCommentUseCase.EXPECT().
+			PostComment(uint(123), uint(10), gomock.Any(), "返信です").
+			Return(nil, domainComment.ErrCommentInvalidParent)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.PostComment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestCommentController_GetComments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/comment/list/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		parentID := uint(1)
+		tree := []domainComment.Comment{
+			{ID: 1, PostID: 10, Status: domainComment.StatusApproved, Replies: []domainComment.Comment{
+				{ID: 2, PostID: 10, ParentID: &parentID, Status: domainComment.StatusApproved},
+			}},
+		}
+		mockCommentUseCase.EXPECT().
+			GetCommentTree(uint(123), uint(10)).
+			Return(tree, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetComments(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Comments []struct {
+				ID      uint `json:"id"`
+				Replies []struct {
+					ID uint `json:"id"`
+				} `json:"replies"`
+			} `json:"comments"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			if assert.Len(t, response.Comments, 1) && assert.Len(t, response.Comments[0].Replies, 1) {
+				assert.Equal(t, uint(2), response.Comments[0].Replies[0].ID)
+			}
+		}
+	})
+}
+
+func TestCommentController_ModerateComment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"commentId":4,"status":"spam"}`
+		req := httptest.NewRequest(http.MethodPost, "/comment/moderate", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCommentUseCase.EXPECT().
+			ModerateComment(uint(123), uint(4), domainComment.StatusSpam).
+			Return(&domainComment.Comment{ID: 4, Status: domainComment.StatusSpam}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.ModerateComment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"commentId":4,"status":"pending"}`
+		req := httptest.NewRequest(http.MethodPost, "/comment/moderate", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.ModerateComment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+
+	t.Run("NotBlogOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"commentId":4,"status":"approved"}`
+		req := httptest.NewRequest(http.MethodPost, "/comment/moderate", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockCommentUseCase := commentMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockCommentUseCase.EXPECT().
+			ModerateComment(uint(456), uint(4), domainComment.StatusApproved).
+			Return(nil, domainComment.ErrCommentUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewCommentController(mockCommentUseCase, mockSession, logger)
+
+		// 実行
+		controller.ModerateComment(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
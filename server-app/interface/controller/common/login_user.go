@@ -0,0 +1,72 @@
+package common
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	"go.uber.org/zap"
+)
+
+// コンテクストからログインユーザーIDを取得
+// 取得に失敗した場合はエラーレスポンスを返却しfalseを返す
+func GetLoginUserID(c *gin.Context, logger *zap.Logger) (uint, bool) {
+	requestID := middleware.GetRequestID(c.Request.Context())
+
+	// セッションによるログイン認証はroutes.go_isAuthenticated共通実施しコンテクストから取得
+	userID, exists := c.Get("userID")
+	if !exists {
+		logger.Error("userID not found in context",
+			zap.String("requestID", requestID))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":      "userIDが取得できませんでした",
+			"code":       "USER_ID_NOT_FOUND",
+			"request_id": requestID,
+		})
+		return 0, false
+	}
+	userIDStr, ok := userID.(string)
+	if !ok {
+		logger.Error("userID is not a string",
+			zap.String("requestID", requestID))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error":      "userIDが正しい型ではありません",
+			"code":       "USER_ID_TYPE_ERROR",
+			"request_id": requestID,
+		})
+		return 0, false
+	}
+
+	// 文字列のuserIDをuintに変換
+	userIDUint, err := strconv.ParseUint(userIDStr, 10, 64)
+	if err != nil {
+		logger.Error("Failed to parse userID",
+			zap.String("userID", userIDStr),
+			zap.Error(err),
+			zap.String("requestID", requestID))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ユーザーIDの形式が不正です",
+			"code":       "INVALID_USER_ID_FORMAT",
+			"request_id": requestID,
+		})
+		return 0, false
+	}
+
+	return uint(userIDUint), true
+}
+
+// セッションからログイン中のユーザーIDを取得
+// ログインを必須としないURLで使用し、セッションがない場合は未ログイン（0）とする
+func OptionalLoginUserID(c *gin.Context, sessionManager session.SessionManager) uint {
+	userID, err := sessionManager.GetSession(c)
+	if err != nil || userID == "" {
+		return 0
+	}
+	id, err := strconv.ParseUint(userID, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return uint(id)
+}
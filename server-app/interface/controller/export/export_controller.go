@@ -0,0 +1,196 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseExport "github.com/kazukimurahashi12/webapp/usecase/export"
+	"go.uber.org/zap"
+)
+
+type ExportController struct {
+	exportUseCase  usecaseExport.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewExportController(exportUseCase usecaseExport.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *ExportController {
+	return &ExportController{
+		exportUseCase:  exportUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ブログをMarkdownのZIPアーカイブとしてその場でダウンロード
+// 件数が多い場合は413を返すため、バックグラウンドでのエクスポートを利用する
+func (e *ExportController) DownloadArchive(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, e.logger)
+	if !ok {
+		return
+	}
+
+	// エクスポート内容準備UseCase
+	archive, err := e.exportUseCase.PrepareExport(userID)
+	if err != nil {
+		e.respondError(c, requestID, err, "エクスポートの準備に失敗しました", "EXPORT_FAILED")
+		return
+	}
+
+	// 書き込み開始後はステータスコードを変更できないため、エラーはログにのみ記録する
+	filename := fmt.Sprintf("%s-export-%s.zip", archive.Username, archive.ExportedAt.Format("20060102"))
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+	manifest, err := e.exportUseCase.WriteArchive(archive, c.Writer)
+	if err != nil {
+		e.logger.Error("Failed to stream export archive",
+			zap.String("requestID", requestID),
+			zap.Uint("userID", userID),
+			zap.Error(err))
+		return
+	}
+
+	e.logger.Info("Export archive streamed",
+		zap.String("requestID", requestID),
+		zap.Uint("userID", userID),
+		zap.Int("blogs", len(manifest.Blogs)),
+		zap.Int("attachments", len(manifest.Attachments)))
+}
+
+// バックグラウンドでのエクスポートを開始
+func (e *ExportController) StartExport(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, e.logger)
+	if !ok {
+		return
+	}
+
+	// エクスポート開始UseCase
+	job, err := e.exportUseCase.StartExport(userID)
+	if err != nil {
+		e.respondError(c, requestID, err, "エクスポートの開始に失敗しました", "EXPORT_START_FAILED")
+		return
+	}
+
+	e.logger.Info("Export job accepted",
+		zap.String("requestID", requestID),
+		zap.Uint("userID", userID),
+		zap.Uint("jobID", job.ID))
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":    "エクスポートを開始しました",
+		"code":       "EXPORT_ACCEPTED",
+		"request_id": requestID,
+		"job":        mapper.ToExportJobResponse(job),
+	})
+}
+
+// エクスポートジョブの状態を取得
+func (e *ExportController) GetJob(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, e.logger)
+	if !ok {
+		return
+	}
+
+	id, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		e.logger.Warn("Invalid export job ID",
+			zap.String("requestID", requestID),
+			zap.String("id", c.Param("id")))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "無効なエクスポートIDです",
+			"code":       "INVALID_EXPORT_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// エクスポートジョブ取得UseCase
+	job, err := e.exportUseCase.GetJob(userID, uint(id))
+	if err != nil {
+		e.respondError(c, requestID, err, "エクスポートの状態の取得に失敗しました", "EXPORT_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "エクスポートの状態を取得しました",
+		"code":       "EXPORT_FETCHED",
+		"request_id": requestID,
+		"job":        mapper.ToExportJobResponse(job),
+	})
+}
+
+// 作成済みのアーカイブのダウンロード
+// URLのトークンで認可するため、有効期限内であればログインしていなくてもダウンロードできる
+func (e *ExportController) DownloadJobArchive(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	job, content, err := e.exportUseCase.OpenDownload(c.Param("token"))
+	if err != nil {
+		e.respondError(c, requestID, err, "アーカイブの取得に失敗しました", "EXPORT_DOWNLOAD_FAILED")
+		return
+	}
+	defer content.Close()
+
+	filename := fmt.Sprintf("export-%s.zip", job.CompletedAt.Format("20060102"))
+	c.DataFromReader(http.StatusOK, job.Size, "application/zip", content, map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": filename}),
+		"Cache-Control":       "private, no-store",
+		"X-Robots-Tag":        "noindex",
+		"Referrer-Policy":     "no-referrer",
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (e *ExportController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainExport.ErrExportTooLarge):
+		status, message, code = http.StatusRequestEntityTooLarge, "記事数が多いため、バックグラウンドでのエクスポートを利用してください", "EXPORT_TOO_LARGE"
+	case errors.Is(err, domainExport.ErrExportJobNotFound),
+		errors.Is(err, domainExport.ErrArchiveNotFound):
+		status, message, code = http.StatusNotFound, "指定されたエクスポートが存在しません", "EXPORT_NOT_FOUND"
+	case errors.Is(err, domainExport.ErrExportUnauthorized):
+		status, message, code = http.StatusForbidden, "このエクスポートを参照する権限がありません", "EXPORT_ACCESS_DENIED"
+	case errors.Is(err, domainExport.ErrExportNotReady):
+		status, message, code = http.StatusConflict, "アーカイブを作成中です", "EXPORT_NOT_READY"
+	case errors.Is(err, domainExport.ErrExportExpired):
+		status, message, code = http.StatusGone, "ダウンロードURLの有効期限が切れています", "EXPORT_EXPIRED"
+	case errors.Is(err, domainUser.ErrUserNotFound):
+		status, message, code = http.StatusNotFound, "ユーザーが存在しません", "USER_NOT_FOUND"
+	}
+
+	e.logger.Error("Export request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
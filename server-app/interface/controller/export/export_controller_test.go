@@ -0,0 +1,281 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/attachment"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/domain/export"
+	infraArchive "github.com/kazukimurahashi12/webapp/infrastructure/archive"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	exportMocks "github.com/kazukimurahashi12/webapp/usecase/export/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestExportController_DownloadArchive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/archive", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		key := "ab/" + strings.Repeat("ab", 32) + ".png"
+		created := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+		archive := &export.Archive{
+			Username:   "taro",
+			ExportedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			Entries: []export.Entry{{
+				Blog: blog.Blog{
+					ID: 10, AuthorID: 123, Title: "はじめての記事", Slug: "first-post",
+					Content: "本文\n\n![画像](/files/" + key + ")",
+					Status:  blog.StatusPublished, Visibility: blog.VisibilityPublic,
+					CreatedAt: created, UpdatedAt: created, PublishedAt: &created,
+				},
+				Categories: []string{"Go"},
+				Tags:       []string{"gin", "gorm"},
+			}},
+			Attachments: []attachment.Attachment{
+				{ID: 1, OwnerID: 123, StorageKey: key, Filename: "photo.png", ContentType: "image/png", Size: 3},
+			},
+		}
+		mockExportUseCase.EXPECT().PrepareExport(uint(123)).Return(archive, nil)
+		mockExportUseCase.EXPECT().
+			WriteArchive(archive, gomock.Any()).
+			DoAndReturn(func(a *export.Archive, w io.Writer) (*export.Manifest, error) {
+				return infraArchive.NewZipWriter().Write(w, a, func(string) (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader("png")), nil
+				})
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.DownloadArchive(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/zip", recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), "taro-export-20240601.zip")
+
+		zr, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+		if !assert.NoError(t, err) {
+			return
+		}
+		files := map[string]string{}
+		for _, f := range zr.File {
+			r, err := f.Open()
+			assert.NoError(t, err)
+			content, _ := io.ReadAll(r)
+			r.Close()
+			files[f.Name] = string(content)
+		}
+
+		post := files["posts/2024-05-01-first-post.md"]
+		assert.True(t, strings.HasPrefix(post, "---\nid: 10\ntitle: はじめての記事\nslug: first-post\n"))
+		assert.Contains(t, post, "categories:\n    - Go\n")
+		assert.Contains(t, post, "tags:\n    - gin\n    - gorm\n")
+		assert.Contains(t, post, "![画像](../attachments/"+key+")")
+		assert.Equal(t, "png", files["attachments/"+key])
+
+		var manifest export.Manifest
+		if assert.NoError(t, json.Unmarshal([]byte(files["manifest.json"]), &manifest)) {
+			assert.Equal(t, "taro", manifest.Username)
+			if assert.Len(t, manifest.Blogs, 1) {
+				assert.Equal(t, "posts/2024-05-01-first-post.md", manifest.Blogs[0].Path)
+				assert.Equal(t, []string{"attachments/" + key}, manifest.Blogs[0].Attachments)
+			}
+			assert.Len(t, manifest.Attachments, 1)
+		}
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/archive", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockExportUseCase.EXPECT().PrepareExport(uint(123)).Return(nil, export.ErrExportTooLarge)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.DownloadArchive(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "EXPORT_TOO_LARGE")
+	})
+}
+
+func TestExportController_StartExport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/export/jobs", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockExportUseCase.EXPECT().
+			StartExport(uint(123)).
+			Return(&export.Job{ID: 5, UserID: 123, Status: export.JobPending, DownloadToken: "secret"}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.StartExport(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusAccepted, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"statusUrl":"/export/jobs/5"`)
+		// 作成前はダウンロードURLを返さない
+		assert.NotContains(t, recorder.Body.String(), "secret")
+	})
+}
+
+func TestExportController_GetJob(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Done", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/jobs/5", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "5"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		expires := time.Now().Add(time.Hour)
+		mockExportUseCase.EXPECT().
+			GetJob(uint(123), uint(5)).
+			Return(&export.Job{ID: 5, UserID: 123, Status: export.JobDone, BlogCount: 120, DownloadToken: "secret", ExpiresAt: &expires}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetJob(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"downloadUrl":"/export/download/secret"`)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/jobs/5", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "5"}}
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockExportUseCase.EXPECT().
+			GetJob(uint(456), uint(5)).
+			Return(nil, export.ErrExportUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetJob(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestExportController_DownloadJobArchive(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/download/secret", nil)
+		ctx.Params = gin.Params{{Key: "token", Value: "secret"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		completed := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		mockExportUseCase.EXPECT().
+			OpenDownload("secret").
+			Return(&export.Job{ID: 5, Status: export.JobDone, Size: 4, CompletedAt: &completed}, io.NopCloser(strings.NewReader("PK..")), nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.DownloadJobArchive(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "PK..", recorder.Body.String())
+		assert.Contains(t, recorder.Header().Get("Content-Disposition"), "export-20240601.zip")
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/export/download/secret", nil)
+		ctx.Params = gin.Params{{Key: "token", Value: "secret"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockExportUseCase := exportMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockExportUseCase.EXPECT().
+			OpenDownload("secret").
+			Return(nil, nil, export.ErrExportExpired)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewExportController(mockExportUseCase, mockSession, logger)
+
+		// 実行
+		controller.DownloadJobArchive(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusGone, recorder.Code)
+	})
+}
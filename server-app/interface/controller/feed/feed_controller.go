@@ -0,0 +1,208 @@
+package feed
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+	"go.uber.org/zap"
+)
+
+// フィード形式
+const (
+	formatRSS  = "rss"
+	formatAtom = "atom"
+)
+
+// フィードに記載するサイト情報
+type FeedSite struct {
+	Title   string
+	BaseURL string // 末尾のスラッシュを含まない絶対URL
+}
+
+type FeedController struct {
+	blogUseCase    usecaseBlog.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+	site           FeedSite
+}
+
+func NewFeedController(blogUseCase usecaseBlog.UseCase, sessionManager session.SessionManager, logger *zap.Logger, site FeedSite) *FeedController {
+	site.BaseURL = strings.TrimRight(site.BaseURL, "/")
+	return &FeedController{
+		blogUseCase:    blogUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+		site:           site,
+	}
+}
+
+// サイト全体のフィード取得
+func (f *FeedController) GetSiteFeed(c *gin.Context) {
+	f.serveFeed(c, domainBlog.FeedQuery{}, "")
+}
+
+// 著者ごとのフィード取得
+func (f *FeedController) GetAuthorFeed(c *gin.Context) {
+	username := c.Param("username")
+	f.serveFeed(c, domainBlog.FeedQuery{AuthorName: username}, "/"+url.PathEscape(username))
+}
+
+// カテゴリごとのフィード取得
+func (f *FeedController) GetCategoryFeed(c *gin.Context) {
+	categoryID, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil || categoryID == 0 {
+		requestID := middleware.GetRequestID(c.Request.Context())
+		f.logger.Warn("Invalid category ID",
+			zap.String("requestID", requestID),
+			zap.String("id", c.Param("id")))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "カテゴリIDが不正です",
+			"code":       "INVALID_CATEGORY_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+	f.serveFeed(c, domainBlog.FeedQuery{CategoryID: uint(categoryID)}, fmt.Sprintf("/blog/category/%d", categoryID))
+}
+
+// フィードを取得して指定形式で返却
+// 掲載内容が変わっていない場合は条件付きリクエストに304を返却する
+func (f *FeedController) serveFeed(c *gin.Context, query domainBlog.FeedQuery, linkPath string) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	format := c.Param("format")
+	if format != formatRSS && format != formatAtom {
+		f.respondError(c, requestID, fmt.Errorf("unknown feed format %q", format), http.StatusNotFound, "指定された形式のフィードは存在しません", "FEED_FORMAT_NOT_FOUND")
+		return
+	}
+
+	// 公開済みのブログを取得UseCase
+	feed, err := f.blogUseCase.GetFeed(query)
+	if err != nil {
+		status, message, code := http.StatusInternalServerError, "フィードの取得に失敗しました", "FEED_FETCH_FAILED"
+		switch {
+		case errors.Is(err, domainUser.ErrUserNotFound):
+			status, message, code = http.StatusNotFound, "指定された著者が存在しません", "USER_NOT_FOUND"
+		case errors.Is(err, domainCategory.ErrCategoryNotFound):
+			status, message, code = http.StatusNotFound, "指定されたカテゴリが存在しません", "CATEGORY_NOT_FOUND"
+		}
+		f.respondError(c, requestID, err, status, message, code)
+		return
+	}
+
+	// 条件付きリクエストの判定
+	etag := fmt.Sprintf(`"%s-%s"`, format, feed.Fingerprint())
+	lastModified := feed.UpdatedAt().UTC().Truncate(time.Second)
+	c.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.Format(http.TimeFormat))
+	}
+	if notModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	// 本文をHTMLに変換
+	items := make([]dto.FeedItem, len(feed.Blogs))
+	for i := range feed.Blogs {
+		blog := &feed.Blogs[i]
+		rendered, err := f.blogUseCase.RenderBlogContent(blog)
+		if err != nil {
+			f.respondError(c, requestID, err, http.StatusInternalServerError, "ブログ記事本文の変換に失敗しました", "BLOG_RENDER_FAILED")
+			return
+		}
+		items[i] = mapper.ToFeedItem(blog, rendered, f.blogURL(blog), f.permalinkURL(blog))
+	}
+
+	channel := dto.FeedChannel{
+		Title:       f.site.Title,
+		Description: f.site.Title,
+		Link:        f.site.BaseURL + linkPath + "/",
+		SelfLink:    f.site.BaseURL + c.Request.URL.Path,
+		UpdatedAt:   lastModified,
+	}
+	if feed.Title != "" {
+		channel.Title = f.site.Title + " - " + feed.Title
+		channel.Description = feed.Title + "の新着記事"
+	}
+
+	var body any
+	contentType := "application/rss+xml; charset=utf-8"
+	if format == formatAtom {
+		body = mapper.ToAtom(channel, items)
+		contentType = "application/atom+xml; charset=utf-8"
+	} else {
+		body = mapper.ToRSS(channel, items)
+	}
+	out, err := xml.MarshalIndent(body, "", "  ")
+	if err != nil {
+		f.respondError(c, requestID, err, http.StatusInternalServerError, "フィードの生成に失敗しました", "FEED_ENCODE_FAILED")
+		return
+	}
+
+	f.logger.Info("Feed served",
+		zap.String("requestID", requestID),
+		zap.String("format", format),
+		zap.Int("items", len(items)))
+	c.Data(http.StatusOK, contentType, append([]byte(xml.Header), out...))
+}
+
+// ブログを一意に識別するURL
+// スラッグの変更に影響されないようIDによるURLとする
+func (f *FeedController) blogURL(blog *domainBlog.Blog) string {
+	return fmt.Sprintf("%s/blog/overview/post/%d", f.site.BaseURL, blog.ID)
+}
+
+// 著者名とスラッグによる未ログインでも閲覧できるブログのURL
+func (f *FeedController) permalinkURL(blog *domainBlog.Blog) string {
+	return f.site.BaseURL + "/public/author/" + url.PathEscape(blog.Author.Username) + "/" + blog.Slug
+}
+
+// 条件付きリクエストに対して掲載内容が変わっていないかを判定
+// If-None-Matchが指定された場合はIf-Modified-Sinceより優先する
+func notModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := r.Header.Get("If-Modified-Since"); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		return err == nil && !lastModified.After(since)
+	}
+	return false
+}
+
+// エラーレスポンスを返却
+func (f *FeedController) respondError(c *gin.Context, requestID string, err error, status int, message, code string) {
+	f.logger.Error("Feed request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,210 @@
+package feed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/domain/user"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	blogMocks "github.com/kazukimurahashi12/webapp/usecase/blog/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestFeedController_GetAuthorFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	site := FeedSite{Title: "webapp", BaseURL: "https://example.com/"}
+	publishedAt := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+	feed := &blog.Feed{
+		Title: "alice",
+		Blogs: []blog.Blog{
+			{
+				ID:          10,
+				Title:       "初めての投稿",
+				Slug:        "first-post",
+				Version:     2,
+				Status:      blog.StatusPublished,
+				PublishedAt: &publishedAt,
+				UpdatedAt:   publishedAt,
+				Author:      user.User{ID: 123, Username: "alice"},
+			},
+		},
+	}
+	rendered := &blog.RenderedContent{HTML: "<p>本文 &amp; <strong>強調</strong></p>\n"}
+
+	t.Run("RSS", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/alice/rss", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "format", Value: "rss"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetFeed(blog.FeedQuery{AuthorName: "alice"}).
+			Return(feed, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(&feed.Blogs[0]).
+			Return(rendered, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/rss+xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, "Wed, 01 May 2024 09:00:00 GMT", recorder.Header().Get("Last-Modified"))
+		assert.NotEmpty(t, recorder.Header().Get("ETag"))
+		body := recorder.Body.String()
+		assert.Contains(t, body, `<rss version="2.0"`)
+		assert.Contains(t, body, "<title>webapp - alice</title>")
+		assert.Contains(t, body, "<link>https://example.com/public/author/alice/first-post</link>")
+		assert.Contains(t, body, `<guid isPermaLink="false">https://example.com/blog/overview/post/10</guid>`)
+		assert.Contains(t, body, "<pubDate>Wed, 01 May 2024 09:00:00 +0000</pubDate>")
+		assert.Contains(t, body, "<dc:creator>alice</dc:creator>")
+		assert.Contains(t, body, "<description>本文 &amp; 強調</description>")
+		assert.Contains(t, body, "<content:encoded>&lt;p&gt;本文")
+	})
+
+	t.Run("Atom", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/alice/atom", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "format", Value: "atom"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetFeed(blog.FeedQuery{AuthorName: "alice"}).
+			Return(feed, nil)
+		mockBlogUseCase.EXPECT().
+			RenderBlogContent(&feed.Blogs[0]).
+			Return(rendered, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "application/atom+xml; charset=utf-8", recorder.Header().Get("Content-Type"))
+		body := recorder.Body.String()
+		assert.Contains(t, body, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+		assert.Contains(t, body, "<updated>2024-05-01T09:00:00Z</updated>")
+		assert.Contains(t, body, "<published>2024-05-01T09:00:00Z</published>")
+		assert.Contains(t, body, "<name>alice</name>")
+		assert.Contains(t, body, `<content type="html">`)
+	})
+
+	t.Run("NotModified", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/alice/rss", nil)
+		ctx.Request.Header.Set("If-None-Match", `"rss-`+feed.Fingerprint()+`"`)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "format", Value: "rss"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定（本文の変換は行われない）
+		mockBlogUseCase.EXPECT().
+			GetFeed(blog.FeedQuery{AuthorName: "alice"}).
+			Return(feed, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotModified, ctx.Writer.Status())
+		assert.Empty(t, recorder.Body.String())
+	})
+
+	t.Run("NotModifiedSince", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/alice/atom", nil)
+		ctx.Request.Header.Set("If-Modified-Since", "Wed, 01 May 2024 09:00:00 GMT")
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "format", Value: "atom"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定（本文の変換は行われない）
+		mockBlogUseCase.EXPECT().
+			GetFeed(blog.FeedQuery{AuthorName: "alice"}).
+			Return(feed, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotModified, ctx.Writer.Status())
+	})
+
+	t.Run("AuthorNotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/nobody/rss", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "nobody"}, {Key: "format", Value: "rss"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockBlogUseCase.EXPECT().
+			GetFeed(blog.FeedQuery{AuthorName: "nobody"}).
+			Return(nil, user.ErrUserNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "USER_NOT_FOUND")
+	})
+
+	t.Run("UnknownFormat", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/feed/author/alice/json", nil)
+		ctx.Params = gin.Params{{Key: "username", Value: "alice"}, {Key: "format", Value: "json"}}
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockBlogUseCase := blogMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewFeedController(mockBlogUseCase, mockSession, logger, site)
+
+		// 実行
+		controller.GetAuthorFeed(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
@@ -0,0 +1,154 @@
+package importer
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseImporter "github.com/kazukimurahashi12/webapp/usecase/importer"
+	"go.uber.org/zap"
+)
+
+// multipartの区切り・ヘッダー等のファイル以外に許容するリクエストサイズ
+const multipartOverhead = 1 << 20
+
+type ImportController struct {
+	importerUseCase usecaseImporter.UseCase
+	sessionManager  session.SessionManager
+	logger          *zap.Logger
+	maxSize         int64
+}
+
+func NewImportController(importerUseCase usecaseImporter.UseCase, sessionManager session.SessionManager, logger *zap.Logger, maxSize int64) *ImportController {
+	return &ImportController{
+		importerUseCase: importerUseCase,
+		sessionManager:  sessionManager,
+		logger:          logger,
+		maxSize:         maxSize,
+	}
+}
+
+// WordPressのエクスポートファイル、またはMarkdownのファイル・ZIPから記事を取り込む
+// multipart/form-dataのfileにファイル、dryRunにtrueを指定すると変更せずに取り込み結果のみを返す
+func (i *ImportController) Upload(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, i.logger)
+	if !ok {
+		return
+	}
+
+	// 上限を超えるリクエストは読み込み途中で打ち切る
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, i.maxSize+multipartOverhead)
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			i.respondError(c, requestID, domainImporter.ErrImportTooLarge, "", "")
+			return
+		}
+		i.logger.Error("Failed to read multipart file",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "取り込むファイルを指定してください",
+			"code":       "INVALID_UPLOAD_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+	defer file.Close()
+
+	dryRun := false
+	if v := c.Request.FormValue("dryRun"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			i.logger.Error("Invalid dryRun format",
+				zap.String("requestID", requestID),
+				zap.String("dryRun", v))
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":      "dryRunの形式が不正です",
+				"code":       "INVALID_DRY_RUN",
+				"request_id": requestID,
+			})
+			return
+		}
+	}
+
+	content, err := io.ReadAll(io.LimitReader(file, i.maxSize+1))
+	if err != nil {
+		i.respondError(c, requestID, err, "ファイルの読み込みに失敗しました", "UPLOAD_READ_FAILED")
+		return
+	}
+	if int64(len(content)) > i.maxSize {
+		i.respondError(c, requestID, domainImporter.ErrImportTooLarge, "", "")
+		return
+	}
+
+	source, posts, err := domainImporter.ParseUpload(header.Filename, content, i.maxSize*domainImporter.MaxExpandRatio)
+	if err != nil {
+		i.respondError(c, requestID, err, "ファイルの読み込みに失敗しました", "IMPORT_PARSE_FAILED")
+		return
+	}
+
+	// 取り込みUseCase
+	report, err := i.importerUseCase.Import(userID, source, posts, dryRun)
+	if err != nil {
+		i.respondError(c, requestID, err, "記事の取り込みに失敗しました", "IMPORT_FAILED")
+		return
+	}
+
+	i.logger.Info("Import completed",
+		zap.String("requestID", requestID),
+		zap.Uint("userID", userID),
+		zap.String("source", source),
+		zap.Bool("dryRun", dryRun),
+		zap.Int("created", report.Created),
+		zap.Int("updated", report.Updated),
+		zap.Int("unchanged", report.Unchanged),
+		zap.Int("skipped", report.Skipped))
+	message := "記事を取り込みました"
+	if dryRun {
+		message = "取り込み結果を確認しました（変更は行っていません）"
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":    message,
+		"code":       "IMPORT_COMPLETED",
+		"request_id": requestID,
+		"report":     mapper.ToImportReportResponse(report),
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (i *ImportController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainImporter.ErrImportTooLarge):
+		status, message, code = http.StatusRequestEntityTooLarge, "ファイルサイズが上限を超えています", "IMPORT_TOO_LARGE"
+	case errors.Is(err, domainImporter.ErrImportFormatUnsupported):
+		status, message, code = http.StatusUnsupportedMediaType, "WordPressのエクスポートファイル（.xml）、Markdown（.md）またはZIPを指定してください", "IMPORT_FORMAT_UNSUPPORTED"
+	case errors.Is(err, domainImporter.ErrImportInvalid):
+		status, message, code = http.StatusBadRequest, "ファイルの形式が不正です", "IMPORT_INVALID"
+	case errors.Is(err, domainImporter.ErrImportEmpty):
+		status, message, code = http.StatusBadRequest, "取り込める記事がありません", "IMPORT_EMPTY"
+	}
+
+	i.logger.Error("Import request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,159 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	importerMocks "github.com/kazukimurahashi12/webapp/usecase/importer/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+// multipart/form-dataのリクエストを生成
+func newUploadRequest(t *testing.T, filename string, content []byte, fields map[string]string) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range fields {
+		assert.NoError(t, writer.WriteField(k, v))
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/import/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestImportController_Upload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	markdown := []byte("---\ntitle: Hello\ntags: [go]\n---\nbody\n")
+
+	t.Run("DryRun", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "2024-05-01-hello.md", markdown, map[string]string{"dryRun": "true"})
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockImporterUseCase := importerMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockImporterUseCase.EXPECT().
+			Import(uint(123), domainImporter.SourceMarkdown, gomock.Any(), true).
+			DoAndReturn(func(userID uint, source string, posts []domainImporter.Post, dryRun bool) (*domainImporter.Report, error) {
+				assert.Len(t, posts, 1)
+				assert.Equal(t, "Hello", posts[0].Title)
+				assert.Equal(t, "hello", posts[0].Slug)
+				report := &domainImporter.Report{DryRun: true, Source: source, NewCategories: []string{}}
+				report.Add(domainImporter.ReportItem{
+					SourceID: posts[0].SourceID,
+					Path:     posts[0].Path,
+					Title:    posts[0].Title,
+					Action:   domainImporter.ActionCreate,
+				})
+				return report, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewImportController(mockImporterUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Report struct {
+				DryRun  bool `json:"dryRun"`
+				Created int  `json:"created"`
+				Items   []struct {
+					Path   string `json:"path"`
+					Action string `json:"action"`
+				} `json:"items"`
+			} `json:"report"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.True(t, response.Report.DryRun)
+			assert.Equal(t, 1, response.Report.Created)
+			if assert.Len(t, response.Report.Items, 1) {
+				assert.Equal(t, "2024-05-01-hello.md", response.Report.Items[0].Path)
+				assert.Equal(t, domainImporter.ActionCreate, response.Report.Items[0].Action)
+			}
+		}
+	})
+
+	t.Run("UnsupportedFormat", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "posts.csv", []byte("title,body\n"), nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockImporterUseCase := importerMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewImportController(mockImporterUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusUnsupportedMediaType, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "IMPORT_FORMAT_UNSUPPORTED")
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "big.md", bytes.Repeat([]byte("a"), 2048), nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockImporterUseCase := importerMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewImportController(mockImporterUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "IMPORT_TOO_LARGE")
+	})
+
+	t.Run("InvalidDryRun", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = newUploadRequest(t, "hello.md", markdown, map[string]string{"dryRun": "maybe"})
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockImporterUseCase := importerMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewImportController(mockImporterUseCase, mockSession, logger, 1024)
+
+		// 実行
+		controller.Upload(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_DRY_RUN")
+	})
+}
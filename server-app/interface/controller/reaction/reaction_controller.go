@@ -0,0 +1,201 @@
+package reaction
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseReaction "github.com/kazukimurahashi12/webapp/usecase/reaction"
+	"go.uber.org/zap"
+)
+
+type ReactionController struct {
+	reactionUseCase usecaseReaction.UseCase
+	sessionManager  session.SessionManager
+	logger          *zap.Logger
+}
+
+func NewReactionController(reactionUseCase usecaseReaction.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *ReactionController {
+	return &ReactionController{
+		reactionUseCase: reactionUseCase,
+		sessionManager:  sessionManager,
+		logger:          logger,
+	}
+}
+
+// ブログ記事のリアクション数取得
+func (rc *ReactionController) GetReactions(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, rc.logger)
+	if !ok {
+		return
+	}
+
+	blogID, ok := rc.bindBlogID(c, requestID)
+	if !ok {
+		return
+	}
+
+	// リアクション数取得UseCase
+	summary, err := rc.reactionUseCase.GetReactions(userID, blogID)
+	if err != nil {
+		rc.respondError(c, requestID, err, "リアクションの取得に失敗しました", "REACTION_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "リアクションを取得しました",
+		"code":       "REACTION_FETCHED",
+		"request_id": requestID,
+		"reactions":  mapper.ToReactionSummaryResponse(summary),
+	})
+}
+
+// リアクションの登録・取り消し
+// 同じ絵文字でリアクション済みの場合は取り消す
+func (rc *ReactionController) ToggleReaction(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, rc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.ReactionToggle{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		rc.logger.Error("Failed to bind JSON in reaction toggle",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "リアクションデータの形式が不正です",
+			"code":       "INVALID_REACTION_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// リアクション切り替えUseCase
+	summary, added, err := rc.reactionUseCase.ToggleReaction(userID, req.BlogID, req.Emoji)
+	if err != nil {
+		rc.respondError(c, requestID, err, "リアクションの更新に失敗しました", "REACTION_TOGGLE_FAILED")
+		return
+	}
+
+	message, code := "リアクションを取り消しました", "REACTION_REMOVED"
+	if added {
+		message, code = "リアクションしました", "REACTION_ADDED"
+	}
+	rc.logger.Info("Successfully toggled reaction",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.String("emoji", req.Emoji),
+		zap.Bool("added", added))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    message,
+		"code":       code,
+		"request_id": requestID,
+		"reacted":    added,
+		"reactions":  mapper.ToReactionSummaryResponse(summary),
+	})
+}
+
+// ブログ記事にリアクションしたユーザー一覧取得
+// クエリパラメータemojiで絵文字、limitで取得件数を指定する
+func (rc *ReactionController) ListReactors(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, rc.logger)
+	if !ok {
+		return
+	}
+
+	blogID, ok := rc.bindBlogID(c, requestID)
+	if !ok {
+		return
+	}
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			rc.respondError(c, requestID, domainReaction.ErrReactionInvalidQuery, "", "")
+			return
+		}
+		limit = n
+	}
+
+	// リアクションしたユーザー一覧取得UseCase
+	reactors, err := rc.reactionUseCase.ListReactors(userID, blogID, c.Query("emoji"), limit)
+	if err != nil {
+		rc.respondError(c, requestID, err, "リアクションしたユーザーの取得に失敗しました", "REACTOR_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "リアクションしたユーザーを取得しました",
+		"code":       "REACTOR_FETCHED",
+		"request_id": requestID,
+		"users":      mapper.ToReactorsResponse(reactors),
+		"meta": gin.H{
+			"count": len(reactors),
+		},
+	})
+}
+
+// パスパラメータからブログIDを取得
+func (rc *ReactionController) bindBlogID(c *gin.Context, requestID string) (uint, bool) {
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		rc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return 0, false
+	}
+	return uint(blogID), true
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (rc *ReactionController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainReaction.ErrReactionEmojiInvalid):
+		status, message, code = http.StatusBadRequest, "この絵文字ではリアクションできません", "INVALID_REACTION_EMOJI"
+	case errors.Is(err, domainReaction.ErrReactionInvalidQuery):
+		status, message, code = http.StatusBadRequest, "取得条件の形式が不正です", "INVALID_REACTION_QUERY"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	}
+
+	rc.logger.Error("Reaction request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
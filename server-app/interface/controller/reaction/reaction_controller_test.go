@@ -0,0 +1,206 @@
+package reaction
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	reactionMocks "github.com/kazukimurahashi12/webapp/usecase/reaction/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestReactionController_ToggleReaction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Added", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"emoji":"👍"}`
+		req := httptest.NewRequest(http.MethodPost, "/reaction/toggle", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockReactionUseCase := reactionMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockReactionUseCase.EXPECT().
+			ToggleReaction(uint(123), uint(10), "👍").
+			Return(&domainReaction.Summary{
+				BlogID: 10,
+				Total:  3,
+				Counts: []domainReaction.Count{
+					{Emoji: "👍", Count: 2, Reacted: true},
+					{Emoji: "🎉", Count: 1},
+				},
+			}, true, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewReactionController(mockReactionUseCase, mockSession, logger)
+
+		// 実行
+		controller.ToggleReaction(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code      string `json:"code"`
+			Reacted   bool   `json:"reacted"`
+			Reactions struct {
+				Total  int `json:"total"`
+				Counts []struct {
+					Emoji   string `json:"emoji"`
+					Count   int    `json:"count"`
+					Reacted bool   `json:"reacted"`
+				} `json:"counts"`
+			} `json:"reactions"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "REACTION_ADDED", response.Code)
+			assert.True(t, response.Reacted)
+			assert.Equal(t, 3, response.Reactions.Total)
+			if assert.Len(t, response.Reactions.Counts, 2) {
+				assert.Equal(t, "👍", response.Reactions.Counts[0].Emoji)
+				assert.True(t, response.Reactions.Counts[0].Reacted)
+			}
+		}
+	})
+
+	t.Run("Removed", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"emoji":"👍"}`
+		req := httptest.NewRequest(http.MethodPost, "/reaction/toggle", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockReactionUseCase := reactionMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockReactionUseCase.EXPECT().
+			ToggleReaction(uint(123), uint(10), "👍").
+			Return(&domainReaction.Summary{BlogID: 10, Counts: []domainReaction.Count{{Emoji: "👍"}}}, false, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewReactionController(mockReactionUseCase, mockSession, logger)
+
+		// 実行
+		controller.ToggleReaction(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "REACTION_REMOVED")
+	})
+
+	t.Run("InvalidEmoji", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"emoji":"💩"}`
+		req := httptest.NewRequest(http.MethodPost, "/reaction/toggle", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockReactionUseCase := reactionMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockReactionUseCase.EXPECT().
+			ToggleReaction(uint(123), uint(10), "💩").
+			Return(nil, false, domainReaction.ErrReactionEmojiInvalid)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewReactionController(mockReactionUseCase, mockSession, logger)
+
+		// 実行
+		controller.ToggleReaction(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_REACTION_EMOJI")
+	})
+}
+
+func TestReactionController_ListReactors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/reaction/10/users?emoji=%F0%9F%91%8D&limit=10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockReactionUseCase := reactionMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockReactionUseCase.EXPECT().
+			ListReactors(uint(123), uint(10), "👍", 10).
+			Return([]domainReaction.Reactor{
+				{UserID: 5, Username: "alice", Emoji: "👍", CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewReactionController(mockReactionUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListReactors(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Users []struct {
+				UserID   uint   `json:"userId"`
+				Username string `json:"username"`
+			} `json:"users"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			if assert.Len(t, response.Users, 1) {
+				assert.Equal(t, "alice", response.Users[0].Username)
+			}
+		}
+	})
+
+	t.Run("BlogNotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/reaction/99/users", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "99"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockReactionUseCase := reactionMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockReactionUseCase.EXPECT().
+			ListReactors(uint(123), uint(99), "", 0).
+			Return(nil, domainBlog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewReactionController(mockReactionUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListReactors(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "BLOG_NOT_FOUND")
+	})
+}
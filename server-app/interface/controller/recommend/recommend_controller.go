@@ -0,0 +1,107 @@
+package recommend
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseRecommend "github.com/kazukimurahashi12/webapp/usecase/recommend"
+	"go.uber.org/zap"
+)
+
+type RecommendController struct {
+	recommendUseCase usecaseRecommend.UseCase
+	sessionManager   session.SessionManager
+	logger           *zap.Logger
+}
+
+func NewRecommendController(recommendUseCase usecaseRecommend.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *RecommendController {
+	return &RecommendController{
+		recommendUseCase: recommendUseCase,
+		sessionManager:   sessionManager,
+		logger:           logger,
+	}
+}
+
+// ブログ記事の関連記事取得
+// クエリパラメータlimitで取得件数を指定する
+func (rc *RecommendController) GetRelated(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, rc.logger)
+	if !ok {
+		return
+	}
+
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		rc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			rc.respondError(c, requestID, domainRecommend.ErrRelatedInvalidQuery, "", "")
+			return
+		}
+		limit = n
+	}
+
+	// 関連記事取得UseCase
+	related, err := rc.recommendUseCase.GetRelated(userID, uint(blogID), limit)
+	if err != nil {
+		rc.respondError(c, requestID, err, "関連記事の取得に失敗しました", "RELATED_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "関連記事を取得しました",
+		"code":       "RELATED_FETCHED",
+		"request_id": requestID,
+		"blogs":      mapper.ToRelatedBlogsResponse(related),
+		"meta": gin.H{
+			"count": len(related),
+		},
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (rc *RecommendController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainRecommend.ErrRelatedInvalidQuery):
+		status, message, code = http.StatusBadRequest, "取得条件の形式が不正です", "INVALID_RELATED_QUERY"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	}
+
+	rc.logger.Error("Related blog request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
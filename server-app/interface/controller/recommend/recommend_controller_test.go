@@ -0,0 +1,114 @@
+package recommend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	recommendMocks "github.com/kazukimurahashi12/webapp/usecase/recommend/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestRecommendController_GetRelated(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/related/10?limit=3", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockRecommendUseCase := recommendMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockRecommendUseCase.EXPECT().
+			GetRelated(uint(123), uint(10), 3).
+			Return([]domainRecommend.Related{
+				{Blog: domainBlog.Blog{ID: 20, Title: "Goの並行処理 後編"}, Score: 0.82, SharedCategories: 1},
+				{Blog: domainBlog.Blog{ID: 21, Title: "チャネル入門"}, Score: 0.41, SharedTags: 2},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRecommendController(mockRecommendUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetRelated(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Code  string `json:"code"`
+			Blogs []struct {
+				ID               uint    `json:"id"`
+				Score            float64 `json:"score"`
+				SharedCategories int     `json:"sharedCategories"`
+				SharedTags       int     `json:"sharedTags"`
+			} `json:"blogs"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "RELATED_FETCHED", response.Code)
+			if assert.Len(t, response.Blogs, 2) {
+				assert.Equal(t, uint(20), response.Blogs[0].ID)
+				assert.Equal(t, 1, response.Blogs[0].SharedCategories)
+				assert.Equal(t, 2, response.Blogs[1].SharedTags)
+			}
+		}
+	})
+
+	t.Run("InvalidLimit", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/related/10?limit=abc", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockRecommendUseCase := recommendMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRecommendController(mockRecommendUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetRelated(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_RELATED_QUERY")
+	})
+
+	t.Run("NotVisible", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/blog/related/11", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "11"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockRecommendUseCase := recommendMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockRecommendUseCase.EXPECT().
+			GetRelated(uint(123), uint(11), 0).
+			Return(nil, domainBlog.ErrBlogNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewRecommendController(mockRecommendUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetRelated(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
@@ -7,17 +7,13 @@ import (
 )
 
 // APIエンドポイントのルーティング
-func GetRouter() *gin.Engine {
+func GetRouter(container *di.Container) *gin.Engine {
 	// Ginのルーター作成
 	router := gin.Default()
 
 	// CORS設定読み込み
 	router.Use(middleware.ConfigureCORS())
 
-	// DIコンテナ作成
-	// NewContainer 依存性注入用のコンストラクタ
-	container := di.NewContainer()
-
 	// ルーティング設定
 	RegisterRoutes(router, container)
 
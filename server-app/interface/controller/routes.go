@@ -20,9 +20,93 @@ func RegisterRoutes(router *gin.Engine, container *di.Container) {
 	// Blog系ルーティング
 	router.POST("/blog/post", isAuthenticated(container.SessionManager), container.BlogController.PostBlog)
 	router.GET("/blog/overview", isAuthenticated(container.SessionManager), container.HomeController.GetMypage)
+	router.GET("/blog/search", isAuthenticated(container.SessionManager), container.HomeController.SearchBlogs)
 	router.GET("/blog/overview/post/:id", isAuthenticated(container.SessionManager), container.BlogController.GetBlogView)
 	router.POST("/blog/edit", isAuthenticated(container.SessionManager), container.BlogController.EditBlog)
 	router.GET("/blog/delete/:id", isAuthenticated(container.SessionManager), container.BlogController.DeleteBlog)
+	router.POST("/blog/publish/:id", isAuthenticated(container.SessionManager), container.PublishController.PublishBlog)
+	router.POST("/blog/unpublish/:id", isAuthenticated(container.SessionManager), container.PublishController.UnpublishBlog)
+	router.POST("/blog/archive/:id", isAuthenticated(container.SessionManager), container.PublishController.ArchiveBlog)
+	router.POST("/blog/schedule", isAuthenticated(container.SessionManager), container.PublishController.ScheduleBlog)
+	router.POST("/blog/share/:id", isAuthenticated(container.SessionManager), container.PublishController.RegenerateShareLink)
+	router.GET("/blog/revision/list/:id", isAuthenticated(container.SessionManager), container.RevisionController.ListRevisions)
+	router.GET("/blog/revision/view/:id/:version", isAuthenticated(container.SessionManager), container.RevisionController.GetRevision)
+	router.GET("/blog/revision/diff/:id", isAuthenticated(container.SessionManager), container.RevisionController.DiffRevisions)
+	router.POST("/blog/revision/restore", isAuthenticated(container.SessionManager), container.RevisionController.RestoreRevision)
+	router.GET("/blog/trash", isAuthenticated(container.SessionManager), container.TrashController.ListTrash)
+	router.POST("/blog/trash/restore/:id", isAuthenticated(container.SessionManager), container.TrashController.RestoreBlog)
+	router.POST("/blog/trash/purge/:id", isAuthenticated(container.SessionManager), container.TrashController.PurgeBlog)
+	router.POST("/blog/bulk", isAuthenticated(container.SessionManager), container.BulkController.BulkOperate)
+	router.POST("/blog/autosave", isAuthenticated(container.SessionManager), container.AutosaveController.SaveAutosave)
+	router.GET("/blog/autosave/:id", isAuthenticated(container.SessionManager), container.AutosaveController.GetAutosave)
+
+	// Category系ルーティング
+	router.GET("/category/tree", isAuthenticated(container.SessionManager), container.CategoryController.GetCategoryTree)
+	router.POST("/category/post", isAuthenticated(container.SessionManager), container.CategoryController.PostCategory)
+	router.POST("/category/rename", isAuthenticated(container.SessionManager), container.CategoryController.RenameCategory)
+	router.POST("/category/move", isAuthenticated(container.SessionManager), container.CategoryController.MoveCategory)
+	router.POST("/category/delete/:id", isAuthenticated(container.SessionManager), container.CategoryController.DeleteCategory)
+	router.GET("/blog/category/:id", isAuthenticated(container.SessionManager), container.CategoryController.GetBlogCategories)
+	router.POST("/blog/category/assign", isAuthenticated(container.SessionManager), container.CategoryController.AssignBlogCategory)
+	router.POST("/blog/category/unassign", isAuthenticated(container.SessionManager), container.CategoryController.UnassignBlogCategory)
+
+	// Tag系ルーティング
+	router.POST("/blog/tag/set", isAuthenticated(container.SessionManager), container.TagController.SetBlogTags)
+	router.GET("/blog/tag/:id", isAuthenticated(container.SessionManager), container.TagController.GetBlogTags)
+	router.GET("/tag/blogs/:name", isAuthenticated(container.SessionManager), container.TagController.ListBlogsByTag)
+	router.GET("/tag/suggest", isAuthenticated(container.SessionManager), container.TagController.SuggestTags)
+	router.GET("/tag/cloud", isAuthenticated(container.SessionManager), container.TagController.GetTagCloud)
+
+	// Attachment系ルーティング
+	router.POST("/attachment/upload", isAuthenticated(container.SessionManager), container.AttachmentController.Upload)
+	router.GET("/attachment/list", isAuthenticated(container.SessionManager), container.AttachmentController.ListAttachments)
+	router.GET("/blog/attachment/:id", isAuthenticated(container.SessionManager), container.AttachmentController.ListBlogAttachments)
+	router.POST("/attachment/delete/:id", isAuthenticated(container.SessionManager), container.AttachmentController.DeleteAttachment)
+	// 公開記事に埋め込まれた画像を表示できるよう認証は不要（保存キーは内容のハッシュ値）
+	router.GET("/files/:shard/:name", container.AttachmentController.GetFile)
+
+	// Export系ルーティング
+	router.GET("/export/archive", isAuthenticated(container.SessionManager), container.ExportController.DownloadArchive)
+	router.POST("/export/jobs", isAuthenticated(container.SessionManager), container.ExportController.StartExport)
+	router.GET("/export/jobs/:id", isAuthenticated(container.SessionManager), container.ExportController.GetJob)
+	// ダウンロードURLのトークンで認可するため認証不要
+	router.GET("/export/download/:token", container.ExportController.DownloadJobArchive)
+
+	// Import系ルーティング
+	router.POST("/import/upload", isAuthenticated(container.SessionManager), container.ImportController.Upload)
+
+	// Comment系ルーティング
+	router.POST("/comment/post", isAuthenticated(container.SessionManager), container.CommentController.PostComment)
+	router.GET("/comment/list/:id", isAuthenticated(container.SessionManager), container.CommentController.GetComments)
+	router.GET("/comment/moderation", isAuthenticated(container.SessionManager), container.CommentController.GetModerationQueue)
+	router.POST("/comment/moderate", isAuthenticated(container.SessionManager), container.CommentController.ModerateComment)
+
+	// Reaction系ルーティング
+	router.GET("/reaction/:id", isAuthenticated(container.SessionManager), container.ReactionController.GetReactions)
+	router.GET("/reaction/:id/users", isAuthenticated(container.SessionManager), container.ReactionController.ListReactors)
+	router.POST("/reaction/toggle", isAuthenticated(container.SessionManager), container.ReactionController.ToggleReaction)
+
+	// Collaborator系ルーティング
+	router.POST("/blog/collaborator/invite", isAuthenticated(container.SessionManager), container.CollaboratorController.InviteCollaborator)
+	router.GET("/blog/collaborator/:id", isAuthenticated(container.SessionManager), container.CollaboratorController.ListCollaborators)
+	router.POST("/blog/collaborator/remove", isAuthenticated(container.SessionManager), container.CollaboratorController.RemoveCollaborator)
+	router.POST("/blog/transfer", isAuthenticated(container.SessionManager), container.CollaboratorController.TransferOwnership)
+	router.GET("/collaborator/invitations", isAuthenticated(container.SessionManager), container.CollaboratorController.ListInvitations)
+	router.POST("/collaborator/respond", isAuthenticated(container.SessionManager), container.CollaboratorController.RespondInvitation)
+
+	// Series系ルーティング
+	router.POST("/series/post", isAuthenticated(container.SessionManager), container.SeriesController.PostSeries)
+	router.GET("/series/:id", isAuthenticated(container.SessionManager), container.SeriesController.GetSeries)
+	router.POST("/series/add", isAuthenticated(container.SessionManager), container.SeriesController.AddBlog)
+	router.POST("/series/remove", isAuthenticated(container.SessionManager), container.SeriesController.RemoveBlog)
+	router.POST("/series/reorder", isAuthenticated(container.SessionManager), container.SeriesController.ReorderBlogs)
+
+	// Recommend系ルーティング
+	router.GET("/blog/related/:id", isAuthenticated(container.SessionManager), container.RecommendController.GetRelated)
+
+	// Stats系ルーティング
+	router.GET("/stats/views", isAuthenticated(container.SessionManager), container.StatsController.GetAuthorViews)
+	router.GET("/stats/views/:id", isAuthenticated(container.SessionManager), container.StatsController.GetBlogViews)
 
 	// User系ルーティング
 	router.POST("/update/id", isAuthenticated(container.SessionManager), container.SettingController.UpdateID)
@@ -32,8 +116,24 @@ func RegisterRoutes(router *gin.Engine, container *di.Container) {
 	router.POST("/logout", isAuthenticated(container.SessionManager), container.LogoutController.DecideLogout)
 	router.POST("/regist", isAuthenticated(container.SessionManager), container.RegistController.Regist)
 
+	// 公開ブログ系ルーティング
+	// 未ログインでも閲覧できるよう認証は不要（全体公開・共有URLによる限定公開の記事のみ返却）
+	router.GET("/public/blog/:id", container.PublicController.GetPublicBlog)
+	router.GET("/public/author/:username/:slug", container.PublicController.GetPublicBlogBySlug)
+	router.GET("/public/share/:token", container.PublicController.GetSharedBlog)
+
+	// Feed系ルーティング
+	// フィードリーダーはセッションを持たないため認証は不要（公開済みの記事のみ掲載）
+	router.GET("/feed/:format", container.FeedController.GetSiteFeed)
+	router.GET("/feed/author/:username/:format", container.FeedController.GetAuthorFeed)
+	router.GET("/feed/category/:id/:format", container.FeedController.GetCategoryFeed)
+
 	// ログイン共通系ルーティング
 	router.GET("/api/login-id", isAuthenticated(container.SessionManager), container.CommonController.GetLoginIdBySession)
+
+	// 著者名とスラッグによるブログ記事のURL
+	// 他のルーティングに一致しないパスのみ対象となる
+	router.GET("/:username/:slug", isAuthenticated(container.SessionManager), container.PermalinkController.GetBlogBySlug)
 }
 
 // ログイン中かどうかを判定するミドルウェア
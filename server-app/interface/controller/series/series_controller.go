@@ -0,0 +1,268 @@
+package series
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainSeries "github.com/kazukimurahashi12/webapp/domain/series"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseSeries "github.com/kazukimurahashi12/webapp/usecase/series"
+	"go.uber.org/zap"
+)
+
+type SeriesController struct {
+	seriesUseCase  usecaseSeries.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewSeriesController(seriesUseCase usecaseSeries.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *SeriesController {
+	return &SeriesController{
+		seriesUseCase:  seriesUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// シリーズ作成
+func (sc *SeriesController) PostSeries(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, sc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.SeriesPost{}
+	if !sc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// シリーズ作成UseCase
+	series, err := sc.seriesUseCase.CreateSeries(userID, req.Title, req.Description)
+	if err != nil {
+		sc.respondError(c, requestID, err, "シリーズの作成に失敗しました", "SERIES_CREATE_FAILED")
+		return
+	}
+
+	sc.logger.Info("Successfully created series",
+		zap.String("requestID", requestID),
+		zap.Uint("seriesID", series.ID))
+	c.JSON(http.StatusCreated, gin.H{
+		"message":    "シリーズを作成しました",
+		"code":       "SERIES_CREATED",
+		"request_id": requestID,
+		"series":     mapper.ToSeriesResponse(series),
+	})
+}
+
+// シリーズと目次の取得
+func (sc *SeriesController) GetSeries(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, sc.logger)
+	if !ok {
+		return
+	}
+
+	idStr := c.Param("id")
+	seriesID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		sc.logger.Error("Invalid series ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "シリーズIDの形式が不正です",
+			"code":       "INVALID_SERIES_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// シリーズ取得UseCase
+	series, err := sc.seriesUseCase.GetSeries(userID, uint(seriesID))
+	if err != nil {
+		sc.respondError(c, requestID, err, "シリーズの取得に失敗しました", "SERIES_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "シリーズを取得しました",
+		"code":       "SERIES_FETCHED",
+		"request_id": requestID,
+		"series":     mapper.ToSeriesResponse(series),
+	})
+}
+
+// シリーズへのブログ追加
+// シリーズの末尾に追加する
+func (sc *SeriesController) AddBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, sc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.SeriesEntryRequest{}
+	if !sc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// シリーズへのブログ追加UseCase
+	series, err := sc.seriesUseCase.AddBlog(userID, req.SeriesID, req.BlogID)
+	if err != nil {
+		sc.respondError(c, requestID, err, "シリーズへのブログ記事の追加に失敗しました", "SERIES_ADD_FAILED")
+		return
+	}
+
+	sc.logger.Info("Successfully added blog to series",
+		zap.String("requestID", requestID),
+		zap.Uint("seriesID", req.SeriesID),
+		zap.Uint("blogID", req.BlogID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "シリーズにブログ記事を追加しました",
+		"code":       "SERIES_BLOG_ADDED",
+		"request_id": requestID,
+		"series":     mapper.ToSeriesResponse(series),
+	})
+}
+
+// シリーズからのブログ削除
+func (sc *SeriesController) RemoveBlog(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, sc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.SeriesEntryRequest{}
+	if !sc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// シリーズからのブログ削除UseCase
+	series, err := sc.seriesUseCase.RemoveBlog(userID, req.SeriesID, req.BlogID)
+	if err != nil {
+		sc.respondError(c, requestID, err, "シリーズからのブログ記事の削除に失敗しました", "SERIES_REMOVE_FAILED")
+		return
+	}
+
+	sc.logger.Info("Successfully removed blog from series",
+		zap.String("requestID", requestID),
+		zap.Uint("seriesID", req.SeriesID),
+		zap.Uint("blogID", req.BlogID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "シリーズからブログ記事を削除しました",
+		"code":       "SERIES_BLOG_REMOVED",
+		"request_id": requestID,
+		"series":     mapper.ToSeriesResponse(series),
+	})
+}
+
+// シリーズ内のブログの並べ替え
+// 全てのブログIDを新しい順序で指定し、一括で反映する
+func (sc *SeriesController) ReorderBlogs(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, sc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.SeriesReorder{}
+	if !sc.bindJSON(c, requestID, &req) {
+		return
+	}
+
+	// シリーズ内のブログ並べ替えUseCase
+	series, err := sc.seriesUseCase.ReorderBlogs(userID, req.SeriesID, req.BlogIDs)
+	if err != nil {
+		sc.respondError(c, requestID, err, "シリーズの並べ替えに失敗しました", "SERIES_REORDER_FAILED")
+		return
+	}
+
+	sc.logger.Info("Successfully reordered series",
+		zap.String("requestID", requestID),
+		zap.Uint("seriesID", req.SeriesID))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "シリーズを並べ替えました",
+		"code":       "SERIES_REORDERED",
+		"request_id": requestID,
+		"series":     mapper.ToSeriesResponse(series),
+	})
+}
+
+// JSON形式のリクエストボディを構造体にバインド
+func (sc *SeriesController) bindJSON(c *gin.Context, requestID string, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		sc.logger.Error("Failed to bind JSON in series request",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "シリーズデータの形式が不正です",
+			"code":       "INVALID_SERIES_FORMAT",
+			"request_id": requestID,
+		})
+		return false
+	}
+	return true
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (sc *SeriesController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainSeries.ErrSeriesNotFound):
+		status, message, code = http.StatusNotFound, "指定されたシリーズが存在しません", "SERIES_NOT_FOUND"
+	case errors.Is(err, domainSeries.ErrSeriesInvalid):
+		status, message, code = http.StatusBadRequest, "シリーズの内容が不正です", "INVALID_SERIES"
+	case errors.Is(err, domainSeries.ErrSeriesUnauthorized):
+		status, message, code = http.StatusForbidden, "このシリーズを変更する権限がありません", "SERIES_ACCESS_DENIED"
+	case errors.Is(err, domainSeries.ErrSeriesEntryNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事はシリーズに含まれていません", "SERIES_ENTRY_NOT_FOUND"
+	case errors.Is(err, domainSeries.ErrSeriesBlogConflict):
+		status, message, code = http.StatusConflict, "指定されたブログ記事は既にシリーズに含まれています", "SERIES_BLOG_CONFLICT"
+	case errors.Is(err, domainSeries.ErrSeriesFull):
+		status, message, code = http.StatusConflict, "シリーズに追加できるブログ記事の上限に達しています", "SERIES_FULL"
+	case errors.Is(err, domainSeries.ErrSeriesOrderMismatch):
+		status, message, code = http.StatusConflict, "並べ替え後の順序がシリーズ内のブログ記事と一致しません", "SERIES_ORDER_MISMATCH"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を追加する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	sc.logger.Error("Series request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,193 @@
+package series
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainSeries "github.com/kazukimurahashi12/webapp/domain/series"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	seriesMocks "github.com/kazukimurahashi12/webapp/usecase/series/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSeriesController_GetSeries(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/series/5", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "5"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSeriesUseCase.EXPECT().
+			GetSeries(uint(123), uint(5)).
+			Return(&domainSeries.Series{
+				ID:      5,
+				OwnerID: 123,
+				Title:   "Go入門",
+				Entries: []domainSeries.Entry{
+					{Position: 1, Blog: domainBlog.Blog{ID: 10, Title: "第1回"}},
+					{Position: 2, Blog: domainBlog.Blog{ID: 11, Title: "第2回"}},
+				},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewSeriesController(mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetSeries(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Series struct {
+				ID      uint `json:"id"`
+				Entries []struct {
+					Position int  `json:"position"`
+					BlogID   uint `json:"blogId"`
+				} `json:"entries"`
+			} `json:"series"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(5), response.Series.ID)
+			if assert.Len(t, response.Series.Entries, 2) {
+				assert.Equal(t, 1, response.Series.Entries[0].Position)
+				assert.Equal(t, uint(11), response.Series.Entries[1].BlogID)
+			}
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/series/6", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "6"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSeriesUseCase.EXPECT().
+			GetSeries(uint(123), uint(6)).
+			Return(nil, domainSeries.ErrSeriesNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewSeriesController(mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetSeries(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+}
+
+func TestSeriesController_ReorderBlogs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"seriesId":5,"blogIds":[11,10]}`
+		req := httptest.NewRequest(http.MethodPost, "/series/reorder", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSeriesUseCase.EXPECT().
+			ReorderBlogs(uint(123), uint(5), []uint{11, 10}).
+			Return(&domainSeries.Series{
+				ID: 5,
+				Entries: []domainSeries.Entry{
+					{Position: 1, Blog: domainBlog.Blog{ID: 11}},
+					{Position: 2, Blog: domainBlog.Blog{ID: 10}},
+				},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewSeriesController(mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.ReorderBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "SERIES_REORDERED")
+	})
+
+	t.Run("OrderMismatch", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"seriesId":5,"blogIds":[11]}`
+		req := httptest.NewRequest(http.MethodPost, "/series/reorder", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSeriesUseCase.EXPECT().
+			ReorderBlogs(uint(123), uint(5), []uint{11}).
+			Return(nil, domainSeries.ErrSeriesOrderMismatch)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewSeriesController(mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.ReorderBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusConflict, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "SERIES_ORDER_MISMATCH")
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"seriesId":5,"blogIds":[11,10]}`
+		req := httptest.NewRequest(http.MethodPost, "/series/reorder", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "999")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockSeriesUseCase := seriesMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockSeriesUseCase.EXPECT().
+			ReorderBlogs(uint(999), uint(5), []uint{11, 10}).
+			Return(nil, domainSeries.ErrSeriesUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewSeriesController(mockSeriesUseCase, mockSession, logger)
+
+		// 実行
+		controller.ReorderBlogs(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
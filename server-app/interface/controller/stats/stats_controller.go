@@ -0,0 +1,155 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseStats "github.com/kazukimurahashi12/webapp/usecase/stats"
+	"go.uber.org/zap"
+)
+
+type StatsController struct {
+	statsUseCase   usecaseStats.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewStatsController(statsUseCase usecaseStats.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *StatsController {
+	return &StatsController{
+		statsUseCase:   statsUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ログインユーザーの全てのブログ記事の日別閲覧数の推移取得
+// クエリパラメータfrom・toで期間（YYYY-MM-DD）を指定する
+func (s *StatsController) GetAuthorViews(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, s.logger)
+	if !ok {
+		return
+	}
+
+	query, ok := s.bindSeriesQuery(c, requestID)
+	if !ok {
+		return
+	}
+
+	// 閲覧数の推移取得UseCase
+	series, err := s.statsUseCase.GetAuthorViews(userID, query)
+	if err != nil {
+		s.respondError(c, requestID, err, "閲覧数の取得に失敗しました", "VIEW_STATS_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "閲覧数を取得しました",
+		"code":       "VIEW_STATS_FETCHED",
+		"request_id": requestID,
+		"views":      mapper.ToViewSeriesResponse(series),
+	})
+}
+
+// ブログ記事の日別閲覧数の推移取得
+// 著者本人と共同編集者が参照できる
+func (s *StatsController) GetBlogViews(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, s.logger)
+	if !ok {
+		return
+	}
+
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		s.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	query, ok := s.bindSeriesQuery(c, requestID)
+	if !ok {
+		return
+	}
+
+	// 閲覧数の推移取得UseCase
+	series, err := s.statsUseCase.GetBlogViews(userID, uint(blogID), query)
+	if err != nil {
+		s.respondError(c, requestID, err, "閲覧数の取得に失敗しました", "VIEW_STATS_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "閲覧数を取得しました",
+		"code":       "VIEW_STATS_FETCHED",
+		"request_id": requestID,
+		"views":      mapper.ToViewSeriesResponse(series),
+	})
+}
+
+// クエリパラメータから閲覧数の推移の取得期間を取得
+func (s *StatsController) bindSeriesQuery(c *gin.Context, requestID string) (domainStats.SeriesQuery, bool) {
+	req := dto.ViewSeriesQuery{}
+	err := c.ShouldBindQuery(&req)
+
+	query := domainStats.SeriesQuery{}
+	if err == nil && req.From != "" {
+		query.From, err = time.ParseInLocation(time.DateOnly, req.From, time.Local)
+	}
+	if err == nil && req.To != "" {
+		query.To, err = time.ParseInLocation(time.DateOnly, req.To, time.Local)
+	}
+	if err != nil {
+		s.respondError(c, requestID, fmt.Errorf("%w: %v", domainStats.ErrStatsInvalidQuery, err), "", "")
+		return query, false
+	}
+	return query, true
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (s *StatsController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainStats.ErrStatsInvalidQuery):
+		status, message, code = http.StatusBadRequest, "取得期間の形式が不正です", "INVALID_STATS_QUERY"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事の閲覧数を参照する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	s.logger.Error("Stats request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
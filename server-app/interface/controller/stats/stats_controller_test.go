@@ -0,0 +1,154 @@
+package stats
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	statsMocks "github.com/kazukimurahashi12/webapp/usecase/stats/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStatsController_GetBlogViews(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/stats/views/10?from=2024-05-01&to=2024-05-02", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)
+		to := time.Date(2024, 5, 2, 0, 0, 0, 0, time.Local)
+		query := domainStats.SeriesQuery{From: from, To: to}
+		mockStatsUseCase.EXPECT().
+			GetBlogViews(uint(123), uint(10), query).
+			Return(domainStats.NewSeries(10, query, []domainStats.DailyViews{
+				{BlogID: 10, Day: to, Views: 12, UniqueVisitors: 5},
+			}), nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewStatsController(mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogViews(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Views struct {
+				BlogID     uint  `json:"blogId"`
+				TotalViews int64 `json:"totalViews"`
+				Points     []struct {
+					Day            string `json:"day"`
+					Views          int64  `json:"views"`
+					UniqueVisitors int64  `json:"uniqueVisitors"`
+				} `json:"points"`
+			} `json:"views"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, uint(10), response.Views.BlogID)
+			assert.Equal(t, int64(12), response.Views.TotalViews)
+			if assert.Len(t, response.Views.Points, 2) {
+				// 閲覧のない日は0件として補完される
+				assert.Equal(t, "2024-05-01", response.Views.Points[0].Day)
+				assert.Equal(t, int64(0), response.Views.Points[0].Views)
+				assert.Equal(t, "2024-05-02", response.Views.Points[1].Day)
+				assert.Equal(t, int64(5), response.Views.Points[1].UniqueVisitors)
+			}
+		}
+	})
+
+	t.Run("NotAuthor", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/stats/views/10", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockStatsUseCase.EXPECT().
+			GetBlogViews(uint(123), uint(10), domainStats.SeriesQuery{}).
+			Return(nil, domainBlog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewStatsController(mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogViews(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "BLOG_ACCESS_DENIED")
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/stats/views/10?from=2024-13-01", nil)
+		ctx.Params = gin.Params{{Key: "id", Value: "10"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewStatsController(mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetBlogViews(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_STATS_QUERY")
+	})
+}
+
+func TestStatsController_GetAuthorViews(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("PeriodTooLong", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/stats/views?from=2020-01-01&to=2024-01-01", nil)
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockStatsUseCase := statsMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockStatsUseCase.EXPECT().
+			GetAuthorViews(uint(123), gomock.Any()).
+			Return(nil, domainStats.ErrStatsInvalidQuery)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewStatsController(mockStatsUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetAuthorViews(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "INVALID_STATS_QUERY")
+	})
+}
@@ -0,0 +1,276 @@
+package tag
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	"github.com/kazukimurahashi12/webapp/infrastructure/web/middleware"
+	"github.com/kazukimurahashi12/webapp/interface/controller/common"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+	"github.com/kazukimurahashi12/webapp/interface/mapper"
+	"github.com/kazukimurahashi12/webapp/interface/session"
+	usecaseTag "github.com/kazukimurahashi12/webapp/usecase/tag"
+	"go.uber.org/zap"
+)
+
+type TagController struct {
+	tagUseCase     usecaseTag.UseCase
+	sessionManager session.SessionManager
+	logger         *zap.Logger
+}
+
+func NewTagController(tagUseCase usecaseTag.UseCase, sessionManager session.SessionManager, logger *zap.Logger) *TagController {
+	return &TagController{
+		tagUseCase:     tagUseCase,
+		sessionManager: sessionManager,
+		logger:         logger,
+	}
+}
+
+// ブログ記事のタグ設定
+// 指定したタグで既存のタグを置き換える
+func (tc *TagController) SetBlogTags(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, tc.logger)
+	if !ok {
+		return
+	}
+
+	// JSON形式のリクエストボディを構造体にバインドする
+	req := dto.BlogTagsSet{}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		tc.logger.Error("Failed to bind JSON in tag setting",
+			zap.String("requestID", requestID),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "タグ設定データの形式が不正です",
+			"code":       "INVALID_TAG_SET_FORMAT",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// タグ設定UseCase
+	tags, err := tc.tagUseCase.SetBlogTags(userID, req.BlogID, req.Tags)
+	if err != nil {
+		tc.respondError(c, requestID, err, "タグの設定に失敗しました", "TAG_SET_FAILED")
+		return
+	}
+
+	tc.logger.Info("Successfully set blog tags",
+		zap.String("requestID", requestID),
+		zap.Uint("blogID", req.BlogID),
+		zap.Int("count", len(tags)))
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "タグを設定しました",
+		"code":       "TAG_SET",
+		"request_id": requestID,
+		"blog_id":    req.BlogID,
+		"tags":       mapper.ToTagsResponse(tags),
+	})
+}
+
+// ブログ記事のタグ一覧取得
+func (tc *TagController) GetBlogTags(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, tc.logger)
+	if !ok {
+		return
+	}
+
+	// ブログIDをリクエストから取得
+	idStr := c.Param("id")
+	blogID, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		tc.logger.Error("Invalid blog ID format",
+			zap.String("requestID", requestID),
+			zap.String("id", idStr),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "ブログIDの形式が不正です",
+			"code":       "INVALID_BLOG_ID",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	tags, err := tc.tagUseCase.FindTagsByBlogID(userID, uint(blogID))
+	if err != nil {
+		tc.respondError(c, requestID, err, "タグ一覧の取得に失敗しました", "TAG_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "タグ一覧を取得しました",
+		"code":       "TAG_FETCHED",
+		"request_id": requestID,
+		"tags":       mapper.ToTagsResponse(tags),
+	})
+}
+
+// タグが付いたブログ記事一覧取得
+func (tc *TagController) ListBlogsByTag(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	userID, ok := common.GetLoginUserID(c, tc.logger)
+	if !ok {
+		return
+	}
+
+	// クエリパラメータからページング・並び替え条件を取得
+	req := dto.TagBlogListQuery{}
+	err := c.ShouldBindQuery(&req)
+
+	query := domainBlog.ListQuery{
+		ViewerID: userID,
+		Limit:    req.Limit,
+		SortBy:   req.Sort,
+		Order:    req.Order,
+	}
+	if err == nil && req.Cursor != "" {
+		query.Cursor, err = domainBlog.DecodeCursor(req.Cursor)
+	}
+	if err == nil {
+		err = query.Normalize()
+	}
+	if err != nil {
+		tc.logger.Error("Invalid tag blog list query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "一覧取得条件の形式が不正です",
+			"code":       "INVALID_BLOG_QUERY",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// タグ別ブログ一覧取得UseCase
+	name := c.Param("name")
+	page, err := tc.tagUseCase.ListBlogsByTag(name, query)
+	if err != nil {
+		tc.respondError(c, requestID, err, "ブログ記事の取得に失敗しました", "BLOG_FETCH_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "ブログ記事を取得しました",
+		"code":       "BLOG_FETCHED",
+		"request_id": requestID,
+		"tag":        domainTag.NormalizeName(name),
+		"blogs":      mapper.ToBlogsResponse(page.Blogs),
+		"meta":       mapper.ToPageMeta(page, query),
+	})
+}
+
+// タグ名の入力候補取得
+func (tc *TagController) SuggestTags(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	req := dto.TagSuggestQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		tc.logger.Error("Invalid tag suggest query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "タグ候補の取得条件が不正です",
+			"code":       "INVALID_TAG_QUERY",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// タグ候補取得UseCase
+	tags, err := tc.tagUseCase.SuggestTags(req.Prefix, req.Limit)
+	if err != nil {
+		tc.respondError(c, requestID, err, "タグ候補の取得に失敗しました", "TAG_SUGGEST_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "タグ候補を取得しました",
+		"code":       "TAG_SUGGESTED",
+		"request_id": requestID,
+		"tags":       mapper.ToTagCountsResponse(tags),
+	})
+}
+
+// タグクラウド用のタグ使用件数取得
+func (tc *TagController) GetTagCloud(c *gin.Context) {
+	// コンテクストからリクエストIDを取得
+	ctx := c.Request.Context()
+	requestID := middleware.GetRequestID(ctx)
+
+	req := dto.TagCloudQuery{}
+	if err := c.ShouldBindQuery(&req); err != nil {
+		tc.logger.Error("Invalid tag cloud query",
+			zap.String("requestID", requestID),
+			zap.String("query", c.Request.URL.RawQuery),
+			zap.Error(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":      "タグクラウドの取得条件が不正です",
+			"code":       "INVALID_TAG_QUERY",
+			"request_id": requestID,
+		})
+		return
+	}
+
+	// タグクラウド取得UseCase
+	tags, err := tc.tagUseCase.GetTagCloud(req.Limit)
+	if err != nil {
+		tc.respondError(c, requestID, err, "タグクラウドの取得に失敗しました", "TAG_CLOUD_FAILED")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "タグクラウドを取得しました",
+		"code":       "TAG_CLOUD_FETCHED",
+		"request_id": requestID,
+		"tags":       mapper.ToTagCountsResponse(tags),
+	})
+}
+
+// ドメインエラーに応じたエラーレスポンスを返却
+func (tc *TagController) respondError(c *gin.Context, requestID string, err error, message, code string) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainTag.ErrTagInvalidData):
+		status, message, code = http.StatusBadRequest, err.Error(), "INVALID_TAG_ENTITY"
+	case errors.Is(err, domainTag.ErrTooManyTags):
+		status, message, code = http.StatusBadRequest, "設定できるタグの数を超えています", "TOO_MANY_TAGS"
+	case errors.Is(err, domainTag.ErrTagNotFound):
+		status, message, code = http.StatusNotFound, "指定されたタグが存在しません", "TAG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogInvalidQuery):
+		status, message, code = http.StatusBadRequest, "一覧取得条件の形式が不正です", "INVALID_BLOG_QUERY"
+	case errors.Is(err, domainBlog.ErrBlogNotFound):
+		status, message, code = http.StatusNotFound, "指定されたブログ記事が存在しません", "BLOG_NOT_FOUND"
+	case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+		status, message, code = http.StatusForbidden, "このブログ記事を編集する権限がありません", "BLOG_ACCESS_DENIED"
+	}
+
+	tc.logger.Error("Tag request failed",
+		zap.String("requestID", requestID),
+		zap.String("code", code),
+		zap.Error(err))
+	c.JSON(status, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": requestID,
+	})
+}
@@ -0,0 +1,306 @@
+package tag
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	sessionMocks "github.com/kazukimurahashi12/webapp/interface/session/mocks"
+	tagMocks "github.com/kazukimurahashi12/webapp/usecase/tag/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTagController_SetBlogTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"tags":["Ｇｏ","ｶﾞｲﾄﾞ"]}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/tag/set", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			SetBlogTags(uint(123), uint(10), []string{"Ｇｏ", "ｶﾞｲﾄﾞ"}).
+			Return([]domainTag.Tag{{ID: 1, Name: "go"}, {ID: 2, Name: "ガイド"}}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.SetBlogTags(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Tags []struct {
+				Name string `json:"name"`
+			} `json:"tags"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Tags, 2) {
+			assert.Equal(t, "go", response.Tags[0].Name)
+			assert.Equal(t, "ガイド", response.Tags[1].Name)
+		}
+	})
+
+	t.Run("TooManyTags", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"tags":["a","b"]}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/tag/set", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			SetBlogTags(uint(123), uint(10), []string{"a", "b"}).
+			Return(nil, domainTag.ErrTooManyTags)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.SetBlogTags(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "TOO_MANY_TAGS")
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		reqBody := `{"blogId":10,"tags":["go"]}`
+		req := httptest.NewRequest(http.MethodPost, "/blog/tag/set", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		ctx.Request = req
+		ctx.Set("userID", "456")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			SetBlogTags(uint(456), uint(10), []string{"go"}).
+			Return(nil, domainBlog.ErrBlogUnauthorized)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.SetBlogTags(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+}
+
+func TestTagController_ListBlogsByTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/blogs/Go?limit=1&sort=title&order=asc", nil)
+		ctx.Params = gin.Params{{Key: "name", Value: "Go"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			ListBlogsByTag("Go", gomock.Any()).
+			DoAndReturn(func(name string, query domainBlog.ListQuery) (*domainBlog.Page, error) {
+				assert.Equal(t, uint(123), query.ViewerID)
+				assert.Equal(t, uint(0), query.AuthorID)
+				assert.Equal(t, 1, query.Limit)
+				assert.Equal(t, domainBlog.SortByTitle, query.SortBy)
+				assert.Equal(t, domainBlog.OrderAsc, query.Order)
+				return &domainBlog.Page{
+					Blogs:      []domainBlog.Blog{{ID: 10, Title: "Go入門"}},
+					HasNext:    true,
+					NextCursor: "next",
+				}, nil
+			})
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListBlogsByTag(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Tag  string `json:"tag"`
+			Meta struct {
+				HasNext    bool   `json:"hasNext"`
+				NextCursor string `json:"nextCursor"`
+			} `json:"meta"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) {
+			assert.Equal(t, "go", response.Tag)
+			assert.True(t, response.Meta.HasNext)
+			assert.Equal(t, "next", response.Meta.NextCursor)
+		}
+	})
+
+	t.Run("TagNotFound", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/blogs/unknown", nil)
+		ctx.Params = gin.Params{{Key: "name", Value: "unknown"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			ListBlogsByTag("unknown", gomock.Any()).
+			Return(nil, domainTag.ErrTagNotFound)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListBlogsByTag(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/blogs/go?cursor=!!!", nil)
+		ctx.Params = gin.Params{{Key: "name", Value: "go"}}
+		ctx.Set("userID", "123")
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.ListBlogsByTag(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
+
+func TestTagController_SuggestTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/suggest?q=go&limit=5", nil)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			SuggestTags("go", 5).
+			Return([]domainTag.TagCount{
+				{Tag: domainTag.Tag{ID: 1, Name: "go"}, Count: 12},
+				{Tag: domainTag.Tag{ID: 3, Name: "gorm"}, Count: 0},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.SuggestTags(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), `"name":"gorm"`)
+	})
+}
+
+func TestTagController_GetTagCloud(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("Success", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/cloud", nil)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		// モック設定
+		mockTagUseCase.EXPECT().
+			GetTagCloud(0).
+			Return([]domainTag.TagCount{
+				{Tag: domainTag.Tag{ID: 1, Name: "go"}, Count: 12},
+				{Tag: domainTag.Tag{ID: 2, Name: "ガイド"}, Count: 3},
+			}, nil)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTagCloud(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		var response struct {
+			Tags []struct {
+				Name  string `json:"name"`
+				Count int64  `json:"count"`
+			} `json:"tags"`
+		}
+		if assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response)) && assert.Len(t, response.Tags, 2) {
+			assert.Equal(t, int64(12), response.Tags[0].Count)
+		}
+	})
+
+	t.Run("LimitTooLarge", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(recorder)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/tag/cloud?limit=1000", nil)
+
+		mockSession := sessionMocks.NewMockSessionManager(ctrl)
+		mockTagUseCase := tagMocks.NewMockUseCase(ctrl)
+
+		logger := zaptest.NewLogger(t)
+		controller := NewTagController(mockTagUseCase, mockSession, logger)
+
+		// 実行
+		controller.GetTagCloud(ctx)
+
+		// 検証
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
@@ -0,0 +1,26 @@
+package dto
+
+type AttachmentResponse struct {
+	ID          uint                         `json:"id"`
+	BlogID      *uint                        `json:"blogId,omitempty"`
+	Filename    string                       `json:"filename"`
+	ContentType string                       `json:"contentType"`
+	Size        int64                        `json:"size"`
+	Width       int                          `json:"width,omitempty"`
+	Height      int                          `json:"height,omitempty"`
+	Processing  string                       `json:"processing"`
+	URL         string                       `json:"url"` // 本文への埋め込みに使用するURL
+	Variants    []*AttachmentVariantResponse `json:"variants,omitempty"`
+	Srcset      map[string]string            `json:"srcset,omitempty"` // 形式ごとのsrcset属性の値
+	Created     string                       `json:"created_at"`
+}
+
+type AttachmentVariantResponse struct {
+	Name        string `json:"name"`
+	Format      string `json:"format"`
+	ContentType string `json:"contentType"`
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Size        int64  `json:"size"`
+	URL         string `json:"url"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type AutosaveRequest struct {
+	BlogID      uint   `json:"blogId"` // 新規作成中の下書きは0または省略
+	Title       string `json:"title"`
+	Content     string `json:"content"`
+	BaseVersion uint   `json:"baseVersion"` // 編集元のバージョン（省略時は現在のバージョン）
+}
+
+type AutosaveResponse struct {
+	BlogID      uint      `json:"blogId"`
+	Title       string    `json:"title"`
+	Content     string    `json:"content"`
+	BaseVersion uint      `json:"baseVersion"`
+	SavedAt     time.Time `json:"savedAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type AutosaveComparisonResponse struct {
+	Autosave *AutosaveResponse      `json:"autosave"`
+	Blog     *BlogDetailResponse    `json:"blog,omitempty"` // 新規作成中の下書きの場合は省略
+	Changed  bool                   `json:"changed"`        // 保存済みの内容と異なる
+	Outdated bool                   `json:"outdated"`       // 自動保存の編集元より後に保存済みのブログが更新された
+	Title    []*DiffSegmentResponse `json:"title"`
+	Lines    []*DiffLineResponse    `json:"lines"`
+}
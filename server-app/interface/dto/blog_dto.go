@@ -1,10 +1,17 @@
 package dto
 
+import "time"
+
 type BlogPost struct {
 	ID      string `json:"id"`
 	UserID  string `json:"userId" binding:"required,min=2,max=10"`
 	Title   string `json:"title" binding:"required,min=1,max=50"`
+	Slug    string `json:"slug" binding:"max=80"` // 未指定の場合はタイトルから生成
 	Content string `json:"content" binding:"required,min=1,max=8000"`
+	Version uint   `json:"version"` // If-Matchヘッダー未指定時に使用する更新元バージョン
+	Status  string `json:"status" binding:"omitempty,oneof=draft published"`
+	// 未指定の場合は作成時は全体公開、更新時は現在の公開範囲を維持
+	Visibility string `json:"visibility" binding:"omitempty,oneof=public unlisted private"`
 }
 
 type BlogPostResponse struct {
@@ -15,7 +22,113 @@ type BlogPostResponse struct {
 	Created string `json:"created_at"`
 }
 
+type BlogDetailResponse struct {
+	ID          uint                      `json:"id"`
+	AuthorID    uint                      `json:"authorId"`
+	Title       string                    `json:"title"`
+	Slug        string                    `json:"slug"`
+	Content     string                    `json:"content"`
+	Version     uint                      `json:"version"`
+	Status      string                    `json:"status"`
+	Visibility  string                    `json:"visibility"`
+	ShareToken  string                    `json:"shareToken,omitempty"` // 著者本人への応答にのみ含める
+	PublishAt   string                    `json:"publish_at,omitempty"`
+	PublishedAt string                    `json:"published_at,omitempty"`
+	Created     string                    `json:"created_at"`
+	Updated     string                    `json:"updated_at"`
+	ContentHTML string                    `json:"contentHtml,omitempty"` // 本文をMarkdownとして変換したHTML
+	TOC         []*TOCEntryResponse       `json:"toc,omitempty"`
+	Series      *SeriesNavigationResponse `json:"series,omitempty"` // 属するシリーズと前後のブログ
+	Role        string                    `json:"role,omitempty"`   // 閲覧者の権限（owner/editor/viewer）
+}
+
+// 目次の項目
+type TOCEntryResponse struct {
+	Level  int    `json:"level"`
+	Text   string `json:"text"`
+	Anchor string `json:"anchor"`
+}
+
 type BlogCreatedResponse struct {
-	ID    uint   `json:"id"`
-	Title string `json:"title"`
+	ID        uint           `json:"id"`
+	Title     string         `json:"title"`
+	Reactions map[string]int `json:"reactions,omitempty"` // 一覧取得時のみ
+}
+
+type BlogListQuery struct {
+	Limit    int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor   string `form:"cursor"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=created updated title"`
+	Order    string `form:"order" binding:"omitempty,oneof=asc desc"`
+	Title    string `form:"q" binding:"max=50"`
+	From     string `form:"from"`
+	To       string `form:"to"`
+	Category uint   `form:"category"`
+	Status   string `form:"status" binding:"omitempty,oneof=draft scheduled published archived"`
+}
+
+type BlogSchedule struct {
+	BlogID    uint      `json:"blogId" binding:"required"`
+	PublishAt time.Time `json:"publishAt" binding:"required"`
+}
+
+type PageMeta struct {
+	Count      int    `json:"count"`
+	Limit      int    `json:"limit"`
+	Sort       string `json:"sort"`
+	Order      string `json:"order"`
+	HasNext    bool   `json:"hasNext"`
+	HasPrev    bool   `json:"hasPrev"`
+	NextCursor string `json:"nextCursor,omitempty"`
+	PrevCursor string `json:"prevCursor,omitempty"`
+}
+
+type BlogSearchQuery struct {
+	Keyword string `form:"q" binding:"required,max=100"`
+	Author  uint   `form:"author"`
+	From    string `form:"from"`
+	To      string `form:"to"`
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset  int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+type BlogSearchHitResponse struct {
+	ID             uint    `json:"id"`
+	AuthorID       uint    `json:"authorId"`
+	Title          string  `json:"title"`
+	TitleHighlight string  `json:"titleHighlight"`
+	Snippet        string  `json:"snippet"`
+	Score          float64 `json:"score"`
+	Created        string  `json:"created_at"`
+}
+
+type TrashedBlogResponse struct {
+	ID        uint   `json:"id"`
+	Title     string `json:"title"`
+	Status    string `json:"status"`
+	DeletedAt string `json:"deleted_at"`
+	PurgeAt   string `json:"purge_at"`
+}
+
+type BlogBulkRequest struct {
+	Action     string `json:"action" binding:"required,oneof=delete restore visibility add_category remove_category"`
+	BlogIDs    []uint `json:"blogIds" binding:"required,min=1"`
+	Visibility string `json:"visibility"` // action=visibilityの場合のみ
+	CategoryID uint   `json:"categoryId"` // action=add_category・remove_categoryの場合のみ
+	DryRun     bool   `json:"dryRun"`
+}
+
+type BulkItemResponse struct {
+	BlogID uint   `json:"blogId"`
+	Result string `json:"result"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type BulkReportResponse struct {
+	Action    string              `json:"action"`
+	DryRun    bool                `json:"dryRun"`
+	Applied   int                 `json:"applied"`
+	Unchanged int                 `json:"unchanged"`
+	Failed    int                 `json:"failed"`
+	Items     []*BulkItemResponse `json:"items"`
 }
@@ -0,0 +1,30 @@
+package dto
+
+type CategoryPost struct {
+	Name        string `json:"name" binding:"required,min=1,max=50"`
+	Description string `json:"description" binding:"max=255"`
+	ParentID    *uint  `json:"parentId"`
+}
+
+type CategoryRename struct {
+	ID   uint   `json:"id" binding:"required"`
+	Name string `json:"name" binding:"required,min=1,max=50"`
+}
+
+type CategoryMove struct {
+	ID       uint  `json:"id" binding:"required"`
+	ParentID *uint `json:"parentId"`
+}
+
+type BlogCategoryAssign struct {
+	BlogID     uint `json:"blogId" binding:"required"`
+	CategoryID uint `json:"categoryId" binding:"required"`
+}
+
+type CategoryResponse struct {
+	ID          uint                `json:"id"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	ParentID    *uint               `json:"parentId"`
+	Children    []*CategoryResponse `json:"children,omitempty"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type CollaboratorInvite struct {
+	BlogID uint   `json:"blogId" binding:"required"`
+	UserID uint   `json:"userId" binding:"required"`
+	Role   string `json:"role" binding:"required,oneof=editor viewer"`
+}
+
+type CollaboratorRemove struct {
+	BlogID uint `json:"blogId" binding:"required"`
+	UserID uint `json:"userId" binding:"required"`
+}
+
+type InvitationAnswer struct {
+	BlogID uint  `json:"blogId" binding:"required"`
+	Accept *bool `json:"accept" binding:"required"`
+}
+
+type OwnershipTransfer struct {
+	BlogID     uint `json:"blogId" binding:"required"`
+	NewOwnerID uint `json:"newOwnerId" binding:"required"`
+}
+
+type CollaboratorResponse struct {
+	BlogID      uint       `json:"blogId"`
+	BlogTitle   string     `json:"blogTitle,omitempty"` // 招待一覧取得時のみ
+	UserID      uint       `json:"userId"`
+	Username    string     `json:"username"`
+	Role        string     `json:"role"`
+	Status      string     `json:"status"`
+	InvitedBy   uint       `json:"invitedBy"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	RespondedAt *time.Time `json:"respondedAt"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+type CommentPost struct {
+	PostID   uint   `json:"postId" binding:"required"`
+	ParentID *uint  `json:"parentId"`
+	Content  string `json:"content" binding:"required,min=1,max=2000"`
+}
+
+type CommentModerate struct {
+	CommentID uint   `json:"commentId" binding:"required"`
+	Status    string `json:"status" binding:"required,oneof=approved rejected spam"`
+}
+
+type CommentResponse struct {
+	ID         uint               `json:"id"`
+	PostID     uint               `json:"postId"`
+	ParentID   *uint              `json:"parentId"`
+	AuthorName string             `json:"authorName"`
+	Content    string             `json:"content"`
+	Status     string             `json:"status"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	Replies    []*CommentResponse `json:"replies,omitempty"`
+}
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+type ExportJobResponse struct {
+	ID              uint       `json:"id"`
+	Status          string     `json:"status"`
+	BlogCount       int        `json:"blogCount"`
+	AttachmentCount int        `json:"attachmentCount"`
+	Size            int64      `json:"size"`
+	Error           string     `json:"error,omitempty"`
+	StatusURL       string     `json:"statusUrl"`             // 状態の確認に使用するURL
+	DownloadURL     string     `json:"downloadUrl,omitempty"` // 作成済みの場合のみ（有効期限付き）
+	CreatedAt       time.Time  `json:"createdAt"`
+	StartedAt       *time.Time `json:"startedAt"`
+	CompletedAt     *time.Time `json:"completedAt"`
+	ExpiresAt       *time.Time `json:"expiresAt"`
+}
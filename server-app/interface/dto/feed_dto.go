@@ -0,0 +1,103 @@
+package dto
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// フィードのチャンネル情報
+type FeedChannel struct {
+	Title       string
+	Description string
+	Link        string // サイトまたは絞り込み対象のURL
+	SelfLink    string // フィード自身のURL
+	UpdatedAt   time.Time
+}
+
+// フィードの項目
+type FeedItem struct {
+	ID          string // 項目を一意に識別するURL
+	Title       string
+	Link        string
+	Author      string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+	Summary     string // タグを除いたプレーンテキスト
+	ContentHTML string
+}
+
+// RSS 2.0
+type RSS struct {
+	XMLName   xml.Name   `xml:"rss"`
+	Version   string     `xml:"version,attr"`
+	ContentNS string     `xml:"xmlns:content,attr"`
+	AtomNS    string     `xml:"xmlns:atom,attr"`
+	DCNS      string     `xml:"xmlns:dc,attr"`
+	Channel   RSSChannel `xml:"channel"`
+}
+
+type RSSChannel struct {
+	Title         string      `xml:"title"`
+	Link          string      `xml:"link"`
+	Description   string      `xml:"description"`
+	AtomLink      RSSAtomLink `xml:"atom:link"`
+	LastBuildDate string      `xml:"lastBuildDate,omitempty"`
+	Items         []RSSItem   `xml:"item"`
+}
+
+type RSSAtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type RSSItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        RSSGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Creator     string  `xml:"dc:creator"`
+	Description string  `xml:"description"`
+	Content     string  `xml:"content:encoded"`
+}
+
+type RSSGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// Atom
+type AtomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []AtomLink  `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type AtomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []AtomLink `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	Author    AtomPerson `xml:"author"`
+	Summary   AtomText   `xml:"summary"`
+	Content   AtomText   `xml:"content"`
+}
+
+type AtomPerson struct {
+	Name string `xml:"name"`
+}
+
+type AtomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
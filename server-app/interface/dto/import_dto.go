@@ -0,0 +1,23 @@
+package dto
+
+type ImportReportResponse struct {
+	DryRun        bool                       `json:"dryRun"`
+	Source        string                     `json:"source"`
+	Created       int                        `json:"created"`
+	Updated       int                        `json:"updated"`
+	Unchanged     int                        `json:"unchanged"`
+	Skipped       int                        `json:"skipped"`
+	NewCategories []string                   `json:"newCategories"`
+	Items         []ImportReportItemResponse `json:"items"`
+}
+
+type ImportReportItemResponse struct {
+	SourceID string   `json:"sourceId"`
+	Path     string   `json:"path"`
+	Title    string   `json:"title"`
+	Action   string   `json:"action"` // create, update, unchanged, skip
+	BlogID   uint     `json:"blogId,omitempty"`
+	Changes  []string `json:"changes,omitempty"`
+	Reason   string   `json:"reason,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+type ReactionToggle struct {
+	BlogID uint   `json:"blogId" binding:"required"`
+	Emoji  string `json:"emoji" binding:"required,max=32"`
+}
+
+type ReactionSummaryResponse struct {
+	BlogID uint                    `json:"blogId"`
+	Total  int                     `json:"total"`
+	Counts []ReactionCountResponse `json:"counts"`
+}
+
+type ReactionCountResponse struct {
+	Emoji   string `json:"emoji"`
+	Count   int    `json:"count"`
+	Reacted bool   `json:"reacted"` // ログインユーザー自身がリアクション済みか
+}
+
+type ReactorResponse struct {
+	UserID    uint      `json:"userId"`
+	Username  string    `json:"username"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type RelatedBlogResponse struct {
+	ID               uint       `json:"id"`
+	AuthorID         uint       `json:"authorId"`
+	Title            string     `json:"title"`
+	Slug             string     `json:"slug"`
+	PublishedAt      *time.Time `json:"publishedAt"`
+	Score            float64    `json:"score"`
+	SharedCategories int        `json:"sharedCategories"`
+	SharedTags       int        `json:"sharedTags"`
+}
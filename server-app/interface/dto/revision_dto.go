@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type RevisionDiffQuery struct {
+	From uint `form:"from" binding:"required"`
+	To   uint `form:"to" binding:"required"`
+}
+
+type RevisionRestore struct {
+	BlogID  uint `json:"blogId" binding:"required"`
+	Version uint `json:"version" binding:"required"`
+}
+
+type RevisionSummaryResponse struct {
+	Version   uint      `json:"version"`
+	Title     string    `json:"title"`
+	EditorID  uint      `json:"editorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type RevisionResponse struct {
+	BlogID    uint      `json:"blogId"`
+	Version   uint      `json:"version"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content"`
+	EditorID  uint      `json:"editorId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DiffSegmentResponse struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type DiffLineResponse struct {
+	Op       string                 `json:"op"`
+	Text     string                 `json:"text"`
+	Segments []*DiffSegmentResponse `json:"segments,omitempty"`
+}
+
+type RevisionDiffResponse struct {
+	From  *RevisionSummaryResponse `json:"from"`
+	To    *RevisionSummaryResponse `json:"to"`
+	Title []*DiffSegmentResponse   `json:"title"`
+	Lines []*DiffLineResponse      `json:"lines"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+type SeriesPost struct {
+	Title       string `json:"title" binding:"required,max=100"`
+	Description string `json:"description" binding:"max=500"`
+}
+
+type SeriesEntryRequest struct {
+	SeriesID uint `json:"seriesId" binding:"required"`
+	BlogID   uint `json:"blogId" binding:"required"`
+}
+
+// 並べ替え後の順序でシリーズ内の全てのブログIDを指定する
+type SeriesReorder struct {
+	SeriesID uint   `json:"seriesId" binding:"required"`
+	BlogIDs  []uint `json:"blogIds" binding:"required,min=1,max=100"`
+}
+
+type SeriesResponse struct {
+	ID          uint                   `json:"id"`
+	OwnerID     uint                   `json:"ownerId"`
+	Title       string                 `json:"title"`
+	Description string                 `json:"description"`
+	Entries     []*SeriesEntryResponse `json:"entries"` // 目次（表示順）
+	CreatedAt   time.Time              `json:"createdAt"`
+	UpdatedAt   time.Time              `json:"updatedAt"`
+}
+
+type SeriesEntryResponse struct {
+	Position    int        `json:"position"`
+	BlogID      uint       `json:"blogId"`
+	Title       string     `json:"title"`
+	Slug        string     `json:"slug"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"publishedAt"`
+}
+
+// ブログが属するシリーズと前後のブログへの案内
+type SeriesNavigationResponse struct {
+	SeriesID uint                 `json:"seriesId"`
+	Title    string               `json:"title"`
+	Position int                  `json:"position"`
+	Total    int                  `json:"total"`
+	Prev     *SeriesEntryResponse `json:"prev"`
+	Next     *SeriesEntryResponse `json:"next"`
+}
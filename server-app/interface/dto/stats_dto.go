@@ -0,0 +1,21 @@
+package dto
+
+type ViewSeriesQuery struct {
+	From string `form:"from"` // YYYY-MM-DD（この日を含む）
+	To   string `form:"to"`   // YYYY-MM-DD（この日を含む）
+}
+
+type ViewSeriesResponse struct {
+	BlogID         uint                `json:"blogId,omitempty"`
+	From           string              `json:"from"`
+	To             string              `json:"to"`
+	TotalViews     int64               `json:"totalViews"`
+	UniqueVisitors int64               `json:"uniqueVisitors"` // 日別のユニーク訪問者数の合計
+	Points         []ViewPointResponse `json:"points"`
+}
+
+type ViewPointResponse struct {
+	Day            string `json:"day"`
+	Views          int64  `json:"views"`
+	UniqueVisitors int64  `json:"uniqueVisitors"`
+}
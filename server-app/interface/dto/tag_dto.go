@@ -0,0 +1,33 @@
+package dto
+
+type BlogTagsSet struct {
+	BlogID uint     `json:"blogId" binding:"required"`
+	Tags   []string `json:"tags" binding:"max=10"`
+}
+
+type TagBlogListQuery struct {
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Cursor string `form:"cursor"`
+	Sort   string `form:"sort" binding:"omitempty,oneof=created updated title"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
+}
+
+type TagSuggestQuery struct {
+	Prefix string `form:"q" binding:"max=30"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=50"`
+}
+
+type TagCloudQuery struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=200"`
+}
+
+type TagResponse struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type TagCountResponse struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/kazukimurahashi12/webapp/domain/attachment"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToAttachmentResponse(a *attachment.Attachment) *dto.AttachmentResponse {
+	response := &dto.AttachmentResponse{
+		ID:          a.ID,
+		BlogID:      a.BlogID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Width:       a.Width,
+		Height:      a.Height,
+		Processing:  a.Processing,
+		URL:         AttachmentURL(a.StorageKey),
+		Created:     a.CreatedAt.Format(time.RFC3339),
+	}
+	if len(a.Variants) > 0 {
+		response.Variants = make([]*dto.AttachmentVariantResponse, len(a.Variants))
+		for i, v := range a.Variants {
+			response.Variants[i] = &dto.AttachmentVariantResponse{
+				Name:        v.Name,
+				Format:      v.Format,
+				ContentType: v.ContentType,
+				Width:       v.Width,
+				Height:      v.Height,
+				Size:        v.Size,
+				URL:         AttachmentURL(v.StorageKey),
+			}
+		}
+		response.Srcset = attachment.Srcsets(a.Variants, AttachmentURL)
+	}
+	return response
+}
+
+func ToAttachmentsResponse(attachments []attachment.Attachment) []*dto.AttachmentResponse {
+	responses := make([]*dto.AttachmentResponse, len(attachments))
+
+	for i := range attachments {
+		responses[i] = ToAttachmentResponse(&attachments[i])
+	}
+
+	return responses
+}
+
+// 保存キーに対応するファイル配信URLのパス
+func AttachmentURL(key string) string {
+	return "/files/" + key
+}
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToAutosaveResponse(a *blog.Autosave) *dto.AutosaveResponse {
+	return &dto.AutosaveResponse{
+		BlogID:      a.BlogID,
+		Title:       a.Title,
+		Content:     a.Content,
+		BaseVersion: a.BaseVersion,
+		SavedAt:     a.SavedAt,
+		ExpiresAt:   a.ExpiresAt,
+	}
+}
+
+func ToAutosaveComparisonResponse(c *blog.AutosaveComparison) *dto.AutosaveComparisonResponse {
+	response := &dto.AutosaveComparisonResponse{
+		Autosave: ToAutosaveResponse(c.Autosave),
+		Changed:  c.Changed,
+		Outdated: c.Outdated,
+		Title:    toDiffSegmentsResponse(c.Title),
+		Lines:    toDiffLinesResponse(c.Lines),
+	}
+	if c.Blog != nil {
+		response.Blog = ToBlogDetailResponse(c.Blog)
+	}
+	return response
+}
@@ -1,6 +1,8 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/kazukimurahashi12/webapp/domain/blog"
 	"github.com/kazukimurahashi12/webapp/interface/dto"
 )
@@ -12,15 +14,123 @@ func ToBlogCreatedResponse(b *blog.Blog) *dto.BlogCreatedResponse {
 	}
 }
 
+func ToBlogDetailResponse(b *blog.Blog) *dto.BlogDetailResponse {
+	return &dto.BlogDetailResponse{
+		ID:          b.ID,
+		AuthorID:    b.AuthorID,
+		Title:       b.Title,
+		Slug:        b.Slug,
+		Content:     b.Content,
+		Version:     b.Version,
+		Status:      b.Status,
+		Visibility:  b.Visibility,
+		PublishAt:   formatTimePtr(b.PublishAt),
+		PublishedAt: formatTimePtr(b.PublishedAt),
+		Created:     b.CreatedAt.Format(time.RFC3339),
+		Updated:     b.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// 閲覧用に本文の変換結果と目次を含めて変換
+func ToBlogViewResponse(b *blog.Blog, rendered *blog.RenderedContent) *dto.BlogDetailResponse {
+	response := ToBlogDetailResponse(b)
+	response.ContentHTML = rendered.HTML
+	response.TOC = make([]*dto.TOCEntryResponse, len(rendered.TOC))
+	for i, entry := range rendered.TOC {
+		response.TOC[i] = &dto.TOCEntryResponse{
+			Level:  entry.Level,
+			Text:   entry.Text,
+			Anchor: entry.Anchor,
+		}
+	}
+	return response
+}
+
+// 未設定の日時は空文字に変換
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
 func ToBlogsResponse(blogs []blog.Blog) []*dto.BlogCreatedResponse {
 	responses := make([]*dto.BlogCreatedResponse, len(blogs))
 
 	for i, b := range blogs {
 		responses[i] = &dto.BlogCreatedResponse{
-			ID:    b.ID,
-			Title: b.Title,
+			ID:        b.ID,
+			Title:     b.Title,
+			Reactions: b.Reactions,
+		}
+	}
+
+	return responses
+}
+
+func ToPageMeta(page *blog.Page, query blog.ListQuery) *dto.PageMeta {
+	return &dto.PageMeta{
+		Count:      len(page.Blogs),
+		Limit:      query.Limit,
+		Sort:       query.SortBy,
+		Order:      query.Order,
+		HasNext:    page.HasNext,
+		HasPrev:    page.HasPrev,
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}
+}
+
+func ToBlogSearchHitsResponse(hits []blog.SearchHit) []*dto.BlogSearchHitResponse {
+	responses := make([]*dto.BlogSearchHitResponse, len(hits))
+
+	for i, h := range hits {
+		responses[i] = &dto.BlogSearchHitResponse{
+			ID:             h.Blog.ID,
+			AuthorID:       h.Blog.AuthorID,
+			Title:          h.Blog.Title,
+			TitleHighlight: h.TitleHighlight,
+			Snippet:        h.Snippet,
+			Score:          h.Score,
+			Created:        h.Blog.CreatedAt.Format(time.RFC3339),
 		}
 	}
 
 	return responses
 }
+
+func ToTrashedBlogsResponse(trashed []blog.TrashedBlog) []*dto.TrashedBlogResponse {
+	responses := make([]*dto.TrashedBlogResponse, len(trashed))
+
+	for i, t := range trashed {
+		responses[i] = &dto.TrashedBlogResponse{
+			ID:        t.Blog.ID,
+			Title:     t.Blog.Title,
+			Status:    t.Blog.Status,
+			DeletedAt: t.Blog.DeletedAt.Format(time.RFC3339),
+			PurgeAt:   t.PurgeAt.Format(time.RFC3339),
+		}
+	}
+
+	return responses
+}
+
+func ToBulkReportResponse(report *blog.BulkReport) *dto.BulkReportResponse {
+	items := make([]*dto.BulkItemResponse, len(report.Items))
+	for i, item := range report.Items {
+		items[i] = &dto.BulkItemResponse{
+			BlogID: item.BlogID,
+			Result: item.Result,
+			Reason: item.Reason,
+		}
+	}
+
+	return &dto.BulkReportResponse{
+		Action:    report.Action,
+		DryRun:    report.DryRun,
+		Applied:   report.Applied,
+		Unchanged: report.Unchanged,
+		Failed:    report.Failed,
+		Items:     items,
+	}
+}
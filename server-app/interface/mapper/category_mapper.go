@@ -0,0 +1,29 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToCategoryResponse(c *category.Category) *dto.CategoryResponse {
+	response := &dto.CategoryResponse{
+		ID:          c.ID,
+		Name:        c.Name,
+		Description: c.Description,
+		ParentID:    c.ParentID,
+	}
+	for i := range c.Children {
+		response.Children = append(response.Children, ToCategoryResponse(&c.Children[i]))
+	}
+	return response
+}
+
+func ToCategoriesResponse(categories []category.Category) []*dto.CategoryResponse {
+	responses := make([]*dto.CategoryResponse, len(categories))
+
+	for i := range categories {
+		responses[i] = ToCategoryResponse(&categories[i])
+	}
+
+	return responses
+}
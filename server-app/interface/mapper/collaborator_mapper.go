@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToCollaboratorResponse(c *blog.Collaborator) *dto.CollaboratorResponse {
+	return &dto.CollaboratorResponse{
+		BlogID:      c.BlogID,
+		BlogTitle:   c.BlogTitle,
+		UserID:      c.UserID,
+		Username:    c.Username,
+		Role:        c.Role,
+		Status:      c.Status,
+		InvitedBy:   c.InvitedBy,
+		CreatedAt:   c.CreatedAt,
+		RespondedAt: c.RespondedAt,
+	}
+}
+
+func ToCollaboratorsResponse(collaborators []blog.Collaborator) []*dto.CollaboratorResponse {
+	responses := make([]*dto.CollaboratorResponse, len(collaborators))
+	for i := range collaborators {
+		responses[i] = ToCollaboratorResponse(&collaborators[i])
+	}
+	return responses
+}
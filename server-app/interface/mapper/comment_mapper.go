@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/comment"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToCommentResponse(c *comment.Comment) *dto.CommentResponse {
+	response := &dto.CommentResponse{
+		ID:         c.ID,
+		PostID:     c.PostID,
+		ParentID:   c.ParentID,
+		AuthorName: c.AuthorName,
+		Content:    c.Content,
+		Status:     c.Status,
+		CreatedAt:  c.CreatedAt,
+	}
+	for i := range c.Replies {
+		response.Replies = append(response.Replies, ToCommentResponse(&c.Replies[i]))
+	}
+	return response
+}
+
+func ToCommentsResponse(comments []comment.Comment) []*dto.CommentResponse {
+	responses := make([]*dto.CommentResponse, len(comments))
+
+	for i := range comments {
+		responses[i] = ToCommentResponse(&comments[i])
+	}
+
+	return responses
+}
@@ -0,0 +1,34 @@
+package mapper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kazukimurahashi12/webapp/domain/export"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToExportJobResponse(job *export.Job) *dto.ExportJobResponse {
+	response := &dto.ExportJobResponse{
+		ID:              job.ID,
+		Status:          job.Status,
+		BlogCount:       job.BlogCount,
+		AttachmentCount: job.AttachmentCount,
+		Size:            job.Size,
+		Error:           job.Error,
+		StatusURL:       fmt.Sprintf("/export/jobs/%d", job.ID),
+		CreatedAt:       job.CreatedAt,
+		StartedAt:       job.StartedAt,
+		CompletedAt:     job.CompletedAt,
+		ExpiresAt:       job.ExpiresAt,
+	}
+	if job.IsDownloadable(time.Now()) {
+		response.DownloadURL = ExportDownloadURL(job.DownloadToken)
+	}
+	return response
+}
+
+// ダウンロードURLのパス
+func ExportDownloadURL(token string) string {
+	return "/export/download/" + token
+}
@@ -0,0 +1,120 @@
+package mapper
+
+import (
+	"html"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+// フィードの要約に含める最大文字数
+const feedSummaryLength = 200
+
+func ToFeedItem(b *blog.Blog, rendered *blog.RenderedContent, id, link string) dto.FeedItem {
+	item := dto.FeedItem{
+		ID:          id,
+		Title:       b.Title,
+		Link:        link,
+		Author:      b.Author.Username,
+		UpdatedAt:   b.ModifiedAt(),
+		Summary:     summarizeHTML(rendered.HTML, feedSummaryLength),
+		ContentHTML: rendered.HTML,
+	}
+	if b.PublishedAt != nil {
+		item.PublishedAt = *b.PublishedAt
+	} else {
+		item.PublishedAt = b.CreatedAt
+	}
+	return item
+}
+
+func ToRSS(channel dto.FeedChannel, items []dto.FeedItem) *dto.RSS {
+	rss := &dto.RSS{
+		Version:   "2.0",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		Channel: dto.RSSChannel{
+			Title:       channel.Title,
+			Link:        channel.Link,
+			Description: channel.Description,
+			AtomLink:    dto.RSSAtomLink{Href: channel.SelfLink, Rel: "self", Type: "application/rss+xml"},
+			Items:       make([]dto.RSSItem, len(items)),
+		},
+	}
+	if !channel.UpdatedAt.IsZero() {
+		rss.Channel.LastBuildDate = channel.UpdatedAt.UTC().Format(time.RFC1123Z)
+	}
+
+	for i, item := range items {
+		rss.Channel.Items[i] = dto.RSSItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			GUID:        dto.RSSGUID{IsPermaLink: false, Value: item.ID},
+			PubDate:     item.PublishedAt.UTC().Format(time.RFC1123Z),
+			Creator:     item.Author,
+			Description: item.Summary,
+			Content:     item.ContentHTML,
+		}
+	}
+	return rss
+}
+
+func ToAtom(channel dto.FeedChannel, items []dto.FeedItem) *dto.AtomFeed {
+	updated := channel.UpdatedAt
+	if updated.IsZero() {
+		// 掲載するブログが無い場合も更新日時は必須
+		updated = time.Unix(0, 0)
+	}
+	feed := &dto.AtomFeed{
+		ID:      channel.SelfLink,
+		Title:   channel.Title,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Links: []dto.AtomLink{
+			{Href: channel.Link, Rel: "alternate"},
+			{Href: channel.SelfLink, Rel: "self", Type: "application/atom+xml"},
+		},
+		Entries: make([]dto.AtomEntry, len(items)),
+	}
+
+	for i, item := range items {
+		feed.Entries[i] = dto.AtomEntry{
+			ID:        item.ID,
+			Title:     item.Title,
+			Links:     []dto.AtomLink{{Href: item.Link, Rel: "alternate", Type: "text/html"}},
+			Published: item.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:   item.UpdatedAt.UTC().Format(time.RFC3339),
+			Author:    dto.AtomPerson{Name: item.Author},
+			Summary:   dto.AtomText{Type: "text", Body: item.Summary},
+			Content:   dto.AtomText{Type: "html", Body: item.ContentHTML},
+		}
+	}
+	return feed
+}
+
+// HTMLからタグを除き、空白を詰めたうえで先頭の指定文字数を返却
+func summarizeHTML(s string, limit int) string {
+	var b strings.Builder
+	inTag := false
+	for _, r := range s {
+		switch {
+		case r == '<':
+			inTag = true
+			b.WriteByte(' ')
+		case r == '>' && inTag:
+			inTag = false
+		case !inTag:
+			b.WriteRune(r)
+		}
+	}
+	text := strings.Join(strings.Fields(html.UnescapeString(b.String())), " ")
+
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	runes := []rune(text)
+	return strings.TrimSpace(string(runes[:limit])) + "…"
+}
@@ -0,0 +1,33 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/importer"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToImportReportResponse(report *importer.Report) *dto.ImportReportResponse {
+	response := &dto.ImportReportResponse{
+		DryRun:        report.DryRun,
+		Source:        report.Source,
+		Created:       report.Created,
+		Updated:       report.Updated,
+		Unchanged:     report.Unchanged,
+		Skipped:       report.Skipped,
+		NewCategories: make([]string, 0, len(report.NewCategories)),
+		Items:         make([]dto.ImportReportItemResponse, 0, len(report.Items)),
+	}
+	response.NewCategories = append(response.NewCategories, report.NewCategories...)
+	for _, item := range report.Items {
+		response.Items = append(response.Items, dto.ImportReportItemResponse{
+			SourceID: item.SourceID,
+			Path:     item.Path,
+			Title:    item.Title,
+			Action:   item.Action,
+			BlogID:   item.BlogID,
+			Changes:  item.Changes,
+			Reason:   item.Reason,
+			Warnings: item.Warnings,
+		})
+	}
+	return response
+}
@@ -0,0 +1,35 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/reaction"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToReactionSummaryResponse(summary *reaction.Summary) *dto.ReactionSummaryResponse {
+	response := &dto.ReactionSummaryResponse{
+		BlogID: summary.BlogID,
+		Total:  summary.Total,
+		Counts: make([]dto.ReactionCountResponse, len(summary.Counts)),
+	}
+	for i, c := range summary.Counts {
+		response.Counts[i] = dto.ReactionCountResponse{
+			Emoji:   c.Emoji,
+			Count:   c.Count,
+			Reacted: c.Reacted,
+		}
+	}
+	return response
+}
+
+func ToReactorsResponse(reactors []reaction.Reactor) []*dto.ReactorResponse {
+	responses := make([]*dto.ReactorResponse, len(reactors))
+	for i, r := range reactors {
+		responses[i] = &dto.ReactorResponse{
+			UserID:    r.UserID,
+			Username:  r.Username,
+			Emoji:     r.Emoji,
+			CreatedAt: r.CreatedAt,
+		}
+	}
+	return responses
+}
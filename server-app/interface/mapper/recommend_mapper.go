@@ -0,0 +1,23 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/recommend"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToRelatedBlogsResponse(related []recommend.Related) []*dto.RelatedBlogResponse {
+	responses := make([]*dto.RelatedBlogResponse, len(related))
+	for i, r := range related {
+		responses[i] = &dto.RelatedBlogResponse{
+			ID:               r.Blog.ID,
+			AuthorID:         r.Blog.AuthorID,
+			Title:            r.Blog.Title,
+			Slug:             r.Blog.Slug,
+			PublishedAt:      r.Blog.PublishedAt,
+			Score:            r.Score,
+			SharedCategories: r.SharedCategories,
+			SharedTags:       r.SharedTags,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,71 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToRevisionSummaryResponse(r *blog.Revision) *dto.RevisionSummaryResponse {
+	return &dto.RevisionSummaryResponse{
+		Version:   r.Version,
+		Title:     r.Title,
+		EditorID:  r.EditorID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func ToRevisionSummariesResponse(revisions []blog.Revision) []*dto.RevisionSummaryResponse {
+	responses := make([]*dto.RevisionSummaryResponse, len(revisions))
+
+	for i := range revisions {
+		responses[i] = ToRevisionSummaryResponse(&revisions[i])
+	}
+
+	return responses
+}
+
+func ToRevisionResponse(r *blog.Revision) *dto.RevisionResponse {
+	return &dto.RevisionResponse{
+		BlogID:    r.BlogID,
+		Version:   r.Version,
+		Title:     r.Title,
+		Content:   r.Content,
+		EditorID:  r.EditorID,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func ToRevisionDiffResponse(d *blog.RevisionDiff) *dto.RevisionDiffResponse {
+	return &dto.RevisionDiffResponse{
+		From:  ToRevisionSummaryResponse(d.From),
+		To:    ToRevisionSummaryResponse(d.To),
+		Title: toDiffSegmentsResponse(d.Title),
+		Lines: toDiffLinesResponse(d.Lines),
+	}
+}
+
+func toDiffLinesResponse(lines []blog.DiffLine) []*dto.DiffLineResponse {
+	responses := make([]*dto.DiffLineResponse, len(lines))
+	for i, line := range lines {
+		responses[i] = &dto.DiffLineResponse{
+			Op:       line.Op,
+			Text:     line.Text,
+			Segments: toDiffSegmentsResponse(line.Segments),
+		}
+	}
+	return responses
+}
+
+func toDiffSegmentsResponse(segments []blog.DiffSegment) []*dto.DiffSegmentResponse {
+	if len(segments) == 0 {
+		return nil
+	}
+	responses := make([]*dto.DiffSegmentResponse, len(segments))
+	for i, s := range segments {
+		responses[i] = &dto.DiffSegmentResponse{
+			Op:   s.Op,
+			Text: s.Text,
+		}
+	}
+	return responses
+}
@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/series"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToSeriesResponse(s *series.Series) *dto.SeriesResponse {
+	response := &dto.SeriesResponse{
+		ID:          s.ID,
+		OwnerID:     s.OwnerID,
+		Title:       s.Title,
+		Description: s.Description,
+		Entries:     make([]*dto.SeriesEntryResponse, len(s.Entries)),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+	for i := range s.Entries {
+		response.Entries[i] = toSeriesEntryResponse(&s.Entries[i])
+	}
+	return response
+}
+
+// シリーズに属さない場合はnilを返す
+func ToSeriesNavigationResponse(nav *series.Navigation) *dto.SeriesNavigationResponse {
+	if nav == nil {
+		return nil
+	}
+	return &dto.SeriesNavigationResponse{
+		SeriesID: nav.SeriesID,
+		Title:    nav.Title,
+		Position: nav.Position,
+		Total:    nav.Total,
+		Prev:     toSeriesEntryResponse(nav.Prev),
+		Next:     toSeriesEntryResponse(nav.Next),
+	}
+}
+
+func toSeriesEntryResponse(entry *series.Entry) *dto.SeriesEntryResponse {
+	if entry == nil {
+		return nil
+	}
+	return &dto.SeriesEntryResponse{
+		Position:    entry.Position,
+		BlogID:      entry.Blog.ID,
+		Title:       entry.Blog.Title,
+		Slug:        entry.Blog.Slug,
+		Status:      entry.Blog.Status,
+		PublishedAt: entry.Blog.PublishedAt,
+	}
+}
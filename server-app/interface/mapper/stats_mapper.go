@@ -0,0 +1,27 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/kazukimurahashi12/webapp/domain/stats"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToViewSeriesResponse(series *stats.Series) *dto.ViewSeriesResponse {
+	response := &dto.ViewSeriesResponse{
+		BlogID:         series.BlogID,
+		From:           series.From.Format(time.DateOnly),
+		To:             series.To.Format(time.DateOnly),
+		TotalViews:     series.TotalViews,
+		UniqueVisitors: series.UniqueVisitors,
+		Points:         make([]dto.ViewPointResponse, len(series.Points)),
+	}
+	for i, p := range series.Points {
+		response.Points[i] = dto.ViewPointResponse{
+			Day:            p.Day.Format(time.DateOnly),
+			Views:          p.Views,
+			UniqueVisitors: p.UniqueVisitors,
+		}
+	}
+	return response
+}
@@ -0,0 +1,33 @@
+package mapper
+
+import (
+	"github.com/kazukimurahashi12/webapp/domain/tag"
+	"github.com/kazukimurahashi12/webapp/interface/dto"
+)
+
+func ToTagsResponse(tags []tag.Tag) []*dto.TagResponse {
+	responses := make([]*dto.TagResponse, len(tags))
+
+	for i, t := range tags {
+		responses[i] = &dto.TagResponse{
+			ID:   t.ID,
+			Name: t.Name,
+		}
+	}
+
+	return responses
+}
+
+func ToTagCountsResponse(counts []tag.TagCount) []*dto.TagCountResponse {
+	responses := make([]*dto.TagCountResponse, len(counts))
+
+	for i, c := range counts {
+		responses[i] = &dto.TagCountResponse{
+			ID:    c.Tag.ID,
+			Name:  c.Tag.Name,
+			Count: c.Count,
+		}
+	}
+
+	return responses
+}
@@ -0,0 +1,17 @@
+package attachment
+
+import (
+	"io"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+)
+
+type UseCase interface {
+	Upload(upload domainAttachment.Upload) (*domainAttachment.Attachment, error)
+	ListAttachments(userID uint) ([]domainAttachment.Attachment, error)
+	ListBlogAttachments(userID, blogID uint) ([]domainAttachment.Attachment, error)
+	DeleteAttachment(userID, id uint) error
+	OpenFile(userID uint, key string) (*domainAttachment.Attachment, io.ReadCloser, bool, error)
+	ProcessImage(id uint) error
+	PendingImageIDs(limit int) ([]uint, error)
+}
@@ -0,0 +1,370 @@
+package attachment
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"path"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type attachmentUseCase struct {
+	attachmentRepo domainAttachment.AttachmentRepository
+	blogRepo       domainBlog.BlogRepository
+	storage        domainAttachment.BlobStorage
+	maxSize        int64
+	processor      domainAttachment.ImageProcessor
+	queue          domainAttachment.ProcessingQueue
+	variantSpecs   []domainAttachment.VariantSpec
+	policy         *usecaseBlog.Policy
+}
+
+func NewAttachmentUseCase(
+	attachmentRepo domainAttachment.AttachmentRepository,
+	blogRepo domainBlog.BlogRepository,
+	collabRepo domainBlog.CollaboratorRepository,
+	storage domainAttachment.BlobStorage,
+	maxSize int64,
+	processor domainAttachment.ImageProcessor,
+	queue domainAttachment.ProcessingQueue,
+	variantSpecs []domainAttachment.VariantSpec,
+) UseCase {
+	return &attachmentUseCase{
+		attachmentRepo: attachmentRepo,
+		blogRepo:       blogRepo,
+		storage:        storage,
+		maxSize:        maxSize,
+		processor:      processor,
+		queue:          queue,
+		variantSpecs:   variantSpecs,
+		policy:         usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// ファイルをアップロード
+// ファイル形式は内容から判定し、同一内容のファイルは保存済みの実体を共有する
+// 画像は位置情報等のメタデータを除去して保存し、派生画像の生成をキューに登録する
+func (u *attachmentUseCase) Upload(upload domainAttachment.Upload) (*domainAttachment.Attachment, error) {
+	size := int64(len(upload.Content))
+	if size == 0 {
+		return nil, domainAttachment.ErrAttachmentEmpty
+	}
+	if size > u.maxSize {
+		return nil, domainAttachment.ErrAttachmentTooLarge
+	}
+	contentType := domainAttachment.DetectContentType(upload.Content)
+	ext, ok := domainAttachment.ExtensionFor(contentType)
+	if !ok {
+		return nil, domainAttachment.ErrContentTypeNotAllowed
+	}
+
+	// ブログへ紐づける場合は自身が著者・編集者のブログのみ対象とする
+	if upload.BlogID != nil {
+		blog, err := u.blogRepo.FindBlogByID(*upload.BlogID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.policy.Authorize(upload.OwnerID, blog, usecaseBlog.ActionEdit); err != nil {
+			return nil, err
+		}
+	}
+
+	content, err := u.processor.Sanitize(upload.Content, contentType)
+	if err != nil {
+		return nil, err
+	}
+	processing := domainAttachment.ProcessingNone
+	if u.processor.CanDerive(contentType) && len(u.variantSpecs) > 0 {
+		processing = domainAttachment.ProcessingPending
+	}
+
+	key := domainAttachment.ContentKey(content, ext)
+	attachment := &domainAttachment.Attachment{
+		OwnerID:     upload.OwnerID,
+		BlogID:      upload.BlogID,
+		StorageKey:  key,
+		Filename:    domainAttachment.NormalizeFilename(upload.Filename),
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Processing:  processing,
+	}
+	// 実体より先に登録し、同じ内容の実体が並行して削除されないようにする
+	// 登録を待たされている間に削除された場合も、この後で実体を保存し直す
+	if err := u.attachmentRepo.Create(attachment); err != nil {
+		return nil, err
+	}
+	if err := u.putBlob(key, content); err != nil {
+		if derr := u.attachmentRepo.Delete(attachment.ID); derr == nil {
+			_ = u.deleteUnreferencedBlobs([]string{key})
+		}
+		return nil, err
+	}
+
+	// キューが満杯の場合は生成待ちのまま残り、定期的な再投入で処理される
+	if processing == domainAttachment.ProcessingPending {
+		u.queue.Enqueue(attachment.ID)
+	}
+	return attachment, nil
+}
+
+// ユーザーの添付ファイルを派生画像と共に取得
+func (u *attachmentUseCase) ListAttachments(userID uint) ([]domainAttachment.Attachment, error) {
+	attachments, err := u.attachmentRepo.FindAttachmentsByOwnerID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return u.withVariants(attachments)
+}
+
+// ブログに紐づく添付ファイルを派生画像と共に取得
+// 著者本人と共同編集者（閲覧者を含む）が参照できる
+func (u *attachmentUseCase) ListBlogAttachments(userID, blogID uint) ([]domainAttachment.Attachment, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionView); err != nil {
+		return nil, err
+	}
+	attachments, err := u.attachmentRepo.FindAttachmentsByBlogID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	return u.withVariants(attachments)
+}
+
+// 派生画像の生成が完了した添付ファイルに派生画像を設定
+func (u *attachmentUseCase) withVariants(attachments []domainAttachment.Attachment) ([]domainAttachment.Attachment, error) {
+	ids := make([]uint, 0, len(attachments))
+	for _, a := range attachments {
+		if a.Processing == domainAttachment.ProcessingReady {
+			ids = append(ids, a.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return attachments, nil
+	}
+	variants, err := u.attachmentRepo.FindVariantsByAttachmentIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byAttachment := map[uint][]domainAttachment.Variant{}
+	for _, v := range variants {
+		byAttachment[v.AttachmentID] = append(byAttachment[v.AttachmentID], v)
+	}
+	for i := range attachments {
+		attachments[i].Variants = byAttachment[attachments[i].ID]
+	}
+	return attachments, nil
+}
+
+// 添付ファイルを削除
+// 実体は他の添付ファイルから参照されていない場合のみ削除する
+func (u *attachmentUseCase) DeleteAttachment(userID, id uint) error {
+	attachment, err := u.attachmentRepo.FindAttachmentByID(id)
+	if err != nil {
+		return err
+	}
+	if attachment.OwnerID != userID {
+		return domainAttachment.ErrAttachmentUnauthorized
+	}
+	variants, err := u.attachmentRepo.FindVariantsByAttachmentIDs([]uint{id})
+	if err != nil {
+		return err
+	}
+	if err := u.attachmentRepo.Delete(id); err != nil {
+		return err
+	}
+
+	keys := []string{attachment.StorageKey}
+	for _, v := range variants {
+		keys = append(keys, v.StorageKey)
+	}
+	return u.deleteUnreferencedBlobs(keys)
+}
+
+// どの添付ファイル・派生画像からも参照されていない実体を削除
+func (u *attachmentUseCase) deleteUnreferencedBlobs(keys []string) error {
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, err := u.attachmentRepo.DeleteBlobIfUnreferenced(key, u.storage.Delete); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 実体をストレージに保存
+// 同一内容の実体が保存済みの場合は保存しない
+func (u *attachmentUseCase) putBlob(key string, content []byte) error {
+	exists, err := u.storage.Exists(key)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	return u.storage.Put(key, bytes.NewReader(content))
+}
+
+// 保存キーからファイルの実体を取得
+// 同一内容のファイルは複数のユーザーで共有されるため、ファイル名はアップロード時の名前ではなく保存キーから付ける
+// 実体を参照する添付ファイルのいずれかを閲覧できる場合のみ返し、閲覧できない場合は存在しない場合と区別しない
+// 未ログインの閲覧者も閲覧できるか（共有キャッシュに保存してよいか）を合わせて返す
+func (u *attachmentUseCase) OpenFile(userID uint, key string) (*domainAttachment.Attachment, io.ReadCloser, bool, error) {
+	if err := domainAttachment.ValidateKey(key); err != nil {
+		return nil, nil, false, err
+	}
+	attachments, err := u.attachmentRepo.FindAttachmentsByKey(key)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	viewable, public, err := u.canViewFile(userID, attachments)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !viewable {
+		return nil, nil, false, domainAttachment.ErrAttachmentNotFound
+	}
+
+	file := &domainAttachment.Attachment{StorageKey: key}
+	if original := findByStorageKey(attachments, key); original != nil {
+		file.Filename = path.Base(key)
+		file.ContentType = original.ContentType
+		file.Size = original.Size
+	} else {
+		// 派生画像の場合は派生画像の形式で返す
+		variant, err := u.attachmentRepo.FindVariantByKey(key)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		file.Filename = variant.Name + path.Ext(variant.StorageKey)
+		file.ContentType = variant.ContentType
+		file.Size = variant.Size
+	}
+	content, err := u.storage.Open(key)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return file, content, public, nil
+}
+
+// 添付ファイルのいずれかを閲覧できるかと、未ログインの閲覧者も閲覧できるかを判定
+// 所有者は常に閲覧でき、ブログに紐づく添付ファイルはブログを閲覧できるユーザーが閲覧できる
+// 共有URLの閲覧者は未ログインのため、共有中の限定公開のブログの添付ファイルは本文中のリンクを知る閲覧者に公開する
+// ブログに紐づかない添付ファイルは所有者のみ閲覧できる
+func (u *attachmentUseCase) canViewFile(userID uint, attachments []domainAttachment.Attachment) (bool, bool, error) {
+	viewable := false
+	for _, attachment := range attachments {
+		if userID != 0 && attachment.OwnerID == userID {
+			viewable = true
+		}
+		if attachment.BlogID == nil {
+			continue
+		}
+		blog, err := u.blogRepo.FindBlogByID(*attachment.BlogID)
+		if errors.Is(err, domainBlog.ErrBlogNotFound) {
+			// ゴミ箱のブログの添付ファイルは所有者のみ閲覧できる
+			continue
+		}
+		if err != nil {
+			return false, false, err
+		}
+		if blog.IsListed() {
+			return true, true, nil
+		}
+		if viewable || blog.IsSharedWith(blog.ShareToken) {
+			viewable = true
+			continue
+		}
+		if viewable, err = u.policy.CanView(userID, blog); err != nil {
+			return false, false, err
+		}
+	}
+	return viewable, false, nil
+}
+
+func findByStorageKey(attachments []domainAttachment.Attachment, key string) *domainAttachment.Attachment {
+	for i := range attachments {
+		if attachments[i].StorageKey == key {
+			return &attachments[i]
+		}
+	}
+	return nil
+}
+
+// 派生画像を生成
+// 生成できない画像は生成失敗として記録し、元のファイルのみ利用可能とする
+func (u *attachmentUseCase) ProcessImage(id uint) error {
+	attachment, err := u.attachmentRepo.FindAttachmentByID(id)
+	if err != nil {
+		return err
+	}
+	if attachment.Processing != domainAttachment.ProcessingPending {
+		return nil
+	}
+
+	derived, err := u.deriveImages(attachment)
+	if err != nil {
+		if errors.Is(err, domainAttachment.ErrImageUnsupported) || errors.Is(err, domainAttachment.ErrImageTooLarge) {
+			if serr := u.attachmentRepo.SaveProcessingResult(id, domainAttachment.ProcessingFailed, 0, 0, nil); serr != nil {
+				return serr
+			}
+		}
+		return err
+	}
+
+	variants := make([]domainAttachment.Variant, 0, len(derived.Images))
+	keys := make([]string, 0, len(derived.Images))
+	for _, image := range derived.Images {
+		key := domainAttachment.ContentKey(image.Content, image.Ext)
+		if err := u.putBlob(key, image.Content); err != nil {
+			return err
+		}
+		variant := image.Variant
+		variant.StorageKey = key
+		variants = append(variants, variant)
+		keys = append(keys, key)
+	}
+
+	if err := u.attachmentRepo.SaveProcessingResult(id, domainAttachment.ProcessingReady, derived.Width, derived.Height, variants); err != nil {
+		// 生成中に添付ファイルが削除された場合は保存した実体を片付ける
+		if errors.Is(err, domainAttachment.ErrAttachmentNotFound) {
+			_ = u.deleteUnreferencedBlobs(keys)
+		}
+		return err
+	}
+	// 登録までの間に同じ内容の実体が他の添付ファイルの削除で消えた場合は保存し直す
+	for i, image := range derived.Images {
+		if err := u.putBlob(keys[i], image.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *attachmentUseCase) deriveImages(attachment *domainAttachment.Attachment) (*domainAttachment.DerivedImages, error) {
+	file, err := u.storage.Open(attachment.StorageKey)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	return u.processor.Derive(content, u.variantSpecs)
+}
+
+// 派生画像の生成待ちの添付ファイルのIDを取得
+func (u *attachmentUseCase) PendingImageIDs(limit int) ([]uint, error) {
+	return u.attachmentRepo.FindAttachmentIDsByProcessing(domainAttachment.ProcessingPending, limit)
+}
@@ -0,0 +1,167 @@
+package attachment
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+// 保存キーごとの添付ファイルをメモリ上で保持するリポジトリ
+// ファイルの配信に使うメソッド以外は呼び出されない想定
+type stubAttachmentRepository struct {
+	domainAttachment.AttachmentRepository
+	attachments []domainAttachment.Attachment
+	variants    []domainAttachment.Variant
+}
+
+func (r *stubAttachmentRepository) FindAttachmentsByKey(key string) ([]domainAttachment.Attachment, error) {
+	var found []domainAttachment.Attachment
+	for _, attachment := range r.attachments {
+		if attachment.StorageKey == key {
+			found = append(found, attachment)
+			continue
+		}
+		for _, variant := range r.variants {
+			if variant.AttachmentID == attachment.ID && variant.StorageKey == key {
+				found = append(found, attachment)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+func (r *stubAttachmentRepository) FindVariantByKey(key string) (*domainAttachment.Variant, error) {
+	for _, variant := range r.variants {
+		if variant.StorageKey == key {
+			return &variant, nil
+		}
+	}
+	return nil, domainAttachment.ErrAttachmentNotFound
+}
+
+type stubBlogRepository struct {
+	domainBlog.BlogRepository
+	blogs map[uint]*domainBlog.Blog
+}
+
+func (r *stubBlogRepository) FindBlogByID(id uint) (*domainBlog.Blog, error) {
+	blog, ok := r.blogs[id]
+	if !ok {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+	return blog, nil
+}
+
+type stubCollaboratorRepository struct {
+	domainBlog.CollaboratorRepository
+}
+
+func (r *stubCollaboratorRepository) FindCollaborator(blogID, userID uint) (*domainBlog.Collaborator, error) {
+	if blogID == privateBlogID && userID == viewerID {
+		return &domainBlog.Collaborator{BlogID: blogID, UserID: userID, Role: domainBlog.RoleViewer, Status: domainBlog.InvitationAccepted}, nil
+	}
+	return nil, domainBlog.ErrCollaboratorNotFound
+}
+
+type stubStorage struct {
+	domainAttachment.BlobStorage
+}
+
+func (s *stubStorage) Open(key string) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(key)), nil
+}
+
+const (
+	ownerID    uint = 1
+	viewerID   uint = 2
+	strangerID uint = 3
+
+	publicBlogID   uint = 10
+	unlistedBlogID uint = 11
+	privateBlogID  uint = 12
+)
+
+func testKey(c string) string {
+	return strings.Repeat(c, 2) + "/" + strings.Repeat(c, 64) + ".png"
+}
+
+func newTestUseCase() UseCase {
+	blogID := func(id uint) *uint { return &id }
+	attachmentRepo := &stubAttachmentRepository{
+		attachments: []domainAttachment.Attachment{
+			{ID: 1, OwnerID: ownerID, BlogID: blogID(publicBlogID), StorageKey: testKey("a"), ContentType: "image/png"},
+			{ID: 2, OwnerID: ownerID, BlogID: blogID(unlistedBlogID), StorageKey: testKey("b"), ContentType: "image/png"},
+			{ID: 3, OwnerID: ownerID, BlogID: blogID(privateBlogID), StorageKey: testKey("c"), ContentType: "image/png"},
+			{ID: 4, OwnerID: ownerID, StorageKey: testKey("d"), ContentType: "image/png"},
+			// 同一内容のファイルを別のユーザーが公開中のブログに添付
+			{ID: 5, OwnerID: strangerID, BlogID: blogID(privateBlogID), StorageKey: testKey("e"), ContentType: "image/png"},
+			{ID: 6, OwnerID: ownerID, BlogID: blogID(publicBlogID), StorageKey: testKey("e"), ContentType: "image/png"},
+		},
+		variants: []domainAttachment.Variant{
+			{ID: 1, AttachmentID: 3, Name: "thumb", ContentType: "image/webp", StorageKey: testKey("f")},
+		},
+	}
+	blogRepo := &stubBlogRepository{
+		blogs: map[uint]*domainBlog.Blog{
+			publicBlogID:   {ID: publicBlogID, AuthorID: ownerID, Status: domainBlog.StatusPublished, Visibility: domainBlog.VisibilityPublic},
+			unlistedBlogID: {ID: unlistedBlogID, AuthorID: ownerID, Status: domainBlog.StatusPublished, Visibility: domainBlog.VisibilityUnlisted, ShareToken: "TOKEN"},
+			privateBlogID:  {ID: privateBlogID, AuthorID: ownerID, Status: domainBlog.StatusPublished, Visibility: domainBlog.VisibilityPrivate},
+		},
+	}
+	return NewAttachmentUseCase(attachmentRepo, blogRepo, &stubCollaboratorRepository{}, &stubStorage{}, domainAttachment.DefaultMaxSize, nil, nil, nil)
+}
+
+func TestAttachmentUseCase_OpenFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   uint
+		key      string
+		viewable bool
+		public   bool
+	}{
+		{"public blog is public", 0, testKey("a"), true, true},
+		{"shared blog is viewable without login", 0, testKey("b"), true, false},
+		{"private blog is hidden from guests", 0, testKey("c"), false, false},
+		{"private blog is hidden from other users", strangerID, testKey("c"), false, false},
+		{"private blog is viewable by owner", ownerID, testKey("c"), true, false},
+		{"private blog is viewable by collaborator", viewerID, testKey("c"), true, false},
+		{"variant follows its attachment", 0, testKey("f"), false, false},
+		{"variant is viewable by collaborator", viewerID, testKey("f"), true, false},
+		{"unattached file is hidden from guests", 0, testKey("d"), false, false},
+		{"unattached file is viewable by owner", ownerID, testKey("d"), true, false},
+		{"shared content is public if any blog is public", 0, testKey("e"), true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, content, public, err := newTestUseCase().OpenFile(tt.userID, tt.key)
+			if !tt.viewable {
+				assert.ErrorIs(t, err, domainAttachment.ErrAttachmentNotFound)
+				return
+			}
+			if assert.NoError(t, err) {
+				defer content.Close()
+				assert.Equal(t, tt.key, file.StorageKey)
+				assert.Equal(t, tt.public, public)
+			}
+		})
+	}
+
+	t.Run("variant is served in its own format", func(t *testing.T) {
+		file, content, _, err := newTestUseCase().OpenFile(ownerID, testKey("f"))
+		if assert.NoError(t, err) {
+			defer content.Close()
+			assert.Equal(t, "image/webp", file.ContentType)
+			assert.Equal(t, "thumb.png", file.Filename)
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, _, _, err := newTestUseCase().OpenFile(ownerID, testKey("0"))
+		assert.ErrorIs(t, err, domainAttachment.ErrAttachmentNotFound)
+	})
+}
@@ -1,14 +1,42 @@
 package blog
 
 import (
+	"time"
+
 	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
 )
 
 type UseCase interface {
 	NewCreateBlog(blog *domainBlog.Blog) (*domainBlog.Blog, error)
 	FindBlogsByAuthorID(authorID uint) ([]domainBlog.Blog, error)
+	ListBlogs(query domainBlog.ListQuery) (*domainBlog.Page, error)
+	SearchBlogs(query domainBlog.SearchQuery) (*domainBlog.SearchResult, error)
+	GetFeed(query domainBlog.FeedQuery) (*domainBlog.Feed, error)
 	FindBlogByID(id uint) (*domainBlog.Blog, error)
+	FindBlogForUser(userID, id uint) (*domainBlog.Blog, string, error)
 	FindBlogByAuthorID(authorID uint) (*domainBlog.Blog, error)
-	DeleteBlog(id uint) error
-	UpdateBlog(blog *domainBlog.Blog) (*domainBlog.Blog, error)
+	FindBlogBySlug(viewerID uint, username, slug string) (*domainBlog.Blog, bool, error)
+	FindPublicBlog(id uint) (*domainBlog.Blog, error)
+	FindSharedBlog(token string) (*domainBlog.Blog, error)
+	RegenerateShareToken(userID, id uint) (*domainBlog.Blog, error)
+	RenderBlogContent(blog *domainBlog.Blog) (*domainBlog.RenderedContent, error)
+	DeleteBlog(userID, id uint) error
+	ListTrash(userID uint) ([]domainBlog.TrashedBlog, error)
+	RestoreBlog(userID, id uint) (*domainBlog.Blog, error)
+	PurgeBlog(userID, id uint) error
+	PurgeExpiredBlogs(now time.Time) ([]uint, error)
+	UpdateBlog(userID uint, blog *domainBlog.Blog) (*domainBlog.Blog, error)
+	PublishBlog(userID, id uint) (*domainBlog.Blog, error)
+	UnpublishBlog(userID, id uint) (*domainBlog.Blog, error)
+	ScheduleBlog(userID, id uint, publishAt time.Time) (*domainBlog.Blog, error)
+	ArchiveBlog(userID, id uint) (*domainBlog.Blog, error)
+	PublishDueBlogs(now time.Time) ([]domainBlog.Blog, error)
+	ListRevisions(userID, blogID uint) ([]domainBlog.Revision, error)
+	GetRevision(userID, blogID, version uint) (*domainBlog.Revision, error)
+	DiffRevisions(userID, blogID, fromVersion, toVersion uint) (*domainBlog.RevisionDiff, error)
+	RestoreRevision(userID, blogID, version uint) (*domainBlog.Blog, error)
+	BulkOperate(userID uint, op *domainBlog.BulkOperation) (*domainBlog.BulkReport, error)
+	SaveAutosave(autosave *domainBlog.Autosave) (*domainBlog.Autosave, error)
+	GetAutosave(userID, blogID uint) (*domainBlog.AutosaveComparison, error)
+	ClearAutosave(userID, blogID uint) error
 }
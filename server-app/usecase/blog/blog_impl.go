@@ -1,24 +1,96 @@
 package blog
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
 )
 
 type blogUseCase struct {
-	blogRepo domainBlog.BlogRepository
+	blogRepo     domainBlog.BlogRepository
+	categoryRepo domainCategory.CategoryRepository
+	searchIndex  domainBlog.SearchIndex
+	termIndex    domainRecommend.TermIndex
+	revisionRepo domainBlog.RevisionRepository
+	retention    domainBlog.RevisionRetention
+	trashPeriod  time.Duration
+	renderer     domainBlog.ContentRenderer
+	renderCache  domainBlog.RenderCache
+	policy       *Policy
+	autosaves    domainBlog.AutosaveStore
+	autosaveTTL  time.Duration
 }
 
-func NewBlogUseCase(blogRepo domainBlog.BlogRepository) UseCase {
+func NewBlogUseCase(
+	blogRepo domainBlog.BlogRepository,
+	categoryRepo domainCategory.CategoryRepository,
+	searchIndex domainBlog.SearchIndex,
+	termIndex domainRecommend.TermIndex,
+	revisionRepo domainBlog.RevisionRepository,
+	retention domainBlog.RevisionRetention,
+	trashPeriod time.Duration,
+	renderer domainBlog.ContentRenderer,
+	renderCache domainBlog.RenderCache,
+	collabRepo domainBlog.CollaboratorRepository,
+	autosaves domainBlog.AutosaveStore,
+	autosaveTTL time.Duration,
+) UseCase {
 	return &blogUseCase{
-		blogRepo: blogRepo,
+		blogRepo:     blogRepo,
+		categoryRepo: categoryRepo,
+		searchIndex:  searchIndex,
+		termIndex:    termIndex,
+		revisionRepo: revisionRepo,
+		retention:    retention,
+		trashPeriod:  trashPeriod,
+		renderer:     renderer,
+		renderCache:  renderCache,
+		policy:       NewPolicy(collabRepo),
+		autosaves:    autosaves,
+		autosaveTTL:  autosaveTTL,
 	}
 }
 
 func (b *blogUseCase) NewCreateBlog(blog *domainBlog.Blog) (*domainBlog.Blog, error) {
+	// 未指定の場合は下書きとして作成
+	switch blog.Status {
+	case "":
+		blog.Status = domainBlog.StatusDraft
+	case domainBlog.StatusPublished:
+		if blog.PublishedAt == nil {
+			now := time.Now()
+			blog.PublishedAt = &now
+		}
+	}
+
+	// 未指定の場合は全体公開として作成
+	if blog.Visibility == "" {
+		blog.Visibility = domainBlog.VisibilityPublic
+	}
+	if !domainBlog.IsVisibility(blog.Visibility) {
+		return nil, domainBlog.ErrVisibilityInvalid
+	}
+	blog.ShareToken = domainBlog.NewShareToken()
+
+	if err := b.assignSlug(blog, nil); err != nil {
+		return nil, err
+	}
+
 	err := b.blogRepo.Create(blog)
 	if err != nil {
 		return nil, err
 	}
+	// 検索インデックス・関連記事の索引語へ登録
+	if err := b.searchIndex.Index(blog); err != nil {
+		return nil, err
+	}
+	if err := b.termIndex.Index(blog); err != nil {
+		return nil, err
+	}
 	return blog, nil
 }
 
@@ -26,6 +98,67 @@ func (b *blogUseCase) FindBlogsByAuthorID(authorID uint) ([]domainBlog.Blog, err
 	return b.blogRepo.FindBlogsByAuthorID(authorID)
 }
 
+// 取得条件に従いブログ一覧をページングして取得
+// カテゴリ指定時はその子孫カテゴリに属するブログも含める
+func (b *blogUseCase) ListBlogs(query domainBlog.ListQuery) (*domainBlog.Page, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+
+	if query.CategoryID != 0 {
+		category, err := b.categoryRepo.FindCategoryByID(query.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		categories, err := b.categoryRepo.FindCategoriesByUserID(category.UserID)
+		if err != nil {
+			return nil, err
+		}
+		query.CategoryIDs = domainCategory.DescendantIDs(categories, query.CategoryID)
+	}
+
+	return b.blogRepo.FindBlogPage(query)
+}
+
+// フィードに掲載する公開済みのブログを取得
+// カテゴリ指定時はその子孫カテゴリに属するブログも含める
+func (b *blogUseCase) GetFeed(query domainBlog.FeedQuery) (*domainBlog.Feed, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+
+	feed := &domainBlog.Feed{Title: query.AuthorName}
+	if query.CategoryID != 0 {
+		category, err := b.categoryRepo.FindCategoryByID(query.CategoryID)
+		if err != nil {
+			return nil, err
+		}
+		feed.Title = category.Name
+
+		categories, err := b.categoryRepo.FindCategoriesByUserID(category.UserID)
+		if err != nil {
+			return nil, err
+		}
+		query.CategoryIDs = domainCategory.DescendantIDs(categories, query.CategoryID)
+	}
+
+	blogs, err := b.blogRepo.FindFeedBlogs(query)
+	if err != nil {
+		return nil, err
+	}
+	feed.Blogs = blogs
+	return feed, nil
+}
+
+// キーワードに一致するブログを関連度順に検索
+func (b *blogUseCase) SearchBlogs(query domainBlog.SearchQuery) (*domainBlog.SearchResult, error) {
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return b.searchIndex.Search(query)
+}
+
 func (b *blogUseCase) FindBlogByID(id uint) (*domainBlog.Blog, error) {
 	return b.blogRepo.FindBlogByID(id)
 }
@@ -34,16 +167,586 @@ func (b *blogUseCase) FindBlogByAuthorID(authorID uint) (*domainBlog.Blog, error
 	return b.blogRepo.FindBlogByAuthorID(authorID)
 }
 
-func (b *blogUseCase) DeleteBlog(id uint) error {
-	return b.blogRepo.Delete(id)
+// ブログをゴミ箱へ移動
+// 保持期間内であればRestoreBlogで元に戻せる
+func (b *blogUseCase) DeleteBlog(userID, id uint) error {
+	if _, _, err := b.authorize(userID, id, ActionDelete); err != nil {
+		return err
+	}
+	if err := b.blogRepo.Delete(id); err != nil {
+		return err
+	}
+	// 検索インデックス・関連記事の索引語から削除
+	if err := b.searchIndex.Remove(id); err != nil {
+		return err
+	}
+	return b.termIndex.Remove(id)
+}
+
+// 本文をHTMLと目次に変換
+// 同じバージョン・変換方式の変換結果がキャッシュにある場合は再変換しない
+func (b *blogUseCase) RenderBlogContent(blog *domainBlog.Blog) (*domainBlog.RenderedContent, error) {
+	name := b.renderer.Name()
+	rendered, err := b.renderCache.Find(blog.ID, blog.Version, name)
+	if err == nil {
+		return rendered, nil
+	}
+	if !errors.Is(err, domainBlog.ErrRenderCacheMiss) {
+		return nil, err
+	}
+
+	rendered, err = b.renderer.Render(blog.Content)
+	if err != nil {
+		return nil, err
+	}
+	// 保存に失敗しても変換結果は返せるため、次回表示時の保存に委ねる
+	_ = b.renderCache.Save(blog.ID, blog.Version, name, rendered)
+	return rendered, nil
+}
+
+// ゴミ箱内の自身のブログを削除日時の新しい順に取得
+func (b *blogUseCase) ListTrash(userID uint) ([]domainBlog.TrashedBlog, error) {
+	blogs, err := b.blogRepo.FindTrashedBlogsByAuthorID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	trashed := make([]domainBlog.TrashedBlog, len(blogs))
+	for i := range blogs {
+		trashed[i] = domainBlog.TrashedBlog{
+			Blog:    blogs[i],
+			PurgeAt: blogs[i].PurgeAt(b.trashPeriod),
+		}
+	}
+	return trashed, nil
+}
+
+// ゴミ箱内の自身のブログを元に戻す
+func (b *blogUseCase) RestoreBlog(userID, id uint) (*domainBlog.Blog, error) {
+	if _, err := b.authorizeTrashed(userID, id, ActionDelete); err != nil {
+		return nil, err
+	}
+	if err := b.blogRepo.Restore(id); err != nil {
+		return nil, err
+	}
+
+	blog, err := b.blogRepo.FindBlogByID(id)
+	if err != nil {
+		return nil, err
+	}
+	// 検索インデックス・関連記事の索引語へ再登録
+	if err := b.searchIndex.Index(blog); err != nil {
+		return nil, err
+	}
+	if err := b.termIndex.Index(blog); err != nil {
+		return nil, err
+	}
+	return blog, nil
+}
+
+// ゴミ箱内の自身のブログを完全に削除
+func (b *blogUseCase) PurgeBlog(userID, id uint) error {
+	if _, err := b.authorizeTrashed(userID, id, ActionDelete); err != nil {
+		return err
+	}
+	return b.blogRepo.Purge(id)
+}
+
+// 保持期間を過ぎたゴミ箱内のブログを完全に削除し、削除したブログのIDを返す
+// スケジューラから定期的に呼び出される
+func (b *blogUseCase) PurgeExpiredBlogs(now time.Time) ([]uint, error) {
+	ids, err := b.blogRepo.FindTrashedBlogIDsBefore(now.Add(-b.trashPeriod), purgeBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	purged := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if err := b.blogRepo.Purge(id); err != nil {
+			// 他のサーバーや利用者が先に削除・復元した場合は対象外
+			if errors.Is(err, domainBlog.ErrBlogNotFound) {
+				continue
+			}
+			return purged, err
+		}
+		purged = append(purged, id)
+	}
+	return purged, nil
+}
+
+// 操作を認可してゴミ箱内のブログを取得
+func (b *blogUseCase) authorizeTrashed(userID, id uint, action Action) (*domainBlog.Blog, error) {
+	blog, err := b.blogRepo.FindTrashedBlogByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.policy.Authorize(userID, blog, action); err != nil {
+		return nil, err
+	}
+	return blog, nil
+}
+
+// ブログを更新
+// 更新するユーザーはuserIDで指定し、著者本人と共同編集者（編集者）のみ更新できる
+// 更新履歴の編集者もuserIDとし、blog.AuthorIDは参照しない
+// 更新対象のバージョンが古い場合はサーバー上の最新のブログを含む競合エラーを返す
+func (b *blogUseCase) UpdateBlog(userID uint, blog *domainBlog.Blog) (*domainBlog.Blog, error) {
+	current, role, err := b.authorize(userID, blog.ID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	// 未指定の場合は現在の公開範囲を維持する
+	if blog.Visibility == "" {
+		blog.Visibility = current.Visibility
+	}
+	if !domainBlog.IsVisibility(blog.Visibility) {
+		return nil, domainBlog.ErrVisibilityInvalid
+	}
+	// 公開範囲の変更には公開操作の権限が必要
+	if blog.Visibility != current.Visibility && !Allows(role, ActionPublish) {
+		return nil, domainBlog.ErrBlogUnauthorized
+	}
+	if err := b.assignSlug(blog, current); err != nil {
+		return nil, err
+	}
+
+	if err := b.blogRepo.Update(blog, userID); err != nil {
+		if errors.Is(err, domainBlog.ErrBlogVersionConflict) {
+			return nil, b.versionConflict(blog)
+		}
+		return nil, err
+	}
+
+	// 保持期間を過ぎた更新履歴を削除
+	// 削除に失敗しても更新自体は確定しているため、次回更新時の削除に委ねる
+	_ = b.revisionRepo.Prune(blog.ID, b.retention, time.Now())
+
+	updated, err := b.blogRepo.FindBlogByID(blog.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 検索インデックス・関連記事の索引語を更新
+	if err := b.searchIndex.Index(updated); err != nil {
+		return nil, err
+	}
+	if err := b.termIndex.Index(updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
 }
 
-func (b *blogUseCase) UpdateBlog(blog *domainBlog.Blog) (*domainBlog.Blog, error) {
+// スラッグを決定
+// 指定されたスラッグは形式と重複を検証し、未指定の場合はタイトルから生成して重複時は連番を付与する
+// 更新でタイトルが変わらない場合は現在のスラッグを維持する
+func (b *blogUseCase) assignSlug(blog, current *domainBlog.Blog) error {
+	// スラッグは著者ごとに一意のため、共同編集者による更新でも著者本人のブログと重複を確認する
+	ownerID := blog.AuthorID
+	if current != nil {
+		ownerID = current.AuthorID
+	}
+	if blog.Slug != "" {
+		if err := domainBlog.ValidateSlug(blog.Slug); err != nil {
+			return err
+		}
+		if current != nil && blog.Slug == current.Slug {
+			return nil
+		}
+		available, err := b.blogRepo.IsSlugAvailable(ownerID, blog.Slug, blog.ID)
+		if err != nil {
+			return err
+		}
+		if !available {
+			return domainBlog.ErrSlugAlreadyExists
+		}
+		return nil
+	}
+
+	if current != nil && blog.Title == current.Title {
+		blog.Slug = current.Slug
+		return nil
+	}
+	base := domainBlog.GenerateSlug(blog.Title)
+	for n := 1; n <= domainBlog.MaxSlugSuffix; n++ {
+		candidate := domainBlog.SlugCandidate(base, n)
+		if current != nil && candidate == current.Slug {
+			blog.Slug = candidate
+			return nil
+		}
+		available, err := b.blogRepo.IsSlugAvailable(ownerID, candidate, blog.ID)
+		if err != nil {
+			return err
+		}
+		if available {
+			blog.Slug = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no available slug for %q", domainBlog.ErrSlugAlreadyExists, base)
+}
 
-	updateErr := b.blogRepo.Update(blog)
-	if updateErr != nil {
-		return nil, updateErr
+// 著者名とスラッグからブログを取得
+// 変更前のスラッグの場合は現在のブログと、新しいスラッグへの転送が必要なことを返す
+func (b *blogUseCase) FindBlogBySlug(viewerID uint, username, slug string) (*domainBlog.Blog, bool, error) {
+	moved := false
+	blog, err := b.blogRepo.FindBlogBySlug(username, slug)
+	if errors.Is(err, domainBlog.ErrBlogNotFound) {
+		blog, err = b.blogRepo.FindBlogBySlugHistory(username, slug)
+		moved = true
+	}
+	if err != nil {
+		return nil, false, err
 	}
+	// 閲覧できないブログは存在自体を明かさない
+	visible, err := b.policy.CanView(viewerID, blog)
+	if err != nil {
+		return nil, false, err
+	}
+	if !visible {
+		return nil, false, domainBlog.ErrBlogNotFound
+	}
+	return blog, moved, nil
+}
 
+// 未ログインの閲覧者向けにIDからブログを取得
+// 全体公開の公開済みブログ以外は存在自体を明かさない
+func (b *blogUseCase) FindPublicBlog(id uint) (*domainBlog.Blog, error) {
+	blog, err := b.blogRepo.FindBlogByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !blog.IsVisibleTo(0) {
+		return nil, domainBlog.ErrBlogNotFound
+	}
 	return blog, nil
 }
+
+// 共有URLのトークンからブログを取得
+// 公開済みかつ非公開でないブログのみ対象とする
+func (b *blogUseCase) FindSharedBlog(token string) (*domainBlog.Blog, error) {
+	blog, err := b.blogRepo.FindBlogByShareToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if !blog.IsSharedWith(token) {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+	return blog, nil
+}
+
+// 共有URLのトークンを再発行
+// 以前の共有URLでは閲覧できなくなる
+func (b *blogUseCase) RegenerateShareToken(userID, id uint) (*domainBlog.Blog, error) {
+	blog, _, err := b.authorize(userID, id, ActionPublish)
+	if err != nil {
+		return nil, err
+	}
+	token := domainBlog.NewShareToken()
+	if err := b.blogRepo.UpdateShareToken(id, token); err != nil {
+		return nil, err
+	}
+	blog.ShareToken = token
+	return blog, nil
+}
+
+// 競合発生時点の最新のブログを取得し競合エラーを生成
+func (b *blogUseCase) versionConflict(rejected *domainBlog.Blog) error {
+	current, err := b.blogRepo.FindBlogByID(rejected.ID)
+	if err != nil {
+		return err
+	}
+	return &domainBlog.VersionConflictError{
+		Current:  current,
+		Rejected: rejected,
+	}
+}
+
+// 予約投稿の公開処理1回あたりの最大件数
+const publishBatchSize = 100
+
+// ゴミ箱の完全削除処理1回あたりの最大件数
+const purgeBatchSize = 100
+
+// ブログを即時公開
+func (b *blogUseCase) PublishBlog(userID, id uint) (*domainBlog.Blog, error) {
+	if _, _, err := b.authorize(userID, id, ActionPublish); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return b.changeStatus(id, domainBlog.StatusChange{
+		Status:      domainBlog.StatusPublished,
+		PublishedAt: &now,
+	})
+}
+
+// ブログを非公開の下書きに戻す
+// 予約投稿の場合は予約を取り消す
+func (b *blogUseCase) UnpublishBlog(userID, id uint) (*domainBlog.Blog, error) {
+	if _, _, err := b.authorize(userID, id, ActionPublish); err != nil {
+		return nil, err
+	}
+
+	return b.changeStatus(id, domainBlog.StatusChange{
+		Status: domainBlog.StatusDraft,
+	})
+}
+
+// ブログの公開を予約
+// 予約済みの場合は公開日時を変更する
+func (b *blogUseCase) ScheduleBlog(userID, id uint, publishAt time.Time) (*domainBlog.Blog, error) {
+	if !publishAt.After(time.Now()) {
+		return nil, fmt.Errorf("%w: publish time must be in the future", domainBlog.ErrBlogInvalidData)
+	}
+	if _, _, err := b.authorize(userID, id, ActionPublish); err != nil {
+		return nil, err
+	}
+
+	return b.changeStatus(id, domainBlog.StatusChange{
+		Status:    domainBlog.StatusScheduled,
+		PublishAt: &publishAt,
+	})
+}
+
+// 公開済みのブログをアーカイブ
+// 公開日時は保持する
+func (b *blogUseCase) ArchiveBlog(userID, id uint) (*domainBlog.Blog, error) {
+	blog, _, err := b.authorize(userID, id, ActionPublish)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.changeStatus(id, domainBlog.StatusChange{
+		Status:      domainBlog.StatusArchived,
+		PublishedAt: blog.PublishedAt,
+	})
+}
+
+// 公開日時を過ぎた予約投稿を公開
+// スケジューラから定期的に呼び出される
+func (b *blogUseCase) PublishDueBlogs(now time.Time) ([]domainBlog.Blog, error) {
+	return b.blogRepo.PublishDueBlogs(now, publishBatchSize)
+}
+
+// 権限を確認してブログを取得
+// 著者本人・共同編集者のいずれでもない場合はErrBlogUnauthorizedを返す
+func (b *blogUseCase) FindBlogForUser(userID, id uint) (*domainBlog.Blog, string, error) {
+	return b.authorize(userID, id, ActionView)
+}
+
+// 操作を認可してブログと操作するユーザーの権限を取得
+func (b *blogUseCase) authorize(userID, id uint, action Action) (*domainBlog.Blog, string, error) {
+	blog, err := b.blogRepo.FindBlogByID(id)
+	if err != nil {
+		return nil, "", err
+	}
+	role, err := b.policy.Authorize(userID, blog, action)
+	if err != nil {
+		return nil, "", err
+	}
+	return blog, role, nil
+}
+
+// 遷移可能なステータスからのみ公開ステータスを変更し、変更後のブログを取得
+func (b *blogUseCase) changeStatus(id uint, change domainBlog.StatusChange) (*domainBlog.Blog, error) {
+	if err := b.blogRepo.ChangeStatus(id, domainBlog.TransitionSources(change.Status), change); err != nil {
+		return nil, err
+	}
+	return b.blogRepo.FindBlogByID(id)
+}
+
+// ブログの更新履歴を新しい順に取得
+// 先頭には現在の内容を最新のバージョンとして含める
+func (b *blogUseCase) ListRevisions(userID, blogID uint) ([]domainBlog.Revision, error) {
+	blog, _, err := b.FindBlogForUser(userID, blogID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := b.revisionRepo.FindRevisionsByBlogID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]domainBlog.Revision{*currentRevision(blog)}, revisions...), nil
+}
+
+// 指定バージョンの内容を取得
+func (b *blogUseCase) GetRevision(userID, blogID, version uint) (*domainBlog.Revision, error) {
+	blog, _, err := b.FindBlogForUser(userID, blogID)
+	if err != nil {
+		return nil, err
+	}
+	return b.findRevision(blog, version)
+}
+
+// 2つのバージョン間の差分を計算
+func (b *blogUseCase) DiffRevisions(userID, blogID, fromVersion, toVersion uint) (*domainBlog.RevisionDiff, error) {
+	blog, _, err := b.FindBlogForUser(userID, blogID)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := b.findRevision(blog, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := b.findRevision(blog, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domainBlog.RevisionDiff{
+		From:  from,
+		To:    to,
+		Title: domainBlog.DiffWords(from.Title, to.Title),
+		Lines: domainBlog.DiffLines(from.Content, to.Content),
+	}, nil
+}
+
+// 過去のバージョンの内容で更新し、新しいバージョンとして復元
+func (b *blogUseCase) RestoreRevision(userID, blogID, version uint) (*domainBlog.Blog, error) {
+	blog, _, err := b.authorize(userID, blogID, ActionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if version == blog.Version {
+		return nil, fmt.Errorf("%w: version %d is already the current version", domainBlog.ErrBlogInvalidData, version)
+	}
+
+	revision, err := b.revisionRepo.FindRevision(blogID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.UpdateBlog(userID, &domainBlog.Blog{
+		ID:       blog.ID,
+		AuthorID: blog.AuthorID,
+		Title:    revision.Title,
+		Content:  revision.Content,
+		Version:  blog.Version,
+	})
+}
+
+// 一括操作に必要な操作権限
+var bulkActions = map[string]Action{
+	domainBlog.BulkActionDelete:         ActionDelete,
+	domainBlog.BulkActionRestore:        ActionDelete,
+	domainBlog.BulkActionVisibility:     ActionPublish,
+	domainBlog.BulkActionAddCategory:    ActionCategorize,
+	domainBlog.BulkActionRemoveCategory: ActionCategorize,
+}
+
+// ブログを一括操作し、ブログごとの結果を返す
+// 権限のないブログは結果に記録して残りのブログの操作を続ける
+// ブログの著者以外のカテゴリを紐付ける場合はErrCategoryNotFoundを返し、いずれのブログも変更しない
+func (b *blogUseCase) BulkOperate(userID uint, op *domainBlog.BulkOperation) (*domainBlog.BulkReport, error) {
+	action, ok := bulkActions[op.Action]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown action %q", domainBlog.ErrBulkInvalid, op.Action)
+	}
+	var category *domainCategory.Category
+	if op.Action == domainBlog.BulkActionAddCategory || op.Action == domainBlog.BulkActionRemoveCategory {
+		var err error
+		if category, err = b.categoryRepo.FindCategoryByID(op.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := b.blogRepo.ApplyBulk(op, func(blog *domainBlog.Blog) error {
+		if _, err := b.policy.Authorize(userID, blog, action); err != nil {
+			return err
+		}
+		// 紐付けられるのはブログの著者のカテゴリのみ
+		// AssignBlogと同様に他のユーザーのカテゴリは存在しないものとして扱う
+		if op.Action == domainBlog.BulkActionAddCategory && category.UserID != blog.AuthorID {
+			return domainCategory.ErrCategoryNotFound
+		}
+		return nil
+	})
+	if err != nil || op.DryRun {
+		return report, err
+	}
+
+	// 検索インデックス・関連記事の索引語を更新
+	// 一括操作自体は確定しているため、失敗しても結果は返却する
+	// 検索・関連記事の取得時にゴミ箱内のブログは除外され、索引語の無いブログは再索引で補われる
+	switch op.Action {
+	case domainBlog.BulkActionDelete:
+		for _, id := range report.AppliedIDs() {
+			_ = b.searchIndex.Remove(id)
+			_ = b.termIndex.Remove(id)
+		}
+	case domainBlog.BulkActionRestore:
+		for _, id := range report.AppliedIDs() {
+			blog, err := b.blogRepo.FindBlogByID(id)
+			if err != nil {
+				continue
+			}
+			_ = b.searchIndex.Index(blog)
+			_ = b.termIndex.Index(blog)
+		}
+	}
+	return report, nil
+}
+
+// 指定バージョンの内容を取得
+// 現在のバージョンの場合はブログ自身の内容を返す
+func (b *blogUseCase) findRevision(blog *domainBlog.Blog, version uint) (*domainBlog.Revision, error) {
+	if version == blog.Version {
+		return currentRevision(blog), nil
+	}
+	return b.revisionRepo.FindRevision(blog.ID, version)
+}
+
+// 現在のブログの内容を履歴として表現
+func currentRevision(blog *domainBlog.Blog) *domainBlog.Revision {
+	revision := domainBlog.NewRevision(blog, blog.AuthorID)
+	revision.CreatedAt = blog.UpdatedAt
+	return revision
+}
+
+// 編集中の内容を自動保存
+// 既存のブログは編集権限のあるユーザーのみ保存できる
+func (b *blogUseCase) SaveAutosave(autosave *domainBlog.Autosave) (*domainBlog.Autosave, error) {
+	if autosave.BlogID != 0 {
+		blog, _, err := b.authorize(autosave.UserID, autosave.BlogID, ActionEdit)
+		if err != nil {
+			return nil, err
+		}
+		// 編集元のバージョンが未指定の場合は現在のバージョンを編集中とみなす
+		if autosave.BaseVersion == 0 {
+			autosave.BaseVersion = blog.Version
+		}
+	}
+
+	autosave.SavedAt = time.Now()
+	autosave.ExpiresAt = autosave.SavedAt.Add(b.autosaveTTL)
+	if err := b.autosaves.Save(autosave, b.autosaveTTL); err != nil {
+		return nil, err
+	}
+	return autosave, nil
+}
+
+// 自動保存した内容を保存済みのブログとの差分と合わせて取得
+func (b *blogUseCase) GetAutosave(userID, blogID uint) (*domainBlog.AutosaveComparison, error) {
+	var blog *domainBlog.Blog
+	if blogID != 0 {
+		var err error
+		if blog, _, err = b.authorize(userID, blogID, ActionEdit); err != nil {
+			return nil, err
+		}
+	}
+
+	autosave, err := b.autosaves.Find(userID, blogID)
+	if err != nil {
+		return nil, err
+	}
+	return domainBlog.CompareAutosave(autosave, blog), nil
+}
+
+// 自動保存した内容を破棄
+// ブログの作成・更新の確定後に呼び出す
+func (b *blogUseCase) ClearAutosave(userID, blogID uint) error {
+	return b.autosaves.Delete(userID, blogID)
+}
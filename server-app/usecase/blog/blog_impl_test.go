@@ -0,0 +1,98 @@
+package blog
+
+import (
+	"errors"
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/stretchr/testify/assert"
+)
+
+// 一括操作の対象のブログをメモリ上で保持するリポジトリ
+// ApplyBulkは認可の結果のみを記録し、認可以外のエラーでは中止する
+type stubBulkBlogRepository struct {
+	domainBlog.BlogRepository
+	blogs map[uint]*domainBlog.Blog
+}
+
+func (r *stubBulkBlogRepository) ApplyBulk(op *domainBlog.BulkOperation, authorize func(blog *domainBlog.Blog) error) (*domainBlog.BulkReport, error) {
+	report := &domainBlog.BulkReport{Action: op.Action, DryRun: op.DryRun}
+	for _, id := range op.BlogIDs {
+		err := authorize(r.blogs[id])
+		switch {
+		case errors.Is(err, domainBlog.ErrBlogUnauthorized):
+			report.Add(domainBlog.BulkItemResult{BlogID: id, Result: domainBlog.BulkResultFailed, Reason: domainBlog.BulkReasonUnauthorized})
+		case err != nil:
+			return nil, err
+		default:
+			report.Add(domainBlog.BulkItemResult{BlogID: id, Result: domainBlog.BulkResultApplied})
+		}
+	}
+	return report, nil
+}
+
+type stubCategoryRepository struct {
+	domainCategory.CategoryRepository
+	categories map[uint]*domainCategory.Category
+}
+
+func (r *stubCategoryRepository) FindCategoryByID(id uint) (*domainCategory.Category, error) {
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, domainCategory.ErrCategoryNotFound
+	}
+	return category, nil
+}
+
+func TestBlogUseCase_BulkOperate_AddCategory(t *testing.T) {
+	const ownerID, otherID uint = 1, 2
+	blogRepo := &stubBulkBlogRepository{
+		blogs: map[uint]*domainBlog.Blog{
+			10: {ID: 10, AuthorID: ownerID},
+			11: {ID: 11, AuthorID: ownerID},
+			20: {ID: 20, AuthorID: otherID},
+		},
+	}
+	categoryRepo := &stubCategoryRepository{
+		categories: map[uint]*domainCategory.Category{
+			1: {ID: 1, UserID: ownerID, Name: "Go"},
+			2: {ID: 2, UserID: otherID, Name: "Rust"},
+		},
+	}
+	useCase := NewBlogUseCase(blogRepo, categoryRepo, nil, nil, nil, domainBlog.RevisionRetention{}, 0, nil, nil,
+		&stubCollaboratorRepository{}, nil, 0)
+
+	bulk := func(categoryID uint, blogIDs ...uint) (*domainBlog.BulkReport, error) {
+		op, err := domainBlog.NewBulkOperation(domainBlog.BulkActionAddCategory, blogIDs, "", categoryID, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return useCase.BulkOperate(ownerID, op)
+	}
+
+	t.Run("own category", func(t *testing.T) {
+		report, err := bulk(1, 10, 11)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 2, report.Applied)
+		}
+	})
+
+	t.Run("other user's category is not found as in AssignBlog", func(t *testing.T) {
+		_, err := bulk(2, 10, 11)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryNotFound)
+	})
+
+	t.Run("unknown category", func(t *testing.T) {
+		_, err := bulk(99, 10)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryNotFound)
+	})
+
+	t.Run("other user's blog is reported as unauthorized", func(t *testing.T) {
+		report, err := bulk(1, 10, 20)
+		if assert.NoError(t, err) {
+			assert.Equal(t, 1, report.Applied)
+			assert.Equal(t, domainBlog.BulkReasonUnauthorized, report.Items[1].Reason)
+		}
+	})
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	blog "github.com/kazukimurahashi12/webapp/domain/blog"
@@ -34,18 +35,77 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ArchiveBlog mocks base method.
+func (m *MockUseCase) ArchiveBlog(userID, id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveBlog", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveBlog indicates an expected call of ArchiveBlog.
+func (mr *MockUseCaseMockRecorder) ArchiveBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveBlog", reflect.TypeOf((*MockUseCase)(nil).ArchiveBlog), userID, id)
+}
+
+// BulkOperate mocks base method.
+func (m *MockUseCase) BulkOperate(userID uint, op *blog.BulkOperation) (*blog.BulkReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkOperate", userID, op)
+	ret0, _ := ret[0].(*blog.BulkReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkOperate indicates an expected call of BulkOperate.
+func (mr *MockUseCaseMockRecorder) BulkOperate(userID, op interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkOperate", reflect.TypeOf((*MockUseCase)(nil).BulkOperate), userID, op)
+}
+
+// ClearAutosave mocks base method.
+func (m *MockUseCase) ClearAutosave(userID, blogID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAutosave", userID, blogID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearAutosave indicates an expected call of ClearAutosave.
+func (mr *MockUseCaseMockRecorder) ClearAutosave(userID, blogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAutosave", reflect.TypeOf((*MockUseCase)(nil).ClearAutosave), userID, blogID)
+}
+
 // DeleteBlog mocks base method.
-func (m *MockUseCase) DeleteBlog(id uint) error {
+func (m *MockUseCase) DeleteBlog(userID, id uint) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteBlog", id)
+	ret := m.ctrl.Call(m, "DeleteBlog", userID, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteBlog indicates an expected call of DeleteBlog.
-func (mr *MockUseCaseMockRecorder) DeleteBlog(id interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) DeleteBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlog", reflect.TypeOf((*MockUseCase)(nil).DeleteBlog), userID, id)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockUseCase) DiffRevisions(userID, blogID, fromVersion, toVersion uint) (*blog.RevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", userID, blogID, fromVersion, toVersion)
+	ret0, _ := ret[0].(*blog.RevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockUseCaseMockRecorder) DiffRevisions(userID, blogID, fromVersion, toVersion interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlog", reflect.TypeOf((*MockUseCase)(nil).DeleteBlog), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockUseCase)(nil).DiffRevisions), userID, blogID, fromVersion, toVersion)
 }
 
 // FindBlogByAuthorID mocks base method.
@@ -78,6 +138,38 @@ func (mr *MockUseCaseMockRecorder) FindBlogByID(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlogByID", reflect.TypeOf((*MockUseCase)(nil).FindBlogByID), id)
 }
 
+// FindBlogBySlug mocks base method.
+func (m *MockUseCase) FindBlogBySlug(viewerID uint, username, slug string) (*blog.Blog, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlogBySlug", viewerID, username, slug)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBlogBySlug indicates an expected call of FindBlogBySlug.
+func (mr *MockUseCaseMockRecorder) FindBlogBySlug(viewerID, username, slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlogBySlug", reflect.TypeOf((*MockUseCase)(nil).FindBlogBySlug), viewerID, username, slug)
+}
+
+// FindBlogForUser mocks base method.
+func (m *MockUseCase) FindBlogForUser(userID, id uint) (*blog.Blog, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBlogForUser", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBlogForUser indicates an expected call of FindBlogForUser.
+func (mr *MockUseCaseMockRecorder) FindBlogForUser(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlogForUser", reflect.TypeOf((*MockUseCase)(nil).FindBlogForUser), userID, id)
+}
+
 // FindBlogsByAuthorID mocks base method.
 func (m *MockUseCase) FindBlogsByAuthorID(authorID uint) ([]blog.Blog, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +185,126 @@ func (mr *MockUseCaseMockRecorder) FindBlogsByAuthorID(authorID interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBlogsByAuthorID", reflect.TypeOf((*MockUseCase)(nil).FindBlogsByAuthorID), authorID)
 }
 
+// FindPublicBlog mocks base method.
+func (m *MockUseCase) FindPublicBlog(id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPublicBlog", id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPublicBlog indicates an expected call of FindPublicBlog.
+func (mr *MockUseCaseMockRecorder) FindPublicBlog(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPublicBlog", reflect.TypeOf((*MockUseCase)(nil).FindPublicBlog), id)
+}
+
+// FindSharedBlog mocks base method.
+func (m *MockUseCase) FindSharedBlog(token string) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSharedBlog", token)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSharedBlog indicates an expected call of FindSharedBlog.
+func (mr *MockUseCaseMockRecorder) FindSharedBlog(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSharedBlog", reflect.TypeOf((*MockUseCase)(nil).FindSharedBlog), token)
+}
+
+// GetAutosave mocks base method.
+func (m *MockUseCase) GetAutosave(userID, blogID uint) (*blog.AutosaveComparison, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAutosave", userID, blogID)
+	ret0, _ := ret[0].(*blog.AutosaveComparison)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAutosave indicates an expected call of GetAutosave.
+func (mr *MockUseCaseMockRecorder) GetAutosave(userID, blogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutosave", reflect.TypeOf((*MockUseCase)(nil).GetAutosave), userID, blogID)
+}
+
+// GetFeed mocks base method.
+func (m *MockUseCase) GetFeed(query blog.FeedQuery) (*blog.Feed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", query)
+	ret0, _ := ret[0].(*blog.Feed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockUseCaseMockRecorder) GetFeed(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockUseCase)(nil).GetFeed), query)
+}
+
+// GetRevision mocks base method.
+func (m *MockUseCase) GetRevision(userID, blogID, version uint) (*blog.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", userID, blogID, version)
+	ret0, _ := ret[0].(*blog.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockUseCaseMockRecorder) GetRevision(userID, blogID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockUseCase)(nil).GetRevision), userID, blogID, version)
+}
+
+// ListBlogs mocks base method.
+func (m *MockUseCase) ListBlogs(query blog.ListQuery) (*blog.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlogs", query)
+	ret0, _ := ret[0].(*blog.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlogs indicates an expected call of ListBlogs.
+func (mr *MockUseCaseMockRecorder) ListBlogs(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlogs", reflect.TypeOf((*MockUseCase)(nil).ListBlogs), query)
+}
+
+// ListRevisions mocks base method.
+func (m *MockUseCase) ListRevisions(userID, blogID uint) ([]blog.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRevisions", userID, blogID)
+	ret0, _ := ret[0].([]blog.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRevisions indicates an expected call of ListRevisions.
+func (mr *MockUseCaseMockRecorder) ListRevisions(userID, blogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRevisions", reflect.TypeOf((*MockUseCase)(nil).ListRevisions), userID, blogID)
+}
+
+// ListTrash mocks base method.
+func (m *MockUseCase) ListTrash(userID uint) ([]blog.TrashedBlog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", userID)
+	ret0, _ := ret[0].([]blog.TrashedBlog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockUseCaseMockRecorder) ListTrash(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockUseCase)(nil).ListTrash), userID)
+}
+
 // NewCreateBlog mocks base method.
 func (m *MockUseCase) NewCreateBlog(b *blog.Blog) (*blog.Blog, error) {
 	m.ctrl.T.Helper()
@@ -108,17 +320,196 @@ func (mr *MockUseCaseMockRecorder) NewCreateBlog(blog interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCreateBlog", reflect.TypeOf((*MockUseCase)(nil).NewCreateBlog), blog)
 }
 
+// PublishBlog mocks base method.
+func (m *MockUseCase) PublishBlog(userID, id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishBlog", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishBlog indicates an expected call of PublishBlog.
+func (mr *MockUseCaseMockRecorder) PublishBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishBlog", reflect.TypeOf((*MockUseCase)(nil).PublishBlog), userID, id)
+}
+
+// PublishDueBlogs mocks base method.
+func (m *MockUseCase) PublishDueBlogs(now time.Time) ([]blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDueBlogs", now)
+	ret0, _ := ret[0].([]blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDueBlogs indicates an expected call of PublishDueBlogs.
+func (mr *MockUseCaseMockRecorder) PublishDueBlogs(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDueBlogs", reflect.TypeOf((*MockUseCase)(nil).PublishDueBlogs), now)
+}
+
+// PurgeBlog mocks base method.
+func (m *MockUseCase) PurgeBlog(userID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeBlog", userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeBlog indicates an expected call of PurgeBlog.
+func (mr *MockUseCaseMockRecorder) PurgeBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeBlog", reflect.TypeOf((*MockUseCase)(nil).PurgeBlog), userID, id)
+}
+
+// PurgeExpiredBlogs mocks base method.
+func (m *MockUseCase) PurgeExpiredBlogs(now time.Time) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredBlogs", now)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredBlogs indicates an expected call of PurgeExpiredBlogs.
+func (mr *MockUseCaseMockRecorder) PurgeExpiredBlogs(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredBlogs", reflect.TypeOf((*MockUseCase)(nil).PurgeExpiredBlogs), now)
+}
+
+// RegenerateShareToken mocks base method.
+func (m *MockUseCase) RegenerateShareToken(userID, id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateShareToken", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateShareToken indicates an expected call of RegenerateShareToken.
+func (mr *MockUseCaseMockRecorder) RegenerateShareToken(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateShareToken", reflect.TypeOf((*MockUseCase)(nil).RegenerateShareToken), userID, id)
+}
+
+// RenderBlogContent mocks base method.
+func (m *MockUseCase) RenderBlogContent(b *blog.Blog) (*blog.RenderedContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderBlogContent", b)
+	ret0, _ := ret[0].(*blog.RenderedContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderBlogContent indicates an expected call of RenderBlogContent.
+func (mr *MockUseCaseMockRecorder) RenderBlogContent(blog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderBlogContent", reflect.TypeOf((*MockUseCase)(nil).RenderBlogContent), blog)
+}
+
+// RestoreBlog mocks base method.
+func (m *MockUseCase) RestoreBlog(userID, id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBlog", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBlog indicates an expected call of RestoreBlog.
+func (mr *MockUseCaseMockRecorder) RestoreBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBlog", reflect.TypeOf((*MockUseCase)(nil).RestoreBlog), userID, id)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockUseCase) RestoreRevision(userID, blogID, version uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", userID, blogID, version)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockUseCaseMockRecorder) RestoreRevision(userID, blogID, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockUseCase)(nil).RestoreRevision), userID, blogID, version)
+}
+
+// SaveAutosave mocks base method.
+func (m *MockUseCase) SaveAutosave(autosave *blog.Autosave) (*blog.Autosave, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAutosave", autosave)
+	ret0, _ := ret[0].(*blog.Autosave)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAutosave indicates an expected call of SaveAutosave.
+func (mr *MockUseCaseMockRecorder) SaveAutosave(autosave interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAutosave", reflect.TypeOf((*MockUseCase)(nil).SaveAutosave), autosave)
+}
+
+// ScheduleBlog mocks base method.
+func (m *MockUseCase) ScheduleBlog(userID, id uint, publishAt time.Time) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleBlog", userID, id, publishAt)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleBlog indicates an expected call of ScheduleBlog.
+func (mr *MockUseCaseMockRecorder) ScheduleBlog(userID, id, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleBlog", reflect.TypeOf((*MockUseCase)(nil).ScheduleBlog), userID, id, publishAt)
+}
+
+// SearchBlogs mocks base method.
+func (m *MockUseCase) SearchBlogs(query blog.SearchQuery) (*blog.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchBlogs", query)
+	ret0, _ := ret[0].(*blog.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchBlogs indicates an expected call of SearchBlogs.
+func (mr *MockUseCaseMockRecorder) SearchBlogs(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchBlogs", reflect.TypeOf((*MockUseCase)(nil).SearchBlogs), query)
+}
+
+// UnpublishBlog mocks base method.
+func (m *MockUseCase) UnpublishBlog(userID, id uint) (*blog.Blog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishBlog", userID, id)
+	ret0, _ := ret[0].(*blog.Blog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpublishBlog indicates an expected call of UnpublishBlog.
+func (mr *MockUseCaseMockRecorder) UnpublishBlog(userID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishBlog", reflect.TypeOf((*MockUseCase)(nil).UnpublishBlog), userID, id)
+}
+
 // UpdateBlog mocks base method.
-func (m *MockUseCase) UpdateBlog(b *blog.Blog) (*blog.Blog, error) {
+func (m *MockUseCase) UpdateBlog(userID uint, b *blog.Blog) (*blog.Blog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateBlog", b)
+	ret := m.ctrl.Call(m, "UpdateBlog", userID, b)
 	ret0, _ := ret[0].(*blog.Blog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateBlog indicates an expected call of UpdateBlog.
-func (mr *MockUseCaseMockRecorder) UpdateBlog(blog interface{}) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) UpdateBlog(userID, blog interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlog", reflect.TypeOf((*MockUseCase)(nil).UpdateBlog), blog)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBlog", reflect.TypeOf((*MockUseCase)(nil).UpdateBlog), userID, blog)
 }
@@ -0,0 +1,113 @@
+package blog
+
+import (
+	"errors"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+// ブログに対する操作
+type Action string
+
+const (
+	ActionView       Action = "view"       // 閲覧・更新履歴の参照
+	ActionEdit       Action = "edit"       // タイトル・本文の更新・更新履歴の復元
+	ActionDelete     Action = "delete"     // ゴミ箱への移動・復元・完全削除
+	ActionPublish    Action = "publish"    // 公開ステータス・公開範囲・共有URLの変更
+	ActionCategorize Action = "categorize" // カテゴリ・タグの紐付け・解除
+	ActionModerate   Action = "moderate"   // コメントの承認・却下
+)
+
+// 操作ごとに許可する権限
+var policyRules = map[Action][]string{
+	ActionView:       {domainBlog.RoleOwner, domainBlog.RoleEditor, domainBlog.RoleViewer},
+	ActionEdit:       {domainBlog.RoleOwner, domainBlog.RoleEditor},
+	ActionDelete:     {domainBlog.RoleOwner},
+	ActionPublish:    {domainBlog.RoleOwner},
+	ActionCategorize: {domainBlog.RoleOwner, domainBlog.RoleEditor},
+	ActionModerate:   {domainBlog.RoleOwner, domainBlog.RoleEditor},
+}
+
+// ブログに対する操作の認可
+// 操作するユーザーの権限を著者本人・共同編集者の登録から判定する
+type Policy struct {
+	collabRepo domainBlog.CollaboratorRepository
+}
+
+func NewPolicy(collabRepo domainBlog.CollaboratorRepository) *Policy {
+	return &Policy{collabRepo: collabRepo}
+}
+
+// 操作を認可し、操作するユーザーの権限を返す
+// 権限がない場合はErrBlogUnauthorizedを返す
+func (p *Policy) Authorize(actorID uint, blog *domainBlog.Blog, action Action) (string, error) {
+	// 未ログインのユーザーはいずれの操作も行えない
+	if actorID == 0 {
+		return "", domainBlog.ErrBlogUnauthorized
+	}
+	role, err := p.RoleOf(actorID, blog)
+	if err != nil {
+		return "", err
+	}
+	if !Allows(role, action) {
+		return "", domainBlog.ErrBlogUnauthorized
+	}
+	return role, nil
+}
+
+// ブログを閲覧できるか
+// 一覧に掲載される公開済みのブログは誰でも、それ以外は閲覧の権限を持つユーザーのみ閲覧できる
+func (p *Policy) CanView(actorID uint, blog *domainBlog.Blog) (bool, error) {
+	if blog.IsVisibleTo(actorID) {
+		return true, nil
+	}
+	role, err := p.RoleOf(actorID, blog)
+	if err != nil {
+		return false, err
+	}
+	return Allows(role, ActionView), nil
+}
+
+// ブログに対するユーザーの権限を取得
+// 招待を承諾していない共同編集者は権限を持たない
+func (p *Policy) RoleOf(actorID uint, blog *domainBlog.Blog) (string, error) {
+	if actorID == 0 {
+		return "", nil
+	}
+	if blog.AuthorID == actorID {
+		return domainBlog.RoleOwner, nil
+	}
+	collaborator, err := p.collabRepo.FindCollaborator(blog.ID, actorID)
+	if err != nil {
+		if errors.Is(err, domainBlog.ErrCollaboratorNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !collaborator.IsActive() {
+		return "", nil
+	}
+	return collaborator.Role, nil
+}
+
+// 権限で操作が許可されているか
+// 未定義の操作は許可しない
+func Allows(role string, action Action) bool {
+	allowed, ok := policyRules[action]
+	if !ok || role == "" {
+		return false
+	}
+	return domainBlog.HasRole(role, allowed...)
+}
+
+// 操作が許可されている共同編集者の権限を取得
+// 複数のブログをまとめて検索する際の条件に使用する
+func CollaboratorRoles(action Action) []string {
+	var roles []string
+	for _, role := range policyRules[action] {
+		if domainBlog.IsCollaboratorRole(role) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
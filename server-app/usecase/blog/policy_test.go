@@ -0,0 +1,179 @@
+package blog
+
+import (
+	"errors"
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	"github.com/stretchr/testify/assert"
+)
+
+// 共同編集者の登録をメモリ上で保持するリポジトリ
+// 認可の判定に使うFindCollaborator以外は呼び出されない想定
+type stubCollaboratorRepository struct {
+	domainBlog.CollaboratorRepository
+	collaborators map[uint]*domainBlog.Collaborator
+	err           error
+}
+
+func (r *stubCollaboratorRepository) FindCollaborator(blogID, userID uint) (*domainBlog.Collaborator, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	collaborator, ok := r.collaborators[userID]
+	if !ok || collaborator.BlogID != blogID {
+		return nil, domainBlog.ErrCollaboratorNotFound
+	}
+	return collaborator, nil
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	const (
+		ownerID    uint = 1
+		editorID   uint = 2
+		viewerID   uint = 3
+		pendingID  uint = 4
+		declinedID uint = 5
+		strangerID uint = 6
+		otherID    uint = 7 // 別のブログの編集者
+	)
+	blog := &domainBlog.Blog{ID: 10, AuthorID: ownerID}
+	repo := &stubCollaboratorRepository{
+		collaborators: map[uint]*domainBlog.Collaborator{
+			editorID:   {BlogID: 10, UserID: editorID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationAccepted},
+			viewerID:   {BlogID: 10, UserID: viewerID, Role: domainBlog.RoleViewer, Status: domainBlog.InvitationAccepted},
+			pendingID:  {BlogID: 10, UserID: pendingID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationPending},
+			declinedID: {BlogID: 10, UserID: declinedID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationDeclined},
+			otherID:    {BlogID: 11, UserID: otherID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationAccepted},
+		},
+	}
+	policy := NewPolicy(repo)
+
+	tests := []struct {
+		name         string
+		actorID      uint
+		action       Action
+		expectedRole string
+		expectedErr  error
+	}{
+		// 著者本人
+		{name: "owner can view", actorID: ownerID, action: ActionView, expectedRole: domainBlog.RoleOwner},
+		{name: "owner can edit", actorID: ownerID, action: ActionEdit, expectedRole: domainBlog.RoleOwner},
+		{name: "owner can delete", actorID: ownerID, action: ActionDelete, expectedRole: domainBlog.RoleOwner},
+		{name: "owner can publish", actorID: ownerID, action: ActionPublish, expectedRole: domainBlog.RoleOwner},
+		{name: "owner can categorize", actorID: ownerID, action: ActionCategorize, expectedRole: domainBlog.RoleOwner},
+		{name: "owner can moderate", actorID: ownerID, action: ActionModerate, expectedRole: domainBlog.RoleOwner},
+
+		// 編集者
+		{name: "editor can view", actorID: editorID, action: ActionView, expectedRole: domainBlog.RoleEditor},
+		{name: "editor can edit", actorID: editorID, action: ActionEdit, expectedRole: domainBlog.RoleEditor},
+		{name: "editor cannot delete", actorID: editorID, action: ActionDelete, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "editor cannot publish", actorID: editorID, action: ActionPublish, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "editor can categorize", actorID: editorID, action: ActionCategorize, expectedRole: domainBlog.RoleEditor},
+		{name: "editor can moderate", actorID: editorID, action: ActionModerate, expectedRole: domainBlog.RoleEditor},
+
+		// 閲覧者
+		{name: "viewer can view", actorID: viewerID, action: ActionView, expectedRole: domainBlog.RoleViewer},
+		{name: "viewer cannot edit", actorID: viewerID, action: ActionEdit, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "viewer cannot delete", actorID: viewerID, action: ActionDelete, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "viewer cannot publish", actorID: viewerID, action: ActionPublish, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "viewer cannot categorize", actorID: viewerID, action: ActionCategorize, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "viewer cannot moderate", actorID: viewerID, action: ActionModerate, expectedErr: domainBlog.ErrBlogUnauthorized},
+
+		// 招待に回答していない・辞退したユーザー
+		{name: "pending invitee cannot view", actorID: pendingID, action: ActionView, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "pending invitee cannot edit", actorID: pendingID, action: ActionEdit, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "declined invitee cannot view", actorID: declinedID, action: ActionView, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "declined invitee cannot edit", actorID: declinedID, action: ActionEdit, expectedErr: domainBlog.ErrBlogUnauthorized},
+
+		// 無関係のユーザー
+		{name: "stranger cannot view", actorID: strangerID, action: ActionView, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "stranger cannot edit", actorID: strangerID, action: ActionEdit, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "stranger cannot delete", actorID: strangerID, action: ActionDelete, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "stranger cannot publish", actorID: strangerID, action: ActionPublish, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "stranger cannot categorize", actorID: strangerID, action: ActionCategorize, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "stranger cannot moderate", actorID: strangerID, action: ActionModerate, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "editor of another blog cannot edit", actorID: otherID, action: ActionEdit, expectedErr: domainBlog.ErrBlogUnauthorized},
+
+		// 未ログイン・未定義の操作
+		{name: "anonymous cannot view", actorID: 0, action: ActionView, expectedErr: domainBlog.ErrBlogUnauthorized},
+		{name: "unknown action is denied", actorID: ownerID, action: Action("transfer"), expectedErr: domainBlog.ErrBlogUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			role, err := policy.Authorize(tt.actorID, blog, tt.action)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Empty(t, role)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedRole, role)
+		})
+	}
+}
+
+func TestPolicy_Authorize_RepositoryError(t *testing.T) {
+	repoErr := errors.New("connection refused")
+	policy := NewPolicy(&stubCollaboratorRepository{err: repoErr})
+	blog := &domainBlog.Blog{ID: 10, AuthorID: 1}
+
+	t.Run("owner is resolved without repository", func(t *testing.T) {
+		role, err := policy.Authorize(1, blog, ActionDelete)
+		assert.NoError(t, err)
+		assert.Equal(t, domainBlog.RoleOwner, role)
+	})
+
+	t.Run("repository error is not reported as unauthorized", func(t *testing.T) {
+		_, err := policy.Authorize(2, blog, ActionView)
+		assert.ErrorIs(t, err, repoErr)
+		assert.NotErrorIs(t, err, domainBlog.ErrBlogUnauthorized)
+	})
+}
+
+func TestCollaboratorRoles(t *testing.T) {
+	assert.Equal(t, []string{domainBlog.RoleEditor, domainBlog.RoleViewer}, CollaboratorRoles(ActionView))
+	assert.Equal(t, []string{domainBlog.RoleEditor}, CollaboratorRoles(ActionModerate))
+	assert.Empty(t, CollaboratorRoles(ActionDelete))
+	assert.Empty(t, CollaboratorRoles(Action("transfer")))
+}
+
+func TestPolicy_CanView(t *testing.T) {
+	const (
+		ownerID    uint = 1
+		editorID   uint = 2
+		viewerID   uint = 3
+		strangerID uint = 6
+	)
+	repo := &stubCollaboratorRepository{
+		collaborators: map[uint]*domainBlog.Collaborator{
+			editorID: {BlogID: 10, UserID: editorID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationAccepted},
+			viewerID: {BlogID: 10, UserID: viewerID, Role: domainBlog.RoleViewer, Status: domainBlog.InvitationAccepted},
+		},
+	}
+	policy := NewPolicy(repo)
+	draft := &domainBlog.Blog{ID: 10, AuthorID: ownerID, Status: domainBlog.StatusDraft, Visibility: domainBlog.VisibilityPublic}
+	published := &domainBlog.Blog{ID: 10, AuthorID: ownerID, Status: domainBlog.StatusPublished, Visibility: domainBlog.VisibilityPublic}
+
+	tests := []struct {
+		name     string
+		actorID  uint
+		blog     *domainBlog.Blog
+		expected bool
+	}{
+		{name: "owner can view draft", actorID: ownerID, blog: draft, expected: true},
+		{name: "editor can view draft", actorID: editorID, blog: draft, expected: true},
+		{name: "viewer can view draft", actorID: viewerID, blog: draft, expected: true},
+		{name: "stranger cannot view draft", actorID: strangerID, blog: draft, expected: false},
+		{name: "stranger can view published", actorID: strangerID, blog: published, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			visible, err := policy.CanView(tt.actorID, tt.blog)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, visible)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package category
+
+import (
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+)
+
+type UseCase interface {
+	CreateCategory(userID uint, name, description string, parentID *uint) (*domainCategory.Category, error)
+	RenameCategory(userID, id uint, name string) (*domainCategory.Category, error)
+	MoveCategory(userID, id uint, parentID *uint) (*domainCategory.Category, error)
+	DeleteCategory(userID, id uint) error
+	GetCategoryTree(userID uint) ([]domainCategory.Category, error)
+	FindCategoriesByBlogID(viewerID, blogID uint) ([]domainCategory.Category, error)
+	AssignBlog(userID, blogID, categoryID uint) error
+	UnassignBlog(userID, blogID, categoryID uint) error
+}
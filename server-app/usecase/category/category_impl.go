@@ -0,0 +1,211 @@
+package category
+
+import (
+	"errors"
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type categoryUseCase struct {
+	categoryRepo domainCategory.CategoryRepository
+	blogRepo     domainBlog.BlogRepository
+	policy       *usecaseBlog.Policy
+}
+
+func NewCategoryUseCase(categoryRepo domainCategory.CategoryRepository, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository) UseCase {
+	return &categoryUseCase{
+		categoryRepo: categoryRepo,
+		blogRepo:     blogRepo,
+		policy:       usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// カテゴリを作成
+// カテゴリは作成したユーザーのカテゴリとして登録する
+func (u *categoryUseCase) CreateCategory(userID uint, name, description string, parentID *uint) (*domainCategory.Category, error) {
+	category, err := domainCategory.NewCategory(userID, name, description, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainCategory.ErrCategoryInvalidData, err)
+	}
+
+	if err := u.ensureNameAvailable(userID, name, 0); err != nil {
+		return nil, err
+	}
+
+	if err := u.ensureParentExists(userID, parentID); err != nil {
+		return nil, err
+	}
+
+	if err := u.categoryRepo.Create(category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// カテゴリ名を変更
+func (u *categoryUseCase) RenameCategory(userID, id uint, name string) (*domainCategory.Category, error) {
+	if err := domainCategory.ValidateName(name); err != nil {
+		return nil, fmt.Errorf("%w: %v", domainCategory.ErrCategoryInvalidData, err)
+	}
+
+	category, err := u.findOwnCategory(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.ensureNameAvailable(userID, name, id); err != nil {
+		return nil, err
+	}
+
+	category.Name = name
+	if err := u.categoryRepo.Update(category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// カテゴリの親を変更
+// 自身または子孫カテゴリの配下への移動は循環となるため拒否する
+func (u *categoryUseCase) MoveCategory(userID, id uint, parentID *uint) (*domainCategory.Category, error) {
+	category, err := u.findOwnCategory(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.ensureParentExists(userID, parentID); err != nil {
+		return nil, err
+	}
+
+	categories, err := u.categoryRepo.FindCategoriesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if domainCategory.WouldCreateCycle(categories, id, parentID) {
+		return nil, domainCategory.ErrCategoryCycle
+	}
+
+	category.ParentID = parentID
+	if err := u.categoryRepo.Update(category); err != nil {
+		return nil, err
+	}
+	return category, nil
+}
+
+// カテゴリを削除
+func (u *categoryUseCase) DeleteCategory(userID, id uint) error {
+	if _, err := u.findOwnCategory(userID, id); err != nil {
+		return err
+	}
+	return u.categoryRepo.Delete(id)
+}
+
+// ユーザーのカテゴリ一覧をツリー構造で取得
+func (u *categoryUseCase) GetCategoryTree(userID uint) ([]domainCategory.Category, error) {
+	categories, err := u.categoryRepo.FindCategoriesByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return domainCategory.BuildTree(categories), nil
+}
+
+// ブログに紐づくカテゴリを取得
+func (u *categoryUseCase) FindCategoriesByBlogID(viewerID, blogID uint) ([]domainCategory.Category, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	// 閲覧できないブログは存在しないものとして扱う
+	visible, err := u.policy.CanView(viewerID, blog)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+	return u.categoryRepo.FindCategoriesByBlogID(blogID)
+}
+
+// ブログにカテゴリを紐付け
+// 紐付けられるのはブログの著者のカテゴリのみ（共同編集者も著者のカテゴリから選択する）
+func (u *categoryUseCase) AssignBlog(userID, blogID, categoryID uint) error {
+	blog, err := u.authorizeBlog(userID, blogID)
+	if err != nil {
+		return err
+	}
+	category, err := u.categoryRepo.FindCategoryByID(categoryID)
+	if err != nil {
+		return err
+	}
+	if category.UserID != blog.AuthorID {
+		return domainCategory.ErrCategoryNotFound
+	}
+	return u.categoryRepo.AssignBlog(categoryID, blogID)
+}
+
+// ブログからカテゴリの紐付けを解除
+func (u *categoryUseCase) UnassignBlog(userID, blogID, categoryID uint) error {
+	if _, err := u.authorizeBlog(userID, blogID); err != nil {
+		return err
+	}
+	return u.categoryRepo.UnassignBlog(categoryID, blogID)
+}
+
+// 自身のカテゴリを取得
+// 他のユーザーのカテゴリの場合はErrCategoryUnauthorizedを返す
+func (u *categoryUseCase) findOwnCategory(userID, id uint) (*domainCategory.Category, error) {
+	category, err := u.categoryRepo.FindCategoryByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if category.UserID != userID {
+		return nil, domainCategory.ErrCategoryUnauthorized
+	}
+	return category, nil
+}
+
+// 親カテゴリが自身のカテゴリに存在することを確認
+// 他のユーザーのカテゴリは存在しないものとして扱う
+func (u *categoryUseCase) ensureParentExists(userID uint, parentID *uint) error {
+	if parentID == nil {
+		return nil
+	}
+	parent, err := u.categoryRepo.FindCategoryByID(*parentID)
+	if err != nil {
+		return err
+	}
+	if parent.UserID != userID {
+		return domainCategory.ErrCategoryNotFound
+	}
+	return nil
+}
+
+// 同名カテゴリがユーザーのカテゴリに存在しないことを確認
+// excludeIDには名前変更対象のカテゴリ自身を指定する
+func (u *categoryUseCase) ensureNameAvailable(userID uint, name string, excludeID uint) error {
+	existing, err := u.categoryRepo.FindCategoryByName(userID, name)
+	if err != nil {
+		if errors.Is(err, domainCategory.ErrCategoryNotFound) {
+			return nil
+		}
+		return err
+	}
+	if existing.ID != excludeID {
+		return domainCategory.ErrCategoryAlreadyExists
+	}
+	return nil
+}
+
+// ブログのカテゴリを変更できることを確認
+func (u *categoryUseCase) authorizeBlog(userID, blogID uint) (*domainBlog.Blog, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionCategorize); err != nil {
+		return nil, err
+	}
+	return blog, nil
+}
@@ -0,0 +1,186 @@
+package category
+
+import (
+	"testing"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	"github.com/stretchr/testify/assert"
+)
+
+// カテゴリをメモリ上で保持するリポジトリ
+// 所有者の判定に使うメソッド以外は呼び出されない想定
+type stubCategoryRepository struct {
+	domainCategory.CategoryRepository
+	categories map[uint]*domainCategory.Category
+	updated    []uint
+	deleted    []uint
+	assigned   []uint
+}
+
+func (r *stubCategoryRepository) FindCategoryByID(id uint) (*domainCategory.Category, error) {
+	category, ok := r.categories[id]
+	if !ok {
+		return nil, domainCategory.ErrCategoryNotFound
+	}
+	copied := *category
+	return &copied, nil
+}
+
+func (r *stubCategoryRepository) FindCategoryByName(userID uint, name string) (*domainCategory.Category, error) {
+	for _, category := range r.categories {
+		if category.UserID == userID && category.Name == name {
+			return category, nil
+		}
+	}
+	return nil, domainCategory.ErrCategoryNotFound
+}
+
+func (r *stubCategoryRepository) FindCategoriesByUserID(userID uint) ([]domainCategory.Category, error) {
+	var categories []domainCategory.Category
+	for _, category := range r.categories {
+		if category.UserID == userID {
+			categories = append(categories, *category)
+		}
+	}
+	return categories, nil
+}
+
+func (r *stubCategoryRepository) Create(category *domainCategory.Category) error {
+	category.ID = uint(len(r.categories) + 1)
+	r.categories[category.ID] = category
+	return nil
+}
+
+func (r *stubCategoryRepository) Update(category *domainCategory.Category) error {
+	r.updated = append(r.updated, category.ID)
+	return nil
+}
+
+func (r *stubCategoryRepository) Delete(id uint) error {
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+func (r *stubCategoryRepository) AssignBlog(categoryID, blogID uint) error {
+	r.assigned = append(r.assigned, categoryID)
+	return nil
+}
+
+type stubBlogRepository struct {
+	domainBlog.BlogRepository
+	blogs map[uint]*domainBlog.Blog
+}
+
+func (r *stubBlogRepository) FindBlogByID(id uint) (*domainBlog.Blog, error) {
+	blog, ok := r.blogs[id]
+	if !ok {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+	return blog, nil
+}
+
+type stubCollaboratorRepository struct {
+	domainBlog.CollaboratorRepository
+	collaborators map[uint]*domainBlog.Collaborator
+}
+
+func (r *stubCollaboratorRepository) FindCollaborator(blogID, userID uint) (*domainBlog.Collaborator, error) {
+	collaborator, ok := r.collaborators[userID]
+	if !ok || collaborator.BlogID != blogID {
+		return nil, domainBlog.ErrCollaboratorNotFound
+	}
+	return collaborator, nil
+}
+
+const (
+	ownerID    uint = 1
+	editorID   uint = 2
+	strangerID uint = 3
+)
+
+func newTestUseCase() (UseCase, *stubCategoryRepository) {
+	categoryRepo := &stubCategoryRepository{
+		categories: map[uint]*domainCategory.Category{
+			1: {ID: 1, UserID: ownerID, Name: "Go"},
+			2: {ID: 2, UserID: ownerID, Name: "Gin"},
+			3: {ID: 3, UserID: strangerID, Name: "Rust"},
+		},
+	}
+	blogRepo := &stubBlogRepository{
+		blogs: map[uint]*domainBlog.Blog{
+			10: {ID: 10, AuthorID: ownerID},
+		},
+	}
+	collabRepo := &stubCollaboratorRepository{
+		collaborators: map[uint]*domainBlog.Collaborator{
+			editorID: {BlogID: 10, UserID: editorID, Role: domainBlog.RoleEditor, Status: domainBlog.InvitationAccepted},
+		},
+	}
+	return NewCategoryUseCase(categoryRepo, blogRepo, collabRepo), categoryRepo
+}
+
+func TestCategoryUseCase_OwnerOnly(t *testing.T) {
+	parentID := uint(2)
+
+	t.Run("owner can rename, move and delete", func(t *testing.T) {
+		useCase, repo := newTestUseCase()
+		_, err := useCase.RenameCategory(ownerID, 1, "Golang")
+		assert.NoError(t, err)
+		_, err = useCase.MoveCategory(ownerID, 1, &parentID)
+		assert.NoError(t, err)
+		assert.NoError(t, useCase.DeleteCategory(ownerID, 1))
+		assert.Equal(t, []uint{1, 1}, repo.updated)
+		assert.Equal(t, []uint{1}, repo.deleted)
+	})
+
+	t.Run("other user cannot rename, move or delete", func(t *testing.T) {
+		useCase, repo := newTestUseCase()
+		_, err := useCase.RenameCategory(strangerID, 1, "Golang")
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryUnauthorized)
+		_, err = useCase.MoveCategory(strangerID, 1, nil)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryUnauthorized)
+		assert.ErrorIs(t, useCase.DeleteCategory(strangerID, 1), domainCategory.ErrCategoryUnauthorized)
+		assert.Empty(t, repo.updated)
+		assert.Empty(t, repo.deleted)
+	})
+
+	t.Run("other user's category cannot be a parent", func(t *testing.T) {
+		useCase, _ := newTestUseCase()
+		foreignID := uint(3)
+		_, err := useCase.CreateCategory(ownerID, "Web", "", &foreignID)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryNotFound)
+		_, err = useCase.MoveCategory(ownerID, 1, &foreignID)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryNotFound)
+	})
+
+	t.Run("same name is allowed for different users", func(t *testing.T) {
+		useCase, _ := newTestUseCase()
+		category, err := useCase.CreateCategory(strangerID, "Go", "", nil)
+		if assert.NoError(t, err) {
+			assert.Equal(t, strangerID, category.UserID)
+		}
+		_, err = useCase.CreateCategory(ownerID, "Go", "", nil)
+		assert.ErrorIs(t, err, domainCategory.ErrCategoryAlreadyExists)
+	})
+}
+
+func TestCategoryUseCase_AssignBlog(t *testing.T) {
+	t.Run("editor can assign author's category", func(t *testing.T) {
+		useCase, repo := newTestUseCase()
+		assert.NoError(t, useCase.AssignBlog(editorID, 10, 1))
+		assert.Equal(t, []uint{1}, repo.assigned)
+	})
+
+	t.Run("other user's category cannot be assigned", func(t *testing.T) {
+		useCase, repo := newTestUseCase()
+		assert.ErrorIs(t, useCase.AssignBlog(ownerID, 10, 3), domainCategory.ErrCategoryNotFound)
+		assert.Empty(t, repo.assigned)
+	})
+
+	t.Run("non-collaborator cannot assign", func(t *testing.T) {
+		useCase, repo := newTestUseCase()
+		assert.ErrorIs(t, useCase.AssignBlog(strangerID, 10, 3), domainBlog.ErrBlogUnauthorized)
+		assert.Empty(t, repo.assigned)
+	})
+}
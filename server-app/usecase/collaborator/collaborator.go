@@ -0,0 +1,14 @@
+package collaborator
+
+import (
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+)
+
+type UseCase interface {
+	InviteCollaborator(userID, blogID, inviteeID uint, role string) (*domainBlog.Collaborator, error)
+	ListCollaborators(userID, blogID uint) ([]domainBlog.Collaborator, error)
+	ListInvitations(userID uint) ([]domainBlog.Collaborator, error)
+	RespondInvitation(userID, blogID uint, accept bool) error
+	RemoveCollaborator(userID, blogID, collaboratorID uint) error
+	TransferOwnership(userID, blogID, newOwnerID uint) (*domainBlog.Blog, error)
+}
@@ -0,0 +1,138 @@
+package collaborator
+
+import (
+	"errors"
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+)
+
+type collaboratorUseCase struct {
+	collabRepo domainBlog.CollaboratorRepository
+	blogRepo   domainBlog.BlogRepository
+	userRepo   domainUser.UserRepository
+}
+
+func NewCollaboratorUseCase(collabRepo domainBlog.CollaboratorRepository, blogRepo domainBlog.BlogRepository, userRepo domainUser.UserRepository) UseCase {
+	return &collaboratorUseCase{
+		collabRepo: collabRepo,
+		blogRepo:   blogRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// 自身のブログの共同編集者として招待
+// 招待されたユーザーが承諾するまで権限は有効にならない
+func (u *collaboratorUseCase) InviteCollaborator(userID, blogID, inviteeID uint, role string) (*domainBlog.Collaborator, error) {
+	if !domainBlog.IsCollaboratorRole(role) {
+		return nil, fmt.Errorf("%w: role must be %s or %s", domainBlog.ErrCollaboratorInvalid, domainBlog.RoleEditor, domainBlog.RoleViewer)
+	}
+	if inviteeID == userID {
+		return nil, fmt.Errorf("%w: cannot invite yourself", domainBlog.ErrCollaboratorInvalid)
+	}
+	if _, err := u.findOwnBlog(userID, blogID); err != nil {
+		return nil, err
+	}
+	if _, err := u.userRepo.FindUserByID(inviteeID); err != nil {
+		return nil, err
+	}
+
+	collaborator := &domainBlog.Collaborator{
+		BlogID:    blogID,
+		UserID:    inviteeID,
+		Role:      role,
+		InvitedBy: userID,
+	}
+	if err := u.collabRepo.Invite(collaborator); err != nil {
+		return nil, err
+	}
+	return collaborator, nil
+}
+
+// ブログの共同編集者を取得
+// 著者本人と承諾済みの共同編集者のみ取得できる
+func (u *collaboratorUseCase) ListCollaborators(userID, blogID uint) ([]domainBlog.Collaborator, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if blog.AuthorID != userID {
+		if _, err := u.findActiveCollaborator(blogID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return u.collabRepo.FindCollaboratorsByBlogID(blogID)
+}
+
+// 自身宛ての未回答の招待を取得
+func (u *collaboratorUseCase) ListInvitations(userID uint) ([]domainBlog.Collaborator, error) {
+	return u.collabRepo.FindInvitationsByUserID(userID)
+}
+
+// 自身宛ての招待を承諾・辞退
+func (u *collaboratorUseCase) RespondInvitation(userID, blogID uint, accept bool) error {
+	status := domainBlog.InvitationDeclined
+	if accept {
+		status = domainBlog.InvitationAccepted
+	}
+	return u.collabRepo.Respond(blogID, userID, status)
+}
+
+// 共同編集者を削除
+// 著者本人は全ての共同編集者を、共同編集者は自身のみを削除できる
+func (u *collaboratorUseCase) RemoveCollaborator(userID, blogID, collaboratorID uint) error {
+	if userID != collaboratorID {
+		if _, err := u.findOwnBlog(userID, blogID); err != nil {
+			return err
+		}
+	}
+	return u.collabRepo.Remove(blogID, collaboratorID)
+}
+
+// ブログの所有権を承諾済みの共同編集者へ移譲
+// 著者本人のみ移譲でき、移譲後は編集者として共同編集者に残る
+func (u *collaboratorUseCase) TransferOwnership(userID, blogID, newOwnerID uint) (*domainBlog.Blog, error) {
+	if _, err := u.findOwnBlog(userID, blogID); err != nil {
+		return nil, err
+	}
+	if _, err := u.findActiveCollaborator(blogID, newOwnerID); err != nil {
+		if errors.Is(err, domainBlog.ErrBlogUnauthorized) {
+			return nil, fmt.Errorf("%w: new owner must be an accepted collaborator", domainBlog.ErrCollaboratorInvalid)
+		}
+		return nil, err
+	}
+
+	if err := u.collabRepo.TransferOwnership(blogID, userID, newOwnerID); err != nil {
+		return nil, err
+	}
+	return u.blogRepo.FindBlogByID(blogID)
+}
+
+// 自身のブログを取得
+func (u *collaboratorUseCase) findOwnBlog(userID, blogID uint) (*domainBlog.Blog, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if blog.AuthorID != userID {
+		return nil, domainBlog.ErrBlogUnauthorized
+	}
+	return blog, nil
+}
+
+// 承諾済みの共同編集者を取得
+// 共同編集者でない場合はErrBlogUnauthorizedを返す
+func (u *collaboratorUseCase) findActiveCollaborator(blogID, userID uint) (*domainBlog.Collaborator, error) {
+	collaborator, err := u.collabRepo.FindCollaborator(blogID, userID)
+	if err != nil {
+		if errors.Is(err, domainBlog.ErrCollaboratorNotFound) {
+			return nil, domainBlog.ErrBlogUnauthorized
+		}
+		return nil, err
+	}
+	if !collaborator.IsActive() {
+		return nil, domainBlog.ErrBlogUnauthorized
+	}
+	return collaborator, nil
+}
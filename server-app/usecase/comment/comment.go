@@ -0,0 +1,12 @@
+package comment
+
+import (
+	domainComment "github.com/kazukimurahashi12/webapp/domain/comment"
+)
+
+type UseCase interface {
+	PostComment(userID, postID uint, parentID *uint, content string) (*domainComment.Comment, error)
+	GetCommentTree(userID, postID uint) ([]domainComment.Comment, error)
+	GetModerationQueue(userID uint) ([]domainComment.Comment, error)
+	ModerateComment(userID, commentID uint, status string) (*domainComment.Comment, error)
+}
@@ -0,0 +1,138 @@
+package comment
+
+import (
+	"errors"
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainComment "github.com/kazukimurahashi12/webapp/domain/comment"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type commentUseCase struct {
+	commentRepo domainComment.CommentRepository
+	blogRepo    domainBlog.BlogRepository
+	userRepo    domainUser.UserRepository
+	policy      *usecaseBlog.Policy
+}
+
+func NewCommentUseCase(commentRepo domainComment.CommentRepository, blogRepo domainBlog.BlogRepository, userRepo domainUser.UserRepository, collabRepo domainBlog.CollaboratorRepository) UseCase {
+	return &commentUseCase{
+		commentRepo: commentRepo,
+		blogRepo:    blogRepo,
+		userRepo:    userRepo,
+		policy:      usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// コメント・返信を投稿
+// コメントを承認できるユーザー（著者・編集者）のコメントは承認済み、それ以外は承認待ちとして登録する
+func (u *commentUseCase) PostComment(userID, postID uint, parentID *uint, content string) (*domainComment.Comment, error) {
+	blog, err := u.blogRepo.FindBlogByID(postID)
+	if err != nil {
+		return nil, err
+	}
+	// 下書き等の非公開記事は著者・共同編集者以外から存在しないものとして扱う
+	if err := u.checkVisible(userID, blog); err != nil {
+		return nil, err
+	}
+
+	// 返信先は同じ記事の公開済みコメントに限る
+	if parentID != nil {
+		parent, err := u.commentRepo.FindCommentByID(*parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.PostID != postID || parent.Status != domainComment.StatusApproved {
+			return nil, domainComment.ErrCommentInvalidParent
+		}
+	}
+
+	user, err := u.userRepo.FindUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := domainComment.NewComment(postID, &userID, parentID, content, user.Username, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domainComment.ErrCommentInvalidData, err)
+	}
+	role, err := u.policy.RoleOf(userID, blog)
+	if err != nil {
+		return nil, err
+	}
+	if usecaseBlog.Allows(role, usecaseBlog.ActionModerate) {
+		comment.Status = domainComment.StatusApproved
+	}
+
+	if err := u.commentRepo.Create(comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// 承認済みコメントを返信ツリーで取得
+func (u *commentUseCase) GetCommentTree(userID, postID uint) ([]domainComment.Comment, error) {
+	blog, err := u.blogRepo.FindBlogByID(postID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkVisible(userID, blog); err != nil {
+		return nil, err
+	}
+
+	comments, err := u.commentRepo.FindCommentsByPostIDAndStatus(postID, domainComment.StatusApproved)
+	if err != nil {
+		return nil, err
+	}
+	return domainComment.BuildTree(comments), nil
+}
+
+// 自身が著者・編集者の全ブログ記事に付いた承認待ちコメントを取得
+func (u *commentUseCase) GetModerationQueue(userID uint) ([]domainComment.Comment, error) {
+	return u.commentRepo.FindCommentsByModeratorIDAndStatus(userID, usecaseBlog.CollaboratorRoles(usecaseBlog.ActionModerate), domainComment.StatusPending)
+}
+
+// コメントを承認・却下・スパム判定
+// 操作できるのはコメント対象ブログの著者・編集者のみ
+func (u *commentUseCase) ModerateComment(userID, commentID uint, status string) (*domainComment.Comment, error) {
+	if !domainComment.IsModerationStatus(status) {
+		return nil, domainComment.ErrCommentInvalidStatus
+	}
+
+	comment, err := u.commentRepo.FindCommentByID(commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	blog, err := u.blogRepo.FindBlogByID(comment.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionModerate); err != nil {
+		if errors.Is(err, domainBlog.ErrBlogUnauthorized) {
+			return nil, domainComment.ErrCommentUnauthorized
+		}
+		return nil, err
+	}
+
+	if err := u.commentRepo.UpdateStatus(commentID, status); err != nil {
+		return nil, err
+	}
+	comment.Status = status
+	return comment, nil
+}
+
+// ブログを閲覧できることを確認
+// 閲覧できない場合はErrBlogNotFoundを返す
+func (u *commentUseCase) checkVisible(userID uint, blog *domainBlog.Blog) error {
+	visible, err := u.policy.CanView(userID, blog)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return domainBlog.ErrBlogNotFound
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package export
+
+import (
+	"io"
+	"time"
+
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+)
+
+type UseCase interface {
+	PrepareExport(userID uint) (*domainExport.Archive, error)
+	WriteArchive(archive *domainExport.Archive, w io.Writer) (*domainExport.Manifest, error)
+	StartExport(userID uint) (*domainExport.Job, error)
+	GetJob(userID, id uint) (*domainExport.Job, error)
+	OpenDownload(token string) (*domainExport.Job, io.ReadCloser, error)
+	RunJob(id uint) error
+	PendingJobIDs(limit int) ([]uint, error)
+	ExpireJobs(now time.Time) ([]uint, error)
+}
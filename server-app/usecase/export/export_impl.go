@@ -0,0 +1,323 @@
+package export
+
+import (
+	"errors"
+	"io"
+	"sort"
+	"time"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	domainExport "github.com/kazukimurahashi12/webapp/domain/export"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+)
+
+// 作成中のまま更新されないジョブを中断されたとみなすまでの時間
+const staleJobTimeout = time.Hour
+
+// 一度に処理する期限切れジョブの件数
+const expireBatchSize = 100
+
+type exportUseCase struct {
+	jobRepo        domainExport.JobRepository
+	blogRepo       domainBlog.BlogRepository
+	categoryRepo   domainCategory.CategoryRepository
+	tagRepo        domainTag.TagRepository
+	attachmentRepo domainAttachment.AttachmentRepository
+	userRepo       domainUser.UserRepository
+	blobStorage    domainAttachment.BlobStorage
+	archiveStorage domainExport.ArchiveStorage
+	archiveWriter  domainExport.ArchiveWriter
+	queue          domainExport.JobQueue
+	syncMaxBlogs   int
+	linkTTL        time.Duration
+}
+
+func NewExportUseCase(
+	jobRepo domainExport.JobRepository,
+	blogRepo domainBlog.BlogRepository,
+	categoryRepo domainCategory.CategoryRepository,
+	tagRepo domainTag.TagRepository,
+	attachmentRepo domainAttachment.AttachmentRepository,
+	userRepo domainUser.UserRepository,
+	blobStorage domainAttachment.BlobStorage,
+	archiveStorage domainExport.ArchiveStorage,
+	archiveWriter domainExport.ArchiveWriter,
+	queue domainExport.JobQueue,
+	syncMaxBlogs int,
+	linkTTL time.Duration,
+) UseCase {
+	return &exportUseCase{
+		jobRepo:        jobRepo,
+		blogRepo:       blogRepo,
+		categoryRepo:   categoryRepo,
+		tagRepo:        tagRepo,
+		attachmentRepo: attachmentRepo,
+		userRepo:       userRepo,
+		blobStorage:    blobStorage,
+		archiveStorage: archiveStorage,
+		archiveWriter:  archiveWriter,
+		queue:          queue,
+		syncMaxBlogs:   syncMaxBlogs,
+		linkTTL:        linkTTL,
+	}
+}
+
+// その場でストリーミングするエクスポートの内容を準備
+// 件数が多い場合はErrExportTooLargeを返し、バックグラウンドでの作成を促す
+func (u *exportUseCase) PrepareExport(userID uint) (*domainExport.Archive, error) {
+	archive, err := u.buildArchive(userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(archive.Entries) > u.syncMaxBlogs {
+		return nil, domainExport.ErrExportTooLarge
+	}
+	return archive, nil
+}
+
+// アーカイブをZIP形式で書き込む
+func (u *exportUseCase) WriteArchive(archive *domainExport.Archive, w io.Writer) (*domainExport.Manifest, error) {
+	return u.archiveWriter.Write(w, archive, u.blobStorage.Open)
+}
+
+// ユーザーのブログ・カテゴリ・タグ・添付ファイルを収集
+func (u *exportUseCase) buildArchive(userID uint) (*domainExport.Archive, error) {
+	user, err := u.userRepo.FindUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	blogs, err := u.blogRepo.FindBlogsByAuthorID(userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(blogs, func(i, j int) bool {
+		if !blogs[i].CreatedAt.Equal(blogs[j].CreatedAt) {
+			return blogs[i].CreatedAt.Before(blogs[j].CreatedAt)
+		}
+		return blogs[i].ID < blogs[j].ID
+	})
+
+	// カテゴリ・タグはブログごとに取得せず全ブログ分をまとめて取得する
+	blogIDs := make([]uint, len(blogs))
+	for i, blog := range blogs {
+		blogIDs[i] = blog.ID
+	}
+	categories, err := u.categoryRepo.FindCategoriesByBlogIDs(blogIDs)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := u.tagRepo.FindTagsByBlogIDs(blogIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]domainExport.Entry, 0, len(blogs))
+	for _, blog := range blogs {
+		entry := domainExport.Entry{Blog: blog}
+		for _, c := range categories[blog.ID] {
+			entry.Categories = append(entry.Categories, c.Name)
+		}
+		for _, t := range tags[blog.ID] {
+			entry.Tags = append(entry.Tags, t.Name)
+		}
+		entries = append(entries, entry)
+	}
+
+	attachments, err := u.attachmentRepo.FindAttachmentsByOwnerID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &domainExport.Archive{
+		Username:    user.Username,
+		ExportedAt:  time.Now(),
+		Entries:     entries,
+		Attachments: domainExport.SelectAttachments(entries, attachments),
+	}, nil
+}
+
+// バックグラウンドでのエクスポートを開始
+// 作成待ち・作成中のジョブがある場合はそのジョブを返す
+func (u *exportUseCase) StartExport(userID uint) (*domainExport.Job, error) {
+	job, err := u.jobRepo.FindActiveJobByUserID(userID)
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, domainExport.ErrExportJobNotFound) {
+		return nil, err
+	}
+
+	job = &domainExport.Job{
+		UserID:        userID,
+		Status:        domainExport.JobPending,
+		DownloadToken: domainExport.NewDownloadToken(),
+	}
+	if err := u.jobRepo.Create(job); err != nil {
+		return nil, err
+	}
+	// キューが満杯の場合は作成待ちのまま残り、定期的な再投入で処理される
+	u.queue.Enqueue(job.ID)
+	return job, nil
+}
+
+// エクスポートジョブの状態を取得
+func (u *exportUseCase) GetJob(userID, id uint) (*domainExport.Job, error) {
+	job, err := u.jobRepo.FindJobByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if job.UserID != userID {
+		return nil, domainExport.ErrExportUnauthorized
+	}
+	return job, nil
+}
+
+// ダウンロードURLのトークンからアーカイブを取得
+func (u *exportUseCase) OpenDownload(token string) (*domainExport.Job, io.ReadCloser, error) {
+	job, err := u.jobRepo.FindJobByToken(token)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !job.IsDownloadable(time.Now()) {
+		if job.Status == domainExport.JobDone || job.Status == domainExport.JobExpired {
+			return nil, nil, domainExport.ErrExportExpired
+		}
+		return nil, nil, domainExport.ErrExportNotReady
+	}
+	content, err := u.archiveStorage.Open(job.ArchiveName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return job, content, nil
+}
+
+// エクスポートジョブのアーカイブを作成
+// 他のワーカーが開始済みのジョブは何もしない
+func (u *exportUseCase) RunJob(id uint) error {
+	job, err := u.jobRepo.FindJobByID(id)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	claimed, err := u.jobRepo.ClaimJob(id, now)
+	if err != nil || !claimed {
+		return err
+	}
+	job.Status = domainExport.JobRunning
+	job.StartedAt = &now
+
+	manifest, size, err := u.createArchive(job)
+	completed := time.Now()
+	job.CompletedAt = &completed
+	if err != nil {
+		job.Status = domainExport.JobFailed
+		job.Error = truncateError(err)
+		if uerr := u.jobRepo.Update(job); uerr != nil {
+			return uerr
+		}
+		return err
+	}
+
+	expires := completed.Add(u.linkTTL)
+	job.Status = domainExport.JobDone
+	job.BlogCount = len(manifest.Blogs)
+	job.AttachmentCount = len(manifest.Attachments)
+	job.Size = size
+	job.ExpiresAt = &expires
+	return u.jobRepo.Update(job)
+}
+
+// アーカイブを作成してストレージに保存
+func (u *exportUseCase) createArchive(job *domainExport.Job) (*domainExport.Manifest, int64, error) {
+	archive, err := u.buildArchive(job.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	w, err := u.archiveStorage.Create(job.ArchiveName())
+	if err != nil {
+		return nil, 0, err
+	}
+	counter := &countingWriter{w: w}
+	manifest, err := u.WriteArchive(archive, counter)
+	if cerr := w.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = u.archiveStorage.Delete(job.ArchiveName())
+		return nil, 0, err
+	}
+	return manifest, counter.n, nil
+}
+
+// 作成待ちのエクスポートジョブのIDを取得
+func (u *exportUseCase) PendingJobIDs(limit int) ([]uint, error) {
+	return u.jobRepo.FindJobIDsByStatus(domainExport.JobPending, limit)
+}
+
+// ダウンロードURLの有効期限を過ぎたアーカイブを削除
+// 作成中のまま長時間経過したジョブ（サーバーの停止等で中断されたもの）は失敗とする
+func (u *exportUseCase) ExpireJobs(now time.Time) ([]uint, error) {
+	ids, err := u.jobRepo.FindExpiredJobIDs(now, expireBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	var expired []uint
+	for _, id := range ids {
+		job, err := u.jobRepo.FindJobByID(id)
+		if err != nil {
+			return expired, err
+		}
+		if err := u.archiveStorage.Delete(job.ArchiveName()); err != nil {
+			return expired, err
+		}
+		job.Status = domainExport.JobExpired
+		if err := u.jobRepo.Update(job); err != nil {
+			return expired, err
+		}
+		expired = append(expired, id)
+	}
+
+	running, err := u.jobRepo.FindJobIDsByStatus(domainExport.JobRunning, expireBatchSize)
+	if err != nil {
+		return expired, err
+	}
+	for _, id := range running {
+		job, err := u.jobRepo.FindJobByID(id)
+		if err != nil {
+			return expired, err
+		}
+		if job.StartedAt == nil || now.Sub(*job.StartedAt) < staleJobTimeout {
+			continue
+		}
+		job.Status = domainExport.JobFailed
+		job.Error = "export was interrupted"
+		job.CompletedAt = &now
+		if err := u.jobRepo.Update(job); err != nil {
+			return expired, err
+		}
+	}
+	return expired, nil
+}
+
+// エラーメッセージを保存可能な長さに切り詰める
+func truncateError(err error) string {
+	msg := []rune(err.Error())
+	if len(msg) > 255 {
+		msg = msg[:255]
+	}
+	return string(msg)
+}
+
+// 書き込んだバイト数を数える
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
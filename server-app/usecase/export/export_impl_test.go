@@ -0,0 +1,96 @@
+package export
+
+import (
+	"testing"
+	"time"
+
+	domainAttachment "github.com/kazukimurahashi12/webapp/domain/attachment"
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	domainUser "github.com/kazukimurahashi12/webapp/domain/user"
+	"github.com/stretchr/testify/assert"
+)
+
+// エクスポートの収集に使うメソッドのみを実装したリポジトリ
+// ブログごとにカテゴリ・タグを取得するメソッドは呼び出されない想定
+type stubUserRepository struct {
+	domainUser.UserRepository
+}
+
+func (r *stubUserRepository) FindUserByID(id uint) (*domainUser.User, error) {
+	return &domainUser.User{ID: id, Username: "alice"}, nil
+}
+
+type stubBlogRepository struct {
+	domainBlog.BlogRepository
+	blogs []domainBlog.Blog
+}
+
+func (r *stubBlogRepository) FindBlogsByAuthorID(authorID uint) ([]domainBlog.Blog, error) {
+	return r.blogs, nil
+}
+
+type stubCategoryRepository struct {
+	domainCategory.CategoryRepository
+	calls int
+}
+
+func (r *stubCategoryRepository) FindCategoriesByBlogIDs(blogIDs []uint) (map[uint][]domainCategory.Category, error) {
+	r.calls++
+	return map[uint][]domainCategory.Category{
+		10: {{ID: 1, Name: "Go"}, {ID: 2, Name: "Web"}},
+	}, nil
+}
+
+type stubTagRepository struct {
+	domainTag.TagRepository
+	calls int
+}
+
+func (r *stubTagRepository) FindTagsByBlogIDs(blogIDs []uint) (map[uint][]domainTag.Tag, error) {
+	r.calls++
+	return map[uint][]domainTag.Tag{
+		10: {{ID: 1, Name: "gin"}},
+		11: {{ID: 2, Name: "gorm"}, {ID: 3, Name: "mysql"}},
+	}, nil
+}
+
+type stubAttachmentRepository struct {
+	domainAttachment.AttachmentRepository
+}
+
+func (r *stubAttachmentRepository) FindAttachmentsByOwnerID(ownerID uint) ([]domainAttachment.Attachment, error) {
+	return nil, nil
+}
+
+func TestExportUseCase_PrepareExport(t *testing.T) {
+	now := time.Now()
+	blogRepo := &stubBlogRepository{
+		blogs: []domainBlog.Blog{
+			{ID: 11, AuthorID: 1, CreatedAt: now},
+			{ID: 10, AuthorID: 1, CreatedAt: now.Add(-time.Hour)},
+		},
+	}
+	categoryRepo := &stubCategoryRepository{}
+	tagRepo := &stubTagRepository{}
+	useCase := NewExportUseCase(nil, blogRepo, categoryRepo, tagRepo, &stubAttachmentRepository{}, &stubUserRepository{},
+		nil, nil, nil, nil, 100, time.Hour)
+
+	archive, err := useCase.PrepareExport(1)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// カテゴリ・タグはブログの件数によらず1回ずつ取得する
+	assert.Equal(t, 1, categoryRepo.calls)
+	assert.Equal(t, 1, tagRepo.calls)
+
+	if assert.Len(t, archive.Entries, 2) {
+		assert.Equal(t, uint(10), archive.Entries[0].Blog.ID)
+		assert.Equal(t, []string{"Go", "Web"}, archive.Entries[0].Categories)
+		assert.Equal(t, []string{"gin"}, archive.Entries[0].Tags)
+		assert.Equal(t, uint(11), archive.Entries[1].Blog.ID)
+		assert.Empty(t, archive.Entries[1].Categories)
+		assert.Equal(t, []string{"gorm", "mysql"}, archive.Entries[1].Tags)
+	}
+}
@@ -0,0 +1,9 @@
+package importer
+
+import (
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+)
+
+type UseCase interface {
+	Import(userID uint, source string, posts []domainImporter.Post, dryRun bool) (*domainImporter.Report, error)
+}
@@ -0,0 +1,403 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainCategory "github.com/kazukimurahashi12/webapp/domain/category"
+	domainImporter "github.com/kazukimurahashi12/webapp/domain/importer"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type importerUseCase struct {
+	blogUseCase  usecaseBlog.UseCase
+	categoryRepo domainCategory.CategoryRepository
+	tagRepo      domainTag.TagRepository
+	linkRepo     domainImporter.SourceLinkRepository
+	converter    domainImporter.HTMLConverter
+}
+
+func NewImporterUseCase(
+	blogUseCase usecaseBlog.UseCase,
+	categoryRepo domainCategory.CategoryRepository,
+	tagRepo domainTag.TagRepository,
+	linkRepo domainImporter.SourceLinkRepository,
+	converter domainImporter.HTMLConverter,
+) UseCase {
+	return &importerUseCase{
+		blogUseCase:  blogUseCase,
+		categoryRepo: categoryRepo,
+		tagRepo:      tagRepo,
+		linkRepo:     linkRepo,
+		converter:    converter,
+	}
+}
+
+// 取り込み対象の記事と、取り込み先のカテゴリ・タグ
+type importItem struct {
+	post       *domainImporter.Post
+	blog       *domainBlog.Blog
+	categories []string
+	tags       []string
+	report     domainImporter.ReportItem
+}
+
+// 記事を取り込む
+// 取り込み元の識別子で取り込み済みの記事を判定し、再取り込み時は変更がある場合のみ更新する
+// 公開状態と日時は初回の取り込み時のものを維持する
+// 記事ごとの不備は取り込まない理由として報告し、DBのエラーの場合は中断する（再実行で続きから取り込まれる）
+func (u *importerUseCase) Import(userID uint, source string, posts []domainImporter.Post, dryRun bool) (*domainImporter.Report, error) {
+	report := &domainImporter.Report{DryRun: dryRun, Source: source}
+	categoryIDs := map[string]uint{}
+	newCategories := map[string]bool{}
+
+	for i := range posts {
+		item, err := u.prepare(userID, &posts[i])
+		if err != nil {
+			return nil, err
+		}
+		if item.report.Action == domainImporter.ActionSkip {
+			report.Add(item.report)
+			continue
+		}
+
+		// 取り込むユーザーのカテゴリに存在しないカテゴリは作成する
+		for _, name := range item.categories {
+			if _, ok := categoryIDs[name]; ok || newCategories[name] {
+				continue
+			}
+			category, err := u.categoryRepo.FindCategoryByName(userID, name)
+			switch {
+			case err == nil:
+				categoryIDs[name] = category.ID
+			case errors.Is(err, domainCategory.ErrCategoryNotFound):
+				newCategories[name] = true
+			default:
+				return nil, err
+			}
+		}
+
+		link, err := u.linkRepo.FindLink(userID, posts[i].Source, posts[i].SourceID)
+		switch {
+		case errors.Is(err, domainImporter.ErrSourceLinkNotFound):
+			err = u.create(item, dryRun)
+		case err == nil:
+			err = u.update(userID, item, link.BlogID, dryRun)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if dryRun || item.report.Action == domainImporter.ActionSkip {
+			report.Add(item.report)
+			continue
+		}
+
+		if err := u.assignCategories(userID, item, categoryIDs, newCategories); err != nil {
+			return nil, err
+		}
+		if item.report.Action == domainImporter.ActionCreate || contains(item.report.Changes, "tags") {
+			if _, err := u.tagRepo.SetBlogTags(item.blog.ID, item.tags); err != nil {
+				return nil, err
+			}
+		}
+		if item.report.Action == domainImporter.ActionCreate {
+			if err := u.linkRepo.Save(&domainImporter.SourceLink{
+				UserID:   userID,
+				Source:   posts[i].Source,
+				SourceID: posts[i].SourceID,
+				BlogID:   item.blog.ID,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		report.Add(item.report)
+	}
+
+	for name := range newCategories {
+		report.NewCategories = append(report.NewCategories, name)
+	}
+	sort.Strings(report.NewCategories)
+	return report, nil
+}
+
+// 記事をブログに変換して検証
+func (u *importerUseCase) prepare(userID uint, post *domainImporter.Post) (*importItem, error) {
+	item := &importItem{
+		post: post,
+		report: domainImporter.ReportItem{
+			SourceID: post.SourceID,
+			Path:     post.Path,
+			Title:    post.Title,
+		},
+	}
+
+	for _, warning := range post.Warnings {
+		item.warn(warning)
+	}
+
+	// 取り込み元で読み替えられなかった公開状態・公開範囲は取り込まない
+	if !domainBlog.IsStatus(post.Status) {
+		item.skip(fmt.Sprintf("公開状態「%s」は取り込めません", post.Status))
+		return item, nil
+	}
+	if !domainBlog.IsVisibility(post.Visibility) {
+		item.skip(fmt.Sprintf("公開範囲「%s」は取り込めません", post.Visibility))
+		return item, nil
+	}
+
+	content := post.Content
+	if post.HTML {
+		converted, err := u.converter.ToMarkdown(content)
+		if err != nil {
+			item.skip(fmt.Sprintf("本文を変換できません: %v", err))
+			return item, nil
+		}
+		content = converted
+	}
+
+	// 入力時と同じ検証を行う
+	blog, err := domainBlog.NewBlog(userID, post.Title, content)
+	if err != nil {
+		item.skip(err.Error())
+		return item, nil
+	}
+	blog.Slug = post.Slug
+	blog.Status = post.Status
+	blog.Visibility = post.Visibility
+	blog.PublishAt = post.PublishAt
+	blog.PublishedAt = post.PublishedAt
+	blog.CreatedAt = post.CreatedAt
+	blog.UpdatedAt = post.UpdatedAt
+	item.blog = blog
+
+	for _, name := range post.Categories {
+		if err := domainCategory.ValidateName(name); err != nil {
+			item.warn(fmt.Sprintf("カテゴリ「%s」: %v", name, err))
+			continue
+		}
+		item.categories = append(item.categories, name)
+	}
+
+	// 不正なタグは除き、上限を超える分は取り込まない
+	seen := map[string]bool{}
+	for _, name := range post.Tags {
+		normalized := domainTag.NormalizeName(name)
+		if err := domainTag.ValidateName(normalized); err != nil {
+			item.warn(fmt.Sprintf("タグ「%s」: %v", name, err))
+			continue
+		}
+		if seen[normalized] {
+			continue
+		}
+		if len(item.tags) == domainTag.MaxTagsPerBlog {
+			item.warn(fmt.Sprintf("タグ「%s」: 1件あたりのタグ数の上限（%d件）を超えています", name, domainTag.MaxTagsPerBlog))
+			continue
+		}
+		seen[normalized] = true
+		item.tags = append(item.tags, normalized)
+	}
+	return item, nil
+}
+
+// 新規に作成
+// 指定されたスラッグが使用済みの場合はタイトルから生成する
+func (u *importerUseCase) create(item *importItem, dryRun bool) error {
+	item.report.Action = domainImporter.ActionCreate
+	if dryRun {
+		return nil
+	}
+
+	blog := *item.blog
+	created, err := u.blogUseCase.NewCreateBlog(&blog)
+	if errors.Is(err, domainBlog.ErrSlugAlreadyExists) || errors.Is(err, domainBlog.ErrSlugInvalid) {
+		item.warn(fmt.Sprintf("スラッグ「%s」は使用できないため、タイトルから生成しました", item.blog.Slug))
+		blog = *item.blog
+		blog.Slug = ""
+		created, err = u.blogUseCase.NewCreateBlog(&blog)
+	}
+	if err != nil {
+		return err
+	}
+	item.blog = created
+	item.report.BlogID = created.ID
+	return nil
+}
+
+// 取り込み済みのブログを更新
+func (u *importerUseCase) update(userID uint, item *importItem, blogID uint, dryRun bool) error {
+	item.report.BlogID = blogID
+	current, err := u.blogUseCase.FindBlogByID(blogID)
+	if errors.Is(err, domainBlog.ErrBlogNotFound) {
+		item.skip("取り込み済みのブログが削除されています")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current.AuthorID != item.blog.AuthorID {
+		item.skip("取り込み済みのブログを更新する権限がありません")
+		return nil
+	}
+
+	changes, err := u.diff(item, current)
+	if err != nil {
+		return err
+	}
+	item.report.Changes = changes
+	if len(changes) == 0 {
+		item.report.Action = domainImporter.ActionUnchanged
+		item.blog = current
+		return nil
+	}
+	item.report.Action = domainImporter.ActionUpdate
+	if dryRun {
+		return nil
+	}
+
+	if !contains(changes, "title") && !contains(changes, "slug") && !contains(changes, "content") && !contains(changes, "visibility") {
+		item.blog = current
+		return nil
+	}
+	blog := *current
+	blog.Title = item.blog.Title
+	blog.Content = item.blog.Content
+	blog.Visibility = item.blog.Visibility
+	if item.blog.Slug != "" {
+		blog.Slug = item.blog.Slug
+	}
+	updated, err := u.blogUseCase.UpdateBlog(userID, &blog)
+	if errors.Is(err, domainBlog.ErrSlugAlreadyExists) {
+		item.warn(fmt.Sprintf("スラッグ「%s」は使用済みのため変更しませんでした", blog.Slug))
+		blog.Slug = current.Slug
+		updated, err = u.blogUseCase.UpdateBlog(userID, &blog)
+	}
+	if err != nil {
+		return err
+	}
+	item.blog = updated
+	return nil
+}
+
+// 取り込み済みのブログとの差分の項目
+func (u *importerUseCase) diff(item *importItem, current *domainBlog.Blog) ([]string, error) {
+	var changes []string
+	if item.blog.Title != current.Title {
+		changes = append(changes, "title")
+	}
+	if item.blog.Slug != "" && item.blog.Slug != current.Slug {
+		changes = append(changes, "slug")
+	}
+	if item.blog.Content != current.Content {
+		changes = append(changes, "content")
+	}
+	if item.blog.Visibility != current.Visibility {
+		changes = append(changes, "visibility")
+	}
+
+	categories, err := u.categoryRepo.FindCategoriesByBlogID(current.ID)
+	if err != nil {
+		return nil, err
+	}
+	currentCategories := make([]string, len(categories))
+	for i, c := range categories {
+		currentCategories[i] = c.Name
+	}
+	if !sameSet(item.categories, currentCategories) {
+		changes = append(changes, "categories")
+	}
+
+	tags, err := u.tagRepo.FindTagsByBlogID(current.ID)
+	if err != nil {
+		return nil, err
+	}
+	currentTags := make([]string, len(tags))
+	for i, t := range tags {
+		currentTags[i] = t.Name
+	}
+	if !sameSet(item.tags, currentTags) {
+		changes = append(changes, "tags")
+	}
+	return changes, nil
+}
+
+// カテゴリの紐付けを取り込み元に合わせる
+func (u *importerUseCase) assignCategories(userID uint, item *importItem, categoryIDs map[string]uint, newCategories map[string]bool) error {
+	if item.report.Action == domainImporter.ActionUpdate && !contains(item.report.Changes, "categories") {
+		return nil
+	}
+	if item.report.Action == domainImporter.ActionUnchanged {
+		return nil
+	}
+
+	wanted := map[uint]bool{}
+	for _, name := range item.categories {
+		id, ok := categoryIDs[name]
+		if !ok && newCategories[name] {
+			category, err := domainCategory.NewCategory(userID, name, "", nil)
+			if err != nil {
+				return err
+			}
+			if err := u.categoryRepo.Create(category); err != nil {
+				return err
+			}
+			id = category.ID
+			categoryIDs[name] = id
+		}
+		wanted[id] = true
+		if err := u.categoryRepo.AssignBlog(id, item.blog.ID); err != nil {
+			return err
+		}
+	}
+
+	if item.report.Action == domainImporter.ActionUpdate {
+		current, err := u.categoryRepo.FindCategoriesByBlogID(item.blog.ID)
+		if err != nil {
+			return err
+		}
+		for _, c := range current {
+			if !wanted[c.ID] {
+				if err := u.categoryRepo.UnassignBlog(c.ID, item.blog.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (i *importItem) skip(reason string) {
+	i.report.Action = domainImporter.ActionSkip
+	i.report.Reason = reason
+}
+
+func (i *importItem) warn(message string) {
+	i.report.Warnings = append(i.report.Warnings, message)
+}
+
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool, len(a))
+	for _, v := range a {
+		set[v] = true
+	}
+	for _, v := range b {
+		if !set[v] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,11 @@
+package reaction
+
+import (
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+)
+
+type UseCase interface {
+	GetReactions(userID, blogID uint) (*domainReaction.Summary, error)
+	ToggleReaction(userID, blogID uint, emoji string) (*domainReaction.Summary, bool, error)
+	ListReactors(userID, blogID uint, emoji string, limit int) ([]domainReaction.Reactor, error)
+}
@@ -0,0 +1,114 @@
+package reaction
+
+import (
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainReaction "github.com/kazukimurahashi12/webapp/domain/reaction"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type reactionUseCase struct {
+	reactionRepo domainReaction.ReactionRepository
+	blogRepo     domainBlog.BlogRepository
+	emojis       *domainReaction.EmojiSet
+	policy       *usecaseBlog.Policy
+}
+
+func NewReactionUseCase(reactionRepo domainReaction.ReactionRepository, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository, emojis *domainReaction.EmojiSet) UseCase {
+	return &reactionUseCase{
+		reactionRepo: reactionRepo,
+		blogRepo:     blogRepo,
+		emojis:       emojis,
+		policy:       usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// ブログのリアクションの集計を取得
+func (u *reactionUseCase) GetReactions(userID, blogID uint) (*domainReaction.Summary, error) {
+	if err := u.checkVisible(userID, blogID); err != nil {
+		return nil, err
+	}
+	return u.summary(userID, blogID)
+}
+
+// リアクションを切り替える
+// 登録した場合はtrue、取り消した場合はfalseを返す
+// 設定から外された絵文字は取り消しのみ可能
+func (u *reactionUseCase) ToggleReaction(userID, blogID uint, emoji string) (*domainReaction.Summary, bool, error) {
+	if err := u.checkVisible(userID, blogID); err != nil {
+		return nil, false, err
+	}
+
+	if !u.emojis.Contains(emoji) {
+		reacted, err := u.reactionRepo.FindEmojisByUser(blogID, userID)
+		if err != nil {
+			return nil, false, err
+		}
+		if !containsEmoji(reacted, emoji) {
+			return nil, false, fmt.Errorf("%w: %q", domainReaction.ErrReactionEmojiInvalid, emoji)
+		}
+	}
+
+	added, err := u.reactionRepo.Toggle(blogID, userID, emoji)
+	if err != nil {
+		return nil, false, err
+	}
+	summary, err := u.summary(userID, blogID)
+	if err != nil {
+		return nil, false, err
+	}
+	return summary, added, nil
+}
+
+// リアクションしたユーザーを新しい順に取得
+// 絵文字が空の場合は全ての絵文字を対象とする
+func (u *reactionUseCase) ListReactors(userID, blogID uint, emoji string, limit int) ([]domainReaction.Reactor, error) {
+	if limit == 0 {
+		limit = domainReaction.DefaultReactorLimit
+	}
+	if limit < 0 || limit > domainReaction.MaxReactorLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domainReaction.ErrReactionInvalidQuery, domainReaction.MaxReactorLimit)
+	}
+	if err := u.checkVisible(userID, blogID); err != nil {
+		return nil, err
+	}
+	return u.reactionRepo.FindReactors(blogID, emoji, limit)
+}
+
+// 下書き等の非公開記事は著者・共同編集者以外から存在しないものとして扱う
+func (u *reactionUseCase) checkVisible(userID, blogID uint) error {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return err
+	}
+	visible, err := u.policy.CanView(userID, blog)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return domainBlog.ErrBlogNotFound
+	}
+	return nil
+}
+
+func (u *reactionUseCase) summary(userID, blogID uint) (*domainReaction.Summary, error) {
+	counts, err := u.reactionRepo.FindCountsByBlogID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	reacted, err := u.reactionRepo.FindEmojisByUser(blogID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return domainReaction.NewSummary(blogID, u.emojis, counts, reacted), nil
+}
+
+func containsEmoji(emojis []string, emoji string) bool {
+	for _, e := range emojis {
+		if e == emoji {
+			return true
+		}
+	}
+	return false
+}
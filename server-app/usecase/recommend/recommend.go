@@ -0,0 +1,10 @@
+package recommend
+
+import (
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+)
+
+type UseCase interface {
+	GetRelated(userID, blogID uint, limit int) ([]domainRecommend.Related, error)
+	IndexMissing(batchSize int) (int, error)
+}
@@ -0,0 +1,183 @@
+package recommend
+
+import (
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainRecommend "github.com/kazukimurahashi12/webapp/domain/recommend"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type recommendUseCase struct {
+	relatedRepo domainRecommend.RelatedRepository
+	termIndex   domainRecommend.TermIndex
+	blogRepo    domainBlog.BlogRepository
+	policy      *usecaseBlog.Policy
+}
+
+func NewRecommendUseCase(relatedRepo domainRecommend.RelatedRepository, termIndex domainRecommend.TermIndex, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository) UseCase {
+	return &recommendUseCase{
+		relatedRepo: relatedRepo,
+		termIndex:   termIndex,
+		blogRepo:    blogRepo,
+		policy:      usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// ブログの関連記事をスコアの高い順に取得
+// 索引語のTF-IDFベクトルのコサイン類似度に、カテゴリ・タグの共有による加点を加えて順位付けする
+func (u *recommendUseCase) GetRelated(userID, blogID uint, limit int) ([]domainRecommend.Related, error) {
+	if limit == 0 {
+		limit = domainRecommend.DefaultRelatedLimit
+	}
+	if limit < 0 || limit > domainRecommend.MaxRelatedLimit {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domainRecommend.ErrRelatedInvalidQuery, domainRecommend.MaxRelatedLimit)
+	}
+
+	// 下書き等の非公開記事は著者・共同編集者以外から存在しないものとして扱う
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := u.policy.CanView(userID, blog)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+
+	terms, err := u.relatedRepo.FindTerms([]uint{blogID})
+	if err != nil {
+		return nil, err
+	}
+	tf, ok := terms[blogID]
+	if !ok {
+		// 索引語が未登録の場合はこの時点で登録する
+		if err := u.termIndex.Index(blog); err != nil {
+			return nil, err
+		}
+		tf = domainRecommend.ExtractTerms(blog.Title, blog.Content)
+	}
+
+	df, total, err := u.relatedRepo.FindDocumentFrequencies(termKeys(tf))
+	if err != nil {
+		return nil, err
+	}
+	target := domainRecommend.NewVector(tf, df, total)
+
+	candidates, err := u.relatedRepo.FindCandidates(blogID, target.TopTerms(domainRecommend.QueryTermCount), domainRecommend.CandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return []domainRecommend.Related{}, nil
+	}
+
+	ids := make([]uint, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.BlogID
+	}
+	candidateTerms, err := u.relatedRepo.FindTerms(ids)
+	if err != nil {
+		return nil, err
+	}
+	// 候補のみに含まれる索引語の文書頻度を追加で取得
+	missing := make(map[string]int)
+	for _, tf := range candidateTerms {
+		for term := range tf {
+			if _, ok := df[term]; !ok {
+				missing[term] = 0
+			}
+		}
+	}
+	if len(missing) > 0 {
+		extra, _, err := u.relatedRepo.FindDocumentFrequencies(termKeys(missing))
+		if err != nil {
+			return nil, err
+		}
+		for term, n := range extra {
+			df[term] = n
+		}
+	}
+
+	related := make([]domainRecommend.Related, 0, len(candidates))
+	for _, c := range candidates {
+		similarity := target.Similarity(domainRecommend.NewVector(candidateTerms[c.BlogID], df, total))
+		score := domainRecommend.Score(similarity, c)
+		if score <= 0 {
+			continue
+		}
+		related = append(related, domainRecommend.Related{
+			Blog:             domainBlog.Blog{ID: c.BlogID},
+			Score:            score,
+			Similarity:       similarity,
+			SharedCategories: c.SharedCategories,
+			SharedTags:       c.SharedTags,
+		})
+	}
+	domainRecommend.SortRelated(related)
+	if len(related) > limit {
+		related = related[:limit]
+	}
+
+	// 上位のブログのみ本文を取得する
+	ids = ids[:0]
+	for _, r := range related {
+		ids = append(ids, r.Blog.ID)
+	}
+	blogs, err := u.relatedRepo.FindPublishedBlogsByIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]domainBlog.Blog, len(blogs))
+	for _, b := range blogs {
+		byID[b.ID] = b
+	}
+	result := make([]domainRecommend.Related, 0, len(related))
+	for _, r := range related {
+		// 候補の取得後に非公開・削除されたブログは除外する
+		b, ok := byID[r.Blog.ID]
+		if !ok {
+			continue
+		}
+		r.Blog = b
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// 索引語が未登録のブログを一括で登録し、登録したブログ数を返す
+// 索引語の導入前に作成されたブログの移行に用いる
+func (u *recommendUseCase) IndexMissing(batchSize int) (int, error) {
+	var afterID uint
+	indexed := 0
+	for {
+		ids, err := u.relatedRepo.FindUnindexedBlogIDs(afterID, batchSize)
+		if err != nil {
+			return indexed, err
+		}
+		if len(ids) == 0 {
+			return indexed, nil
+		}
+		for _, id := range ids {
+			blog, err := u.blogRepo.FindBlogByID(id)
+			if err != nil {
+				return indexed, err
+			}
+			if err := u.termIndex.Index(blog); err != nil {
+				return indexed, err
+			}
+			indexed++
+		}
+		afterID = ids[len(ids)-1]
+	}
+}
+
+func termKeys(tf map[string]int) []string {
+	terms := make([]string, 0, len(tf))
+	for term := range tf {
+		terms = append(terms, term)
+	}
+	return terms
+}
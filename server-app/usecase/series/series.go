@@ -0,0 +1,14 @@
+package series
+
+import (
+	domainSeries "github.com/kazukimurahashi12/webapp/domain/series"
+)
+
+type UseCase interface {
+	CreateSeries(userID uint, title, description string) (*domainSeries.Series, error)
+	GetSeries(userID, seriesID uint) (*domainSeries.Series, error)
+	AddBlog(userID, seriesID, blogID uint) (*domainSeries.Series, error)
+	RemoveBlog(userID, seriesID, blogID uint) (*domainSeries.Series, error)
+	ReorderBlogs(userID, seriesID uint, blogIDs []uint) (*domainSeries.Series, error)
+	FindNavigation(userID, blogID uint) (*domainSeries.Navigation, error)
+}
@@ -0,0 +1,120 @@
+package series
+
+import (
+	"errors"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainSeries "github.com/kazukimurahashi12/webapp/domain/series"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type seriesUseCase struct {
+	seriesRepo domainSeries.SeriesRepository
+	blogRepo   domainBlog.BlogRepository
+	policy     *usecaseBlog.Policy
+}
+
+func NewSeriesUseCase(seriesRepo domainSeries.SeriesRepository, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository) UseCase {
+	return &seriesUseCase{
+		seriesRepo: seriesRepo,
+		blogRepo:   blogRepo,
+		policy:     usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// シリーズを作成
+func (u *seriesUseCase) CreateSeries(userID uint, title, description string) (*domainSeries.Series, error) {
+	series, err := domainSeries.NewSeries(userID, title, description)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.seriesRepo.Create(series); err != nil {
+		return nil, err
+	}
+	series.Entries = []domainSeries.Entry{}
+	return series, nil
+}
+
+// シリーズを目次と合わせて取得
+// 目次には閲覧者が閲覧できるブログ（共同編集者として参加している下書き等を含む）のみを含める
+func (u *seriesUseCase) GetSeries(userID, seriesID uint) (*domainSeries.Series, error) {
+	series, err := u.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	return series.VisibleTo(func(blog *domainBlog.Blog) (bool, error) {
+		return u.policy.CanView(userID, blog)
+	})
+}
+
+// シリーズの末尾にブログを追加
+// 追加できるのは自身が著者・編集者のブログのみ
+func (u *seriesUseCase) AddBlog(userID, seriesID, blogID uint) (*domainSeries.Series, error) {
+	if _, err := u.findOwnSeries(userID, seriesID); err != nil {
+		return nil, err
+	}
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionEdit); err != nil {
+		return nil, err
+	}
+
+	if err := u.seriesRepo.AddEntry(seriesID, blogID); err != nil {
+		return nil, err
+	}
+	return u.GetSeries(userID, seriesID)
+}
+
+// シリーズからブログを外す
+func (u *seriesUseCase) RemoveBlog(userID, seriesID, blogID uint) (*domainSeries.Series, error) {
+	if _, err := u.findOwnSeries(userID, seriesID); err != nil {
+		return nil, err
+	}
+	if err := u.seriesRepo.RemoveEntry(seriesID, blogID); err != nil {
+		return nil, err
+	}
+	return u.GetSeries(userID, seriesID)
+}
+
+// シリーズ内のブログを並べ替える
+// 全てのブログを新しい順序で指定する必要があり、一部のみの指定は受け付けない
+func (u *seriesUseCase) ReorderBlogs(userID, seriesID uint, blogIDs []uint) (*domainSeries.Series, error) {
+	if _, err := u.findOwnSeries(userID, seriesID); err != nil {
+		return nil, err
+	}
+	if err := u.seriesRepo.Reorder(seriesID, blogIDs); err != nil {
+		return nil, err
+	}
+	return u.GetSeries(userID, seriesID)
+}
+
+// ブログが属するシリーズと前後のブログを取得
+// シリーズに属さない場合はnilを返す
+func (u *seriesUseCase) FindNavigation(userID, blogID uint) (*domainSeries.Navigation, error) {
+	seriesID, err := u.seriesRepo.FindSeriesIDByBlogID(blogID)
+	if err != nil {
+		if errors.Is(err, domainSeries.ErrSeriesNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	series, err := u.GetSeries(userID, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	return series.NavigationOf(blogID), nil
+}
+
+// 自身のシリーズを取得
+func (u *seriesUseCase) findOwnSeries(userID, seriesID uint) (*domainSeries.Series, error) {
+	series, err := u.seriesRepo.FindByID(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if series.OwnerID != userID {
+		return nil, domainSeries.ErrSeriesUnauthorized
+	}
+	return series, nil
+}
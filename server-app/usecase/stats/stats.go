@@ -0,0 +1,13 @@
+package stats
+
+import (
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+)
+
+type UseCase interface {
+	RecordView(blog *domainBlog.Blog, viewerID uint, visitorID string) error
+	FlushViews() (int, error)
+	GetBlogViews(userID, blogID uint, query domainStats.SeriesQuery) (*domainStats.Series, error)
+	GetAuthorViews(userID uint, query domainStats.SeriesQuery) (*domainStats.Series, error)
+}
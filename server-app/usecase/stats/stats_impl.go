@@ -0,0 +1,101 @@
+package stats
+
+import (
+	"time"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainStats "github.com/kazukimurahashi12/webapp/domain/stats"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+// 1回の書き込みで処理する閲覧数の件数
+const flushBatchSize = 500
+
+type statsUseCase struct {
+	statsRepo   domainStats.StatsRepository
+	blogRepo    domainBlog.BlogRepository
+	viewCounter domainStats.ViewCounter
+	policy      *usecaseBlog.Policy
+}
+
+func NewStatsUseCase(statsRepo domainStats.StatsRepository, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository, viewCounter domainStats.ViewCounter) UseCase {
+	return &statsUseCase{
+		statsRepo:   statsRepo,
+		blogRepo:    blogRepo,
+		viewCounter: viewCounter,
+		policy:      usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// ブログの閲覧を記録
+// 著者自身の閲覧は集計しない
+func (u *statsUseCase) RecordView(blog *domainBlog.Blog, viewerID uint, visitorID string) error {
+	if viewerID != 0 && viewerID == blog.AuthorID {
+		return nil
+	}
+	return u.viewCounter.Record(domainStats.NewViewKey(blog.ID, time.Now()), visitorID)
+}
+
+// 集計中の閲覧数をDBへ書き込み、書き込んだ件数を返す
+// 書き込みに失敗した分は書き込み待ちに戻し、次回に再度書き込む
+func (u *statsUseCase) FlushViews() (int, error) {
+	flushed := 0
+	for {
+		keys, err := u.viewCounter.PopPending(flushBatchSize)
+		if err != nil {
+			return flushed, err
+		}
+		if len(keys) == 0 {
+			return flushed, nil
+		}
+
+		rows, err := u.viewCounter.Counts(keys)
+		if err == nil {
+			err = u.statsRepo.SaveDailyViews(rows)
+		}
+		if err != nil {
+			if requeueErr := u.viewCounter.Requeue(keys); requeueErr != nil {
+				return flushed, requeueErr
+			}
+			return flushed, err
+		}
+		flushed += len(rows)
+
+		if len(keys) < flushBatchSize {
+			return flushed, nil
+		}
+	}
+}
+
+// ブログの閲覧数の推移を取得
+// 著者本人と共同編集者が参照できる
+func (u *statsUseCase) GetBlogViews(userID, blogID uint, query domainStats.SeriesQuery) (*domainStats.Series, error) {
+	if err := query.Normalize(time.Now()); err != nil {
+		return nil, err
+	}
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionView); err != nil {
+		return nil, err
+	}
+
+	rows, err := u.statsRepo.FindBlogDailyViews(blogID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	return domainStats.NewSeries(blogID, query, rows), nil
+}
+
+// 著者の全てのブログの閲覧数の推移を取得
+func (u *statsUseCase) GetAuthorViews(userID uint, query domainStats.SeriesQuery) (*domainStats.Series, error) {
+	if err := query.Normalize(time.Now()); err != nil {
+		return nil, err
+	}
+	rows, err := u.statsRepo.FindAuthorDailyViews(userID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+	return domainStats.NewSeries(0, query, rows), nil
+}
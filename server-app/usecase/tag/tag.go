@@ -0,0 +1,14 @@
+package tag
+
+import (
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+)
+
+type UseCase interface {
+	SetBlogTags(userID, blogID uint, names []string) ([]domainTag.Tag, error)
+	FindTagsByBlogID(viewerID, blogID uint) ([]domainTag.Tag, error)
+	ListBlogsByTag(name string, query domainBlog.ListQuery) (*domainBlog.Page, error)
+	SuggestTags(prefix string, limit int) ([]domainTag.TagCount, error)
+	GetTagCloud(limit int) ([]domainTag.TagCount, error)
+}
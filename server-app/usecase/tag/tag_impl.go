@@ -0,0 +1,107 @@
+package tag
+
+import (
+	"fmt"
+
+	domainBlog "github.com/kazukimurahashi12/webapp/domain/blog"
+	domainTag "github.com/kazukimurahashi12/webapp/domain/tag"
+	usecaseBlog "github.com/kazukimurahashi12/webapp/usecase/blog"
+)
+
+type tagUseCase struct {
+	tagRepo  domainTag.TagRepository
+	blogRepo domainBlog.BlogRepository
+	policy   *usecaseBlog.Policy
+}
+
+func NewTagUseCase(tagRepo domainTag.TagRepository, blogRepo domainBlog.BlogRepository, collabRepo domainBlog.CollaboratorRepository) UseCase {
+	return &tagUseCase{
+		tagRepo:  tagRepo,
+		blogRepo: blogRepo,
+		policy:   usecaseBlog.NewPolicy(collabRepo),
+	}
+}
+
+// ブログのタグを置き換え
+// タグ名は正規化し、重複は1つにまとめる
+func (u *tagUseCase) SetBlogTags(userID, blogID uint, names []string) ([]domainTag.Tag, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.policy.Authorize(userID, blog, usecaseBlog.ActionCategorize); err != nil {
+		return nil, err
+	}
+
+	normalized, err := domainTag.NormalizeNames(names)
+	if err != nil {
+		return nil, err
+	}
+	return u.tagRepo.SetBlogTags(blogID, normalized)
+}
+
+// ブログに紐づくタグを取得
+func (u *tagUseCase) FindTagsByBlogID(viewerID, blogID uint) ([]domainTag.Tag, error) {
+	blog, err := u.blogRepo.FindBlogByID(blogID)
+	if err != nil {
+		return nil, err
+	}
+	// 閲覧できないブログは存在しないものとして扱う
+	visible, err := u.policy.CanView(viewerID, blog)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, domainBlog.ErrBlogNotFound
+	}
+	return u.tagRepo.FindTagsByBlogID(blogID)
+}
+
+// タグが付いたブログ一覧をページングして取得
+// 全ての著者の公開済みブログと閲覧者自身のブログを対象とする
+func (u *tagUseCase) ListBlogsByTag(name string, query domainBlog.ListQuery) (*domainBlog.Page, error) {
+	tag, err := u.tagRepo.FindTagByName(domainTag.NormalizeName(name))
+	if err != nil {
+		return nil, err
+	}
+	query.TagID = tag.ID
+
+	if err := query.Normalize(); err != nil {
+		return nil, err
+	}
+	return u.blogRepo.FindBlogPage(query)
+}
+
+// 入力途中のタグ名に前方一致するタグを候補として取得
+func (u *tagUseCase) SuggestTags(prefix string, limit int) ([]domainTag.TagCount, error) {
+	limit, err := normalizeLimit(limit, domainTag.DefaultSuggestLimit, domainTag.MaxSuggestLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := domainTag.NormalizeName(prefix)
+	if normalized == "" {
+		return []domainTag.TagCount{}, nil
+	}
+	return u.tagRepo.FindTagsByPrefix(normalized, limit)
+}
+
+// タグクラウド用にタグの使用件数を取得
+func (u *tagUseCase) GetTagCloud(limit int) ([]domainTag.TagCount, error) {
+	limit, err := normalizeLimit(limit, domainTag.DefaultCloudLimit, domainTag.MaxCloudLimit)
+	if err != nil {
+		return nil, err
+	}
+	return u.tagRepo.CountTags(limit)
+}
+
+// 未指定の取得件数にデフォルト値を設定し、上限を検証
+func normalizeLimit(limit, defaultLimit, maxLimit int) (int, error) {
+	if limit == 0 {
+		return defaultLimit, nil
+	}
+	if limit < 0 || limit > maxLimit {
+		return 0, fmt.Errorf("%w: limit must be between 1 and %d", domainTag.ErrTagInvalidData, maxLimit)
+	}
+	return limit, nil
+}